@@ -37,6 +37,9 @@
 		GetCapabilityResponse
 		RevokeAuthTokenRequest
 		RevokeAuthTokenResponse
+		AuditLogEntry
+		GetAuditLogRequest
+		GetAuditLogResponse
 */
 package auth
 
@@ -44,6 +47,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf "github.com/gogo/protobuf/types"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
@@ -618,6 +622,136 @@ func (m *RevokeAuthTokenResponse) String() string            { return proto.Comp
 func (*RevokeAuthTokenResponse) ProtoMessage()               {}
 func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptorAuth, []int{28} }
 
+// AuditLogEntry records a single mutating PFS, PPS or auth API call
+type AuditLogEntry struct {
+	Time *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=time" json:"time,omitempty"`
+	// username is the authenticated caller (e.g. "github:alice"). It's empty if
+	// auth wasn't activated or the caller wasn't signed in
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// method is the full name of the RPC (e.g. "/pfs.API/DeleteRepo")
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// repo and pipeline are the repo and pipeline the call acted on, if any
+	Repo     string `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Pipeline string `protobuf:"bytes,5,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// error is the error the call returned, and is empty if it succeeded
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AuditLogEntry) Reset()                    { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string            { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()               {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) { return fileDescriptorAuth, []int{29} }
+
+func (m *AuditLogEntry) GetTime() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetAuditLogRequest struct {
+	// If set, only entries recorded at or after 'since' are returned
+	Since *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=since" json:"since,omitempty"`
+	// page_size is the number of entries returned per page. If it's unset (or
+	// larger than the server's limit), the server's limit is used
+	PageSize int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page is the page of entries to return, starting from 0
+	Page int64 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (m *GetAuditLogRequest) Reset()                    { *m = GetAuditLogRequest{} }
+func (m *GetAuditLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAuditLogRequest) ProtoMessage()               {}
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) { return fileDescriptorAuth, []int{30} }
+
+func (m *GetAuditLogRequest) GetSince() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *GetAuditLogRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetAuditLogRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type GetAuditLogResponse struct {
+	Entries    []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	TotalPages int64            `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Page       int64            `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (m *GetAuditLogResponse) Reset()                    { *m = GetAuditLogResponse{} }
+func (m *GetAuditLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAuditLogResponse) ProtoMessage()               {}
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) { return fileDescriptorAuth, []int{31} }
+
+func (m *GetAuditLogResponse) GetEntries() []*AuditLogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *GetAuditLogResponse) GetTotalPages() int64 {
+	if m != nil {
+		return m.TotalPages
+	}
+	return 0
+}
+
+func (m *GetAuditLogResponse) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ActivateRequest)(nil), "auth.ActivateRequest")
 	proto.RegisterType((*ActivateResponse)(nil), "auth.ActivateResponse")
@@ -648,6 +782,9 @@ func init() {
 	proto.RegisterType((*GetCapabilityResponse)(nil), "auth.GetCapabilityResponse")
 	proto.RegisterType((*RevokeAuthTokenRequest)(nil), "auth.RevokeAuthTokenRequest")
 	proto.RegisterType((*RevokeAuthTokenResponse)(nil), "auth.RevokeAuthTokenResponse")
+	proto.RegisterType((*AuditLogEntry)(nil), "auth.AuditLogEntry")
+	proto.RegisterType((*GetAuditLogRequest)(nil), "auth.GetAuditLogRequest")
+	proto.RegisterType((*GetAuditLogResponse)(nil), "auth.GetAuditLogResponse")
 	proto.RegisterEnum("auth.Scope", Scope_name, Scope_value)
 	proto.RegisterEnum("auth.User_UserType", User_UserType_name, User_UserType_value)
 }
@@ -681,6 +818,9 @@ type APIClient interface {
 	SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error)
 	GetCapability(ctx context.Context, in *GetCapabilityRequest, opts ...grpc.CallOption) (*GetCapabilityResponse, error)
 	RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error)
+	// GetAuditLog returns the log of mutating PFS, PPS and auth API calls. Only
+	// admins may call it
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
 }
 
 type aPIClient struct {
@@ -808,6 +948,15 @@ func (c *aPIClient) RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequ
 	return out, nil
 }
 
+func (c *aPIClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := grpc.Invoke(ctx, "/auth.API/GetAuditLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for API service
 
 type APIServer interface {
@@ -829,6 +978,9 @@ type APIServer interface {
 	SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error)
 	GetCapability(context.Context, *GetCapabilityRequest) (*GetCapabilityResponse, error)
 	RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error)
+	// GetAuditLog returns the log of mutating PFS, PPS and auth API calls. Only
+	// admins may call it
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -1069,6 +1221,24 @@ func _API_RevokeAuthToken_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.API/GetAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "auth.API",
 	HandlerType: (*APIServer)(nil),
@@ -1125,6 +1295,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAuthToken",
 			Handler:    _API_RevokeAuthToken_Handler,
 		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _API_GetAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/auth/auth.proto",
@@ -1911,6 +2085,142 @@ func (m *RevokeAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *AuditLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.Time.Size()))
+		n1, err := m.Time.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Pipeline) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Pipeline)))
+		i += copy(dAtA[i:], m.Pipeline)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *GetAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Since != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.Since.Size()))
+		n2, err := m.Since.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.Page))
+	}
+	return i, nil
+}
+
+func (m *GetAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAuth(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.TotalPages != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.TotalPages))
+	}
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.Page))
+	}
+	return i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -2231,6 +2541,70 @@ func (m *RevokeAuthTokenResponse) Size() (n int) {
 	return n
 }
 
+func (m *AuditLogEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Pipeline)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAuditLogRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAuth(uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		n += 1 + sovAuth(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *GetAuditLogResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovAuth(uint64(m.TotalPages))
+	}
+	if m.Page != 0 {
+		n += 1 + sovAuth(uint64(m.Page))
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	for {
 		n++
@@ -4650,6 +5024,474 @@ func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AuditLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &google_protobuf.Timestamp{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuditLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuditLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &google_protobuf.Timestamp{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuditLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuditLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &AuditLogEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4758,65 +5600,77 @@ var (
 func init() { proto.RegisterFile("client/auth/auth.proto", fileDescriptorAuth) }
 
 var fileDescriptorAuth = []byte{
-	// 950 bytes of a gzipped FileDescriptorProto
+	// 1147 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xf5, 0x67, 0x6a, 0x6c, 0x4b, 0xf4, 0x5a, 0x91, 0x6d, 0xb6, 0x11, 0x92, 0xcd, 0x21,
-	0x46, 0x0b, 0x38, 0x8d, 0x53, 0x23, 0x45, 0x02, 0xb4, 0x60, 0x64, 0x42, 0x65, 0xa1, 0x28, 0x06,
-	0xe9, 0xd4, 0xa7, 0x22, 0xa0, 0xa5, 0xad, 0x45, 0xd8, 0x16, 0x59, 0x71, 0x65, 0x40, 0x3d, 0x14,
-	0x7d, 0x8c, 0x3e, 0x52, 0x8f, 0x3d, 0xf4, 0x01, 0x0a, 0xf7, 0x45, 0x8a, 0xfd, 0xa3, 0x48, 0x8a,
-	0x51, 0xdd, 0x43, 0x2f, 0xf2, 0xf2, 0x9b, 0x9d, 0x6f, 0x67, 0xbf, 0xd9, 0x99, 0x31, 0xb4, 0x87,
-	0xd7, 0x01, 0x99, 0xd0, 0x67, 0xfe, 0x8c, 0x8e, 0xf9, 0xcf, 0x61, 0x34, 0x0d, 0x69, 0x88, 0x2a,
-	0x6c, 0x6d, 0xb6, 0x2e, 0xc3, 0xcb, 0x90, 0x03, 0xcf, 0xd8, 0x4a, 0xd8, 0xf0, 0x0f, 0xd0, 0xb4,
-	0x86, 0x34, 0xb8, 0xf5, 0x29, 0x71, 0xc9, 0x4f, 0x33, 0x12, 0x53, 0xf4, 0x18, 0x36, 0x2f, 0x03,
-	0x3a, 0x9e, 0x5d, 0x7c, 0xa0, 0xe1, 0x15, 0x99, 0xec, 0x69, 0x8f, 0xb4, 0x83, 0xba, 0xbb, 0x21,
-	0xb0, 0x33, 0x06, 0xa1, 0xa7, 0xd0, 0x94, 0x5b, 0x66, 0x31, 0x99, 0x4e, 0xfc, 0x1b, 0xb2, 0x57,
-	0xe2, 0xbb, 0x1a, 0x02, 0x7e, 0x2f, 0x51, 0xfc, 0x1c, 0x8c, 0x05, 0x7d, 0x1c, 0x85, 0x93, 0x98,
-	0xa0, 0x87, 0x00, 0x91, 0x3f, 0x1c, 0x67, 0xd8, 0xeb, 0x0c, 0xe1, 0xdc, 0x78, 0x07, 0xb6, 0x4f,
-	0x88, 0x9f, 0x8d, 0x09, 0xb7, 0x00, 0xa5, 0x41, 0xc1, 0x84, 0x11, 0x18, 0x3d, 0x42, 0xad, 0xd1,
-	0x4d, 0x30, 0x89, 0xd5, 0xce, 0xcf, 0x61, 0x3b, 0x85, 0xc9, 0x23, 0xdb, 0x50, 0xf3, 0x39, 0xb2,
-	0xa7, 0x3d, 0x2a, 0x1f, 0xd4, 0x5d, 0xf9, 0x85, 0xbf, 0x81, 0x9d, 0xb7, 0xe1, 0x28, 0xf8, 0x71,
-	0x9e, 0xe1, 0x40, 0x06, 0x94, 0xfd, 0xd1, 0x48, 0xee, 0x65, 0x4b, 0x46, 0x30, 0x25, 0x37, 0xe1,
-	0x2d, 0xbb, 0x27, 0x27, 0x10, 0x5f, 0xb8, 0x0d, 0xad, 0x2c, 0x81, 0x8c, 0xec, 0x17, 0xa8, 0x30,
-	0x0d, 0x90, 0x09, 0x7a, 0xa2, 0x90, 0xb8, 0x69, 0xf2, 0x8d, 0x9e, 0x42, 0x85, 0xce, 0x23, 0xa1,
-	0x5c, 0xe3, 0x68, 0xe7, 0x90, 0x67, 0x8c, 0x79, 0xf1, 0x9f, 0xb3, 0x79, 0x44, 0x5c, 0xbe, 0x01,
-	0x3f, 0x07, 0x5d, 0x21, 0x68, 0x03, 0xd6, 0x9d, 0xc1, 0xf7, 0x56, 0xdf, 0x39, 0x31, 0xd6, 0x10,
-	0x40, 0xad, 0xe7, 0x9c, 0x7d, 0xfb, 0xfe, 0x8d, 0xa1, 0xa1, 0x4d, 0xd0, 0x4f, 0x9d, 0x53, 0xbb,
-	0xef, 0x0c, 0x6c, 0xa3, 0x84, 0x7d, 0xd8, 0xb1, 0x66, 0x74, 0x4c, 0x26, 0x34, 0x18, 0xfe, 0x4f,
-	0xa9, 0x3d, 0x86, 0x56, 0xf6, 0x88, 0xfb, 0xa5, 0xb7, 0x09, 0x5b, 0xe7, 0xe3, 0xd0, 0xba, 0x71,
-	0x54, 0xc2, 0x7a, 0xd0, 0x50, 0x80, 0x64, 0x58, 0x25, 0xda, 0x3e, 0xe8, 0x41, 0xfc, 0x81, 0xa7,
-	0x8f, 0xc7, 0xa5, 0xbb, 0xeb, 0x41, 0xcc, 0xc5, 0xc7, 0xbf, 0x6a, 0x50, 0xb6, 0xba, 0x7d, 0xf4,
-	0x05, 0xac, 0x93, 0x09, 0x9d, 0x06, 0x44, 0x64, 0x7b, 0xe3, 0xa8, 0x2d, 0xa4, 0xb5, 0xba, 0xfd,
-	0x43, 0x5b, 0x18, 0xd8, 0x9f, 0xb9, 0xab, 0xb6, 0x99, 0x3d, 0xd8, 0x4c, 0x1b, 0x58, 0xfe, 0xaf,
-	0xc8, 0x5c, 0x9e, 0xcd, 0x96, 0xe8, 0x31, 0x54, 0x6f, 0xfd, 0xeb, 0x99, 0x4a, 0xd6, 0x86, 0x60,
-	0xf4, 0x86, 0x61, 0x44, 0x5c, 0x61, 0x79, 0x55, 0xfa, 0x4a, 0xc3, 0x0e, 0x18, 0x4c, 0x93, 0x70,
-	0x1a, 0xfc, 0x9c, 0x68, 0x8e, 0xa0, 0x32, 0x25, 0x51, 0x28, 0xd9, 0xf8, 0x9a, 0xd1, 0xc5, 0xcc,
-	0xb7, 0x90, 0x8e, 0x5b, 0xf0, 0x0b, 0xd8, 0x4e, 0x51, 0x49, 0x65, 0x3a, 0x00, 0xbe, 0x02, 0x47,
-	0x9c, 0x51, 0x77, 0x53, 0x08, 0xee, 0x42, 0xb3, 0x47, 0xa8, 0xe0, 0x91, 0xc7, 0xaf, 0x12, 0xb3,
-	0x05, 0x55, 0x16, 0x4e, 0x2c, 0x1f, 0xb5, 0xf8, 0xc0, 0x2f, 0x79, 0x55, 0x49, 0x12, 0x79, 0xf0,
-	0x13, 0xa8, 0xf1, 0xb0, 0x84, 0xa4, 0xb9, 0x88, 0xa5, 0x09, 0x8f, 0xa0, 0xe9, 0xfd, 0x87, 0xd3,
-	0x95, 0x30, 0xa5, 0x22, 0x61, 0xca, 0x1f, 0x15, 0x06, 0x81, 0xe1, 0xe5, 0xc2, 0xc3, 0x4f, 0x60,
-	0x8b, 0x15, 0x7d, 0xb7, 0xbf, 0x42, 0x74, 0xec, 0x80, 0x6e, 0x75, 0xfb, 0x22, 0xc3, 0xab, 0xe2,
-	0xba, 0x47, 0x72, 0x5e, 0x41, 0x43, 0x9d, 0x27, 0x05, 0x3a, 0xc8, 0x3f, 0xba, 0x46, 0xf2, 0xe8,
-	0xb2, 0x8f, 0x0d, 0xbf, 0x85, 0x2d, 0xef, 0xdf, 0x62, 0x4d, 0xd3, 0x95, 0x56, 0xd3, 0x19, 0xd0,
-	0xf0, 0x32, 0xa1, 0xb0, 0x9e, 0xd4, 0x23, 0xb4, 0xeb, 0x47, 0xfe, 0x45, 0x70, 0x1d, 0xd0, 0xb9,
-	0x2a, 0xb4, 0x97, 0xf0, 0x20, 0x87, 0x2f, 0x5e, 0xd5, 0x30, 0x41, 0x65, 0x18, 0x29, 0x04, 0x1f,
-	0x42, 0xdb, 0x25, 0xb7, 0xe1, 0x15, 0x61, 0x0f, 0x92, 0x57, 0xb1, 0x0a, 0xbd, 0x05, 0xd5, 0x74,
-	0x99, 0x8b, 0x0f, 0xbc, 0x0f, 0xbb, 0x4b, 0xfb, 0xc5, 0x51, 0x9f, 0x7d, 0x09, 0x55, 0x2e, 0x24,
-	0xd2, 0xa1, 0x32, 0x78, 0x37, 0xb0, 0x45, 0x13, 0x73, 0x6d, 0xeb, 0xc4, 0x76, 0x0d, 0x8d, 0xad,
-	0xcf, 0x5d, 0xe7, 0xcc, 0x76, 0x8d, 0x12, 0xaa, 0x43, 0xf5, 0xdd, 0xf9, 0xc0, 0x76, 0x8d, 0xf2,
-	0xd1, 0x9f, 0x35, 0x28, 0x5b, 0xa7, 0x0e, 0x7a, 0x0d, 0xba, 0x9a, 0x26, 0xe8, 0x81, 0x14, 0x24,
-	0x3b, 0x28, 0xcc, 0x76, 0x1e, 0x96, 0xa2, 0xac, 0x21, 0x0b, 0x60, 0x31, 0x42, 0xd0, 0xae, 0xd8,
-	0xb7, 0x34, 0x69, 0xcc, 0xbd, 0x65, 0x43, 0x42, 0xf1, 0x35, 0xd4, 0x93, 0xd9, 0x82, 0xe4, 0x49,
-	0xf9, 0x01, 0x64, 0xee, 0x2e, 0xe1, 0x89, 0x7f, 0x0f, 0x36, 0xd3, 0xd3, 0x02, 0xed, 0x8b, 0xad,
-	0x05, 0x23, 0xc8, 0x34, 0x8b, 0x4c, 0x69, 0xa2, 0x74, 0xef, 0x55, 0x44, 0x05, 0x2d, 0x5f, 0x11,
-	0x15, 0xb5, 0x6a, 0x71, 0xa3, 0xa4, 0xcb, 0xa8, 0x1b, 0xe5, 0x3b, 0x98, 0xba, 0xd1, 0x52, 0x3b,
-	0xc2, 0x6b, 0xe8, 0x18, 0x6a, 0xa2, 0x79, 0x23, 0x39, 0xbf, 0x32, 0xbd, 0xdd, 0x6c, 0x65, 0xc1,
-	0xc4, 0xed, 0x35, 0xe8, 0xaa, 0xc5, 0xa8, 0x44, 0xe6, 0xfa, 0x96, 0xd9, 0xce, 0xc3, 0x69, 0x67,
-	0x2f, 0xe7, 0xec, 0x15, 0x3b, 0x7b, 0xcb, 0xce, 0xc7, 0x50, 0x13, 0x95, 0xab, 0x02, 0xce, 0xf4,
-	0x0d, 0x15, 0x70, 0xb6, 0xb8, 0x85, 0x9b, 0x97, 0x71, 0xf3, 0x8a, 0xdc, 0xbc, 0xbc, 0xdb, 0x77,
-	0xbc, 0x2f, 0x2d, 0x4a, 0x0e, 0x99, 0x09, 0xff, 0x52, 0x7d, 0x9a, 0x9f, 0x14, 0xda, 0x12, 0xae,
-	0x53, 0x68, 0xe6, 0xaa, 0x0a, 0x7d, 0x2a, 0x3c, 0x8a, 0x8b, 0xd3, 0x7c, 0xf8, 0x11, 0xab, 0x62,
-	0x7c, 0x63, 0xfc, 0x7e, 0xd7, 0xd1, 0xfe, 0xb8, 0xeb, 0x68, 0x7f, 0xdd, 0x75, 0xb4, 0xdf, 0xfe,
-	0xee, 0xac, 0x5d, 0xd4, 0xf8, 0x3f, 0x85, 0x2f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x69, 0x1c,
-	0x8b, 0xec, 0x4a, 0x0a, 0x00, 0x00,
+	0x10, 0xb6, 0x7e, 0x4d, 0x8d, 0x6c, 0x89, 0x5e, 0x2b, 0xb2, 0xcc, 0x34, 0x4e, 0xb2, 0x39, 0xc4,
+	0x68, 0x51, 0x39, 0x71, 0x6a, 0xa4, 0x48, 0x80, 0x16, 0x8c, 0x2c, 0xa8, 0x2a, 0x14, 0xc7, 0x20,
+	0x9d, 0xfa, 0x54, 0x18, 0xb4, 0xb4, 0x91, 0x08, 0x4b, 0x22, 0x2b, 0xae, 0x5c, 0xc8, 0x87, 0xa2,
+	0x8f, 0x51, 0xf4, 0x4d, 0xfa, 0x06, 0x3d, 0xf6, 0x11, 0x0a, 0xf7, 0x45, 0x8a, 0xfd, 0xa3, 0x49,
+	0x8a, 0x71, 0xdd, 0x43, 0x2e, 0xd2, 0xee, 0x37, 0x33, 0xdf, 0xcc, 0xce, 0xcc, 0xee, 0x10, 0xea,
+	0xfd, 0xb1, 0x4b, 0xa6, 0x74, 0xcf, 0x99, 0xd3, 0x11, 0xff, 0x69, 0xfa, 0x33, 0x8f, 0x7a, 0x28,
+	0xcf, 0xd6, 0x46, 0x6d, 0xe8, 0x0d, 0x3d, 0x0e, 0xec, 0xb1, 0x95, 0x90, 0x19, 0x0f, 0x87, 0x9e,
+	0x37, 0x1c, 0x93, 0x3d, 0xbe, 0x3b, 0x9f, 0x7f, 0xd8, 0xa3, 0xee, 0x84, 0x04, 0xd4, 0x99, 0xf8,
+	0x42, 0x01, 0xff, 0x08, 0x55, 0xb3, 0x4f, 0xdd, 0x4b, 0x87, 0x12, 0x8b, 0xfc, 0x34, 0x27, 0x01,
+	0x45, 0x8f, 0x61, 0x6d, 0xe8, 0xd2, 0xd1, 0xfc, 0xfc, 0x8c, 0x7a, 0x17, 0x64, 0xda, 0xc8, 0x3c,
+	0xca, 0xec, 0x96, 0xac, 0xb2, 0xc0, 0x4e, 0x18, 0x84, 0x9e, 0x42, 0x55, 0xaa, 0xcc, 0x03, 0x32,
+	0x9b, 0x3a, 0x13, 0xd2, 0xc8, 0x72, 0xad, 0x8a, 0x80, 0xdf, 0x4b, 0x14, 0x3f, 0x07, 0xfd, 0x86,
+	0x3e, 0xf0, 0xbd, 0x69, 0x40, 0xd0, 0x03, 0x00, 0xdf, 0xe9, 0x8f, 0x62, 0xec, 0x25, 0x86, 0x70,
+	0x6e, 0xbc, 0x09, 0x1b, 0x87, 0xc4, 0x89, 0xc7, 0x84, 0x6b, 0x80, 0xa2, 0xa0, 0x60, 0xc2, 0x08,
+	0xf4, 0x0e, 0xa1, 0xe6, 0x60, 0xe2, 0x4e, 0x03, 0xa5, 0xf9, 0x05, 0x6c, 0x44, 0x30, 0xe9, 0xb2,
+	0x0e, 0x45, 0x87, 0x23, 0x8d, 0xcc, 0xa3, 0xdc, 0x6e, 0xc9, 0x92, 0x3b, 0xfc, 0x2d, 0x6c, 0xbe,
+	0xf5, 0x06, 0xee, 0x87, 0x45, 0x8c, 0x03, 0xe9, 0x90, 0x73, 0x06, 0x03, 0xa9, 0xcb, 0x96, 0x8c,
+	0x60, 0x46, 0x26, 0xde, 0x25, 0x3b, 0x27, 0x27, 0x10, 0x3b, 0x5c, 0x87, 0x5a, 0x9c, 0x40, 0x46,
+	0xf6, 0x0b, 0xe4, 0x59, 0x0e, 0x90, 0x01, 0x5a, 0x98, 0x21, 0x71, 0xd2, 0x70, 0x8f, 0x9e, 0x42,
+	0x9e, 0x2e, 0x7c, 0x91, 0xb9, 0xca, 0xfe, 0x66, 0x93, 0x97, 0x94, 0x59, 0xf1, 0x9f, 0x93, 0x85,
+	0x4f, 0x2c, 0xae, 0x80, 0x9f, 0x83, 0xa6, 0x10, 0x54, 0x86, 0xd5, 0xee, 0xd1, 0x0f, 0x66, 0xaf,
+	0x7b, 0xa8, 0xaf, 0x20, 0x80, 0x62, 0xa7, 0x7b, 0xf2, 0xdd, 0xfb, 0x37, 0x7a, 0x06, 0xad, 0x81,
+	0x76, 0xdc, 0x3d, 0x6e, 0xf7, 0xba, 0x47, 0x6d, 0x3d, 0x8b, 0x1d, 0xd8, 0x34, 0xe7, 0x74, 0x44,
+	0xa6, 0xd4, 0xed, 0x7f, 0xa2, 0xd2, 0x1e, 0x40, 0x2d, 0xee, 0xe2, 0x6e, 0xe5, 0xad, 0xc2, 0xfa,
+	0xe9, 0xc8, 0x33, 0x27, 0x5d, 0x55, 0xb0, 0x0e, 0x54, 0x14, 0x20, 0x19, 0x6e, 0x4b, 0xda, 0x36,
+	0x68, 0x6e, 0x70, 0xc6, 0xcb, 0xc7, 0xe3, 0xd2, 0xac, 0x55, 0x37, 0xe0, 0xc9, 0xc7, 0xbf, 0x66,
+	0x20, 0x67, 0xb6, 0x7a, 0xe8, 0x19, 0xac, 0x92, 0x29, 0x9d, 0xb9, 0x44, 0x54, 0xbb, 0xbc, 0x5f,
+	0x17, 0xa9, 0x35, 0x5b, 0xbd, 0x66, 0x5b, 0x08, 0xd8, 0xdf, 0xc2, 0x52, 0x6a, 0x46, 0x07, 0xd6,
+	0xa2, 0x02, 0x56, 0xff, 0x0b, 0xb2, 0x90, 0xbe, 0xd9, 0x12, 0x3d, 0x86, 0xc2, 0xa5, 0x33, 0x9e,
+	0xab, 0x62, 0x95, 0x05, 0xa3, 0xdd, 0xf7, 0x7c, 0x62, 0x09, 0xc9, 0xab, 0xec, 0xd7, 0x19, 0xdc,
+	0x05, 0x9d, 0xe5, 0xc4, 0x9b, 0xb9, 0x57, 0x61, 0xce, 0x11, 0xe4, 0x67, 0xc4, 0xf7, 0x24, 0x1b,
+	0x5f, 0x33, 0xba, 0x80, 0xd9, 0xa6, 0xd2, 0x71, 0x09, 0x7e, 0x01, 0x1b, 0x11, 0x2a, 0x99, 0x99,
+	0x1d, 0x00, 0x47, 0x81, 0x03, 0xce, 0xa8, 0x59, 0x11, 0x04, 0xb7, 0xa0, 0xda, 0x21, 0x54, 0xf0,
+	0x48, 0xf7, 0xb7, 0x25, 0xb3, 0x06, 0x05, 0x16, 0x4e, 0x20, 0x9b, 0x5a, 0x6c, 0xf0, 0x4b, 0xd0,
+	0x6f, 0x48, 0xa4, 0xe3, 0x27, 0x50, 0xe4, 0x61, 0x89, 0x94, 0x26, 0x22, 0x96, 0x22, 0x3c, 0x80,
+	0xaa, 0xfd, 0x3f, 0xbc, 0xab, 0xc4, 0x64, 0xd3, 0x12, 0x93, 0xfb, 0x68, 0x62, 0x10, 0xe8, 0x76,
+	0x22, 0x3c, 0xfc, 0x04, 0xd6, 0xd9, 0xa5, 0x6f, 0xf5, 0x6e, 0x49, 0x3a, 0xee, 0x82, 0x66, 0xb6,
+	0x7a, 0xa2, 0xc2, 0xb7, 0xc5, 0x75, 0x87, 0xe2, 0xbc, 0x82, 0x8a, 0xf2, 0x27, 0x13, 0xb4, 0x9b,
+	0x6c, 0xba, 0x4a, 0xd8, 0x74, 0xf1, 0x66, 0xc3, 0x6f, 0x61, 0xdd, 0xfe, 0xaf, 0x58, 0xa3, 0x74,
+	0xd9, 0xdb, 0xe9, 0x74, 0xa8, 0xd8, 0xb1, 0x50, 0xd8, 0x9b, 0xd4, 0x21, 0xb4, 0xe5, 0xf8, 0xce,
+	0xb9, 0x3b, 0x76, 0xe9, 0x42, 0x5d, 0xb4, 0x97, 0x70, 0x2f, 0x81, 0xdf, 0x74, 0x55, 0x3f, 0x44,
+	0x65, 0x18, 0x11, 0x04, 0x37, 0xa1, 0x6e, 0x91, 0x4b, 0xef, 0x82, 0xb0, 0x86, 0xe4, 0xb7, 0x58,
+	0x85, 0x5e, 0x83, 0x42, 0xf4, 0x9a, 0x8b, 0x0d, 0xde, 0x86, 0xad, 0x25, 0x7d, 0x19, 0xdb, 0x1f,
+	0x19, 0x58, 0x37, 0xe7, 0x03, 0x97, 0xf6, 0xbc, 0xa1, 0xa8, 0x44, 0x13, 0xf2, 0x6c, 0x26, 0x71,
+	0x86, 0xf2, 0xbe, 0xd1, 0x14, 0x03, 0xab, 0xa9, 0x06, 0x56, 0xf3, 0x44, 0x0d, 0x2c, 0x8b, 0xeb,
+	0xc5, 0x2a, 0x97, 0x4d, 0x54, 0xae, 0x0e, 0xc5, 0x09, 0xa1, 0x23, 0x6f, 0xc0, 0xdb, 0xa7, 0x64,
+	0xc9, 0x5d, 0x98, 0xe1, 0x7c, 0x24, 0xc3, 0x06, 0x68, 0xbe, 0xeb, 0x93, 0xb1, 0x3b, 0x25, 0x8d,
+	0x82, 0xe0, 0x51, 0x7b, 0x76, 0x2c, 0x32, 0x9b, 0x79, 0xb3, 0x46, 0x51, 0x1c, 0x8b, 0x6f, 0xf0,
+	0xcf, 0x80, 0x58, 0xd1, 0x65, 0xf4, 0x2a, 0x05, 0xcf, 0xa0, 0x10, 0xb8, 0xd3, 0xfe, 0x5d, 0x0e,
+	0x20, 0x14, 0xd1, 0x7d, 0x28, 0xf9, 0xce, 0x90, 0x9c, 0x05, 0xee, 0x95, 0x38, 0x42, 0xce, 0xd2,
+	0x18, 0x60, 0xbb, 0x57, 0xfc, 0x52, 0xb0, 0x35, 0x3f, 0x40, 0xce, 0xe2, 0x6b, 0xbc, 0x80, 0xcd,
+	0x98, 0x63, 0x59, 0xb6, 0x2f, 0x93, 0x2d, 0x27, 0x47, 0x48, 0x2c, 0xbf, 0x61, 0xa3, 0xa0, 0x87,
+	0x50, 0xa6, 0x1e, 0x75, 0xc6, 0x67, 0x8c, 0x33, 0x90, 0x8e, 0x81, 0x43, 0xc7, 0x0c, 0x49, 0x73,
+	0xfd, 0xf9, 0x57, 0x50, 0xe0, 0x8d, 0x8f, 0x34, 0xc8, 0x1f, 0xbd, 0x3b, 0x6a, 0x8b, 0xa1, 0x63,
+	0xb5, 0xcd, 0xc3, 0xb6, 0xa5, 0x67, 0xd8, 0xfa, 0xd4, 0xea, 0x9e, 0xb4, 0x2d, 0x3d, 0x8b, 0x4a,
+	0x50, 0x78, 0x77, 0x7a, 0xd4, 0xb6, 0xf4, 0xdc, 0xfe, 0xef, 0xab, 0x90, 0x33, 0x8f, 0xbb, 0xe8,
+	0x35, 0x68, 0x6a, 0xfa, 0xa3, 0x7b, 0x32, 0xb8, 0xf8, 0x60, 0x37, 0xea, 0x49, 0x58, 0x36, 0xca,
+	0x0a, 0x32, 0x01, 0x6e, 0x46, 0x3e, 0xda, 0x12, 0x7a, 0x4b, 0x5f, 0x06, 0x46, 0x63, 0x59, 0x10,
+	0x52, 0x7c, 0x03, 0xa5, 0xf0, 0x5b, 0x00, 0x49, 0x4f, 0xc9, 0x0f, 0x06, 0x63, 0x6b, 0x09, 0x0f,
+	0xed, 0x3b, 0xb0, 0x16, 0x9d, 0xee, 0x68, 0x5b, 0xa8, 0xa6, 0x7c, 0x32, 0x18, 0x46, 0x9a, 0x28,
+	0x4a, 0x14, 0x9d, 0x95, 0x8a, 0x28, 0x65, 0x44, 0x1b, 0x46, 0x9a, 0x28, 0x7a, 0xa2, 0x70, 0x2a,
+	0xa8, 0x13, 0x25, 0x27, 0x8e, 0xb1, 0xb5, 0x84, 0x87, 0xf6, 0x07, 0x50, 0x14, 0xc3, 0x16, 0xc9,
+	0x66, 0x89, 0xcd, 0x62, 0xa3, 0x16, 0x07, 0x43, 0xb3, 0xd7, 0xa0, 0xa9, 0x91, 0xa0, 0x0a, 0x99,
+	0x98, 0x33, 0x46, 0x3d, 0x09, 0x47, 0x8d, 0xed, 0x84, 0xb1, 0x9d, 0x6e, 0x6c, 0x2f, 0x1b, 0x1f,
+	0x40, 0x51, 0xbc, 0xb4, 0x2a, 0xe0, 0xd8, 0x3b, 0x6f, 0xd4, 0xe2, 0x60, 0xd4, 0xcc, 0x8e, 0x99,
+	0xd9, 0x69, 0x66, 0x76, 0xd2, 0xec, 0x7b, 0x58, 0x8f, 0x3d, 0x91, 0xc8, 0x08, 0xf9, 0x97, 0xde,
+	0x53, 0xe3, 0x7e, 0xaa, 0x2c, 0xe4, 0x3a, 0x86, 0x6a, 0xe2, 0x15, 0x44, 0x9f, 0x09, 0x8b, 0xf4,
+	0xc7, 0xd4, 0x78, 0xf0, 0x11, 0x69, 0xc8, 0x78, 0x08, 0xe5, 0xc8, 0x3b, 0x80, 0x1a, 0x37, 0x67,
+	0x8f, 0xbf, 0x49, 0xc6, 0x76, 0x8a, 0x44, 0xb1, 0xbc, 0xd1, 0xff, 0xbc, 0xde, 0xc9, 0xfc, 0x75,
+	0xbd, 0x93, 0xf9, 0xfb, 0x7a, 0x27, 0xf3, 0xdb, 0x3f, 0x3b, 0x2b, 0xe7, 0x45, 0xfe, 0x56, 0xbd,
+	0xf8, 0x77, 0x00, 0xba, 0xde, 0x93, 0xf4, 0x61, 0x0c, 0x00, 0x00,
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -15,12 +16,14 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/debug"
 	"github.com/pachyderm/pachyderm/src/client/deploy"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/health"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 )
 
@@ -55,6 +58,7 @@ type APIClient struct {
 	AuthAPIClient
 	DeployAPIClient
 	Enterprise enterprise.APIClient // not embedded--method name conflicts with AuthAPIClient
+	Debug      debug.APIClient      // not embedded--Dump conflicts with APIClient.Dump
 
 	// addr is a "host:port" string pointing at a pachd endpoint
 	addr string
@@ -208,6 +212,33 @@ func (c APIClient) DeleteAll() error {
 	return nil
 }
 
+// HealthDetailed probes each of pachd's dependencies (etcd, object storage,
+// etc.) and returns the status of each.
+func (c APIClient) HealthDetailed() ([]*health.ComponentHealth, error) {
+	resp, err := c.healthClient.HealthDetailed(
+		c.Ctx(),
+		&types.Empty{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Components, nil
+}
+
+// Dump writes a gzipped tarball of debugging information about the cluster
+// to w. logLines is the number of recent log lines to include from each
+// pipeline; if 0, pachd picks a default.
+func (c APIClient) Dump(logLines int64, w io.Writer) error {
+	dumpClient, err := c.Debug.Dump(
+		c.Ctx(),
+		&debug.DumpRequest{LogLines: logLines},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return grpcutil.ScrubGRPC(grpcutil.WriteFromStreamingBytesClient(dumpClient, w))
+}
+
 // SetMaxConcurrentStreams Sets the maximum number of concurrent streams the
 // client can have. It is not safe to call this operations while operations are
 // outstanding.
@@ -238,10 +269,15 @@ func EtcdDialOptions() []grpc.DialOption {
 // - TLS is disabled
 // - Dial is synchronous: the call doesn't return until the connection has been
 //                        established and it's safe to send RPCs
+// - The trace carried by each RPC's context, if any, is propagated to the server
 //
 // This is primarily useful for Pachd and Worker clients
 func PachDialOptions() []grpc.DialOption {
-	return append(EtcdDialOptions(), grpc.WithInsecure())
+	return append(EtcdDialOptions(),
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(tracing.StreamClientInterceptor()),
+	)
 }
 
 func (c *APIClient) connect() error {
@@ -261,6 +297,7 @@ func (c *APIClient) connect() error {
 	c.AuthAPIClient = auth.NewAPIClient(clientConn)
 	c.Enterprise = enterprise.NewAPIClient(clientConn)
 	c.DeployAPIClient = deploy.NewAPIClient(clientConn)
+	c.Debug = debug.NewAPIClient(clientConn)
 	c.clientConn = clientConn
 	c.healthClient = health.NewHealthClient(clientConn)
 	return nil
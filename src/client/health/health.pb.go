@@ -8,6 +8,8 @@ It is generated from these files:
 	client/health/health.proto
 
 It has these top-level messages:
+	ComponentHealth
+	HealthDetailedResponse
 */
 package health
 
@@ -15,10 +17,14 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import google_protobuf "github.com/gogo/protobuf/types"
+import google_protobuf1 "github.com/gogo/protobuf/types"
+import google_protobuf2 "github.com/gogo/protobuf/types"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
 
+import io "io"
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
@@ -30,6 +36,99 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// ComponentHealth is the result of probing one of pachd's dependencies.
+type ComponentHealth struct {
+	// name identifies the dependency, e.g. "etcd" or "object-storage".
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// required is true if pachd can't serve requests while this component
+	// is unhealthy, in which case pachd's readiness check fails.
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// latency is how long the probe took.
+	Latency *google_protobuf1.Duration `protobuf:"bytes,4,opt,name=latency" json:"latency,omitempty"`
+	// error is the error returned by the probe, if it failed.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// last_error and last_error_time record the most recent failed probe of
+	// this component, even if it has since recovered.
+	LastError     string                      `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime *google_protobuf2.Timestamp `protobuf:"bytes,7,opt,name=last_error_time,json=lastErrorTime" json:"last_error_time,omitempty"`
+}
+
+func (m *ComponentHealth) Reset()                    { *m = ComponentHealth{} }
+func (m *ComponentHealth) String() string            { return proto.CompactTextString(m) }
+func (*ComponentHealth) ProtoMessage()               {}
+func (*ComponentHealth) Descriptor() ([]byte, []int) { return fileDescriptorHealth, []int{0} }
+
+func (m *ComponentHealth) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ComponentHealth) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *ComponentHealth) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *ComponentHealth) GetLatency() *google_protobuf1.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+func (m *ComponentHealth) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ComponentHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ComponentHealth) GetLastErrorTime() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return nil
+}
+
+type HealthDetailedResponse struct {
+	Components []*ComponentHealth `protobuf:"bytes,1,rep,name=components" json:"components,omitempty"`
+}
+
+func (m *HealthDetailedResponse) Reset()                    { *m = HealthDetailedResponse{} }
+func (m *HealthDetailedResponse) String() string            { return proto.CompactTextString(m) }
+func (*HealthDetailedResponse) ProtoMessage()               {}
+func (*HealthDetailedResponse) Descriptor() ([]byte, []int) { return fileDescriptorHealth, []int{1} }
+
+func (m *HealthDetailedResponse) GetComponents() []*ComponentHealth {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ComponentHealth)(nil), "health.ComponentHealth")
+	proto.RegisterType((*HealthDetailedResponse)(nil), "health.HealthDetailedResponse")
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -42,6 +141,8 @@ const _ = grpc.SupportPackageIsVersion4
 
 type HealthClient interface {
 	Health(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// HealthDetailed probes each of pachd's dependencies and reports their status.
+	HealthDetailed(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*HealthDetailedResponse, error)
 }
 
 type healthClient struct {
@@ -61,10 +162,21 @@ func (c *healthClient) Health(ctx context.Context, in *google_protobuf.Empty, op
 	return out, nil
 }
 
+func (c *healthClient) HealthDetailed(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*HealthDetailedResponse, error) {
+	out := new(HealthDetailedResponse)
+	err := grpc.Invoke(ctx, "/health.Health/HealthDetailed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Health service
 
 type HealthServer interface {
 	Health(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	// HealthDetailed probes each of pachd's dependencies and reports their status.
+	HealthDetailed(context.Context, *google_protobuf.Empty) (*HealthDetailedResponse, error)
 }
 
 func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
@@ -89,6 +201,24 @@ func _Health_Health_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Health_HealthDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).HealthDetailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/health.Health/HealthDetailed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).HealthDetailed(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Health_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "health.Health",
 	HandlerType: (*HealthServer)(nil),
@@ -97,21 +227,644 @@ var _Health_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Health",
 			Handler:    _Health_Health_Handler,
 		},
+		{
+			MethodName: "HealthDetailed",
+			Handler:    _Health_HealthDetailed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/health/health.proto",
 }
 
+func (m *ComponentHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComponentHealth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Healthy {
+		dAtA[i] = 0x10
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Required {
+		dAtA[i] = 0x18
+		i++
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Latency != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHealth(dAtA, i, uint64(m.Latency.Size()))
+		n1, err := m.Latency.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if m.LastErrorTime != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintHealth(dAtA, i, uint64(m.LastErrorTime.Size()))
+		n2, err := m.LastErrorTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *HealthDetailedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthDetailedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, msg := range m.Components {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHealth(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintHealth(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+
+func (m *ComponentHealth) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.Required {
+		n += 2
+	}
+	if m.Latency != nil {
+		l = m.Latency.Size()
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.LastErrorTime != nil {
+		l = m.LastErrorTime.Size()
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	return n
+}
+
+func (m *HealthDetailedResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovHealth(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovHealth(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+
+func sozHealth(x uint64) (n int) {
+	return sovHealth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *ComponentHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Latency == nil {
+				m.Latency = &google_protobuf1.Duration{}
+			}
+			if err := m.Latency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorTime == nil {
+				m.LastErrorTime = &google_protobuf2.Timestamp{}
+			}
+			if err := m.LastErrorTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthDetailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthDetailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthDetailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, &ComponentHealth{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHealth(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthHealth
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowHealth
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipHealth(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthHealth = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHealth   = fmt.Errorf("proto: integer overflow")
+)
+
 func init() { proto.RegisterFile("client/health/health.proto", fileDescriptorHealth) }
 
 var fileDescriptorHealth = []byte{
-	// 123 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0xc9, 0x4c,
-	0xcd, 0x2b, 0xd1, 0xcf, 0x48, 0x4d, 0xcc, 0x29, 0xc9, 0x80, 0x52, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x6c, 0x10, 0x9e, 0x94, 0x74, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x58, 0x34,
-	0xa9, 0x34, 0x4d, 0x3f, 0x35, 0xb7, 0xa0, 0xa4, 0x12, 0xa2, 0xc8, 0xc8, 0x85, 0x8b, 0xcd, 0x03,
-	0xac, 0x4c, 0xc8, 0x0a, 0xce, 0x12, 0xd3, 0x83, 0xe8, 0xd0, 0x83, 0xe9, 0xd0, 0x73, 0x05, 0xe9,
-	0x90, 0xc2, 0x21, 0xae, 0xc4, 0xe0, 0x24, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0xce, 0x78, 0x2c, 0xc7, 0x90, 0xc4, 0x06, 0x56, 0x63, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0xc0, 0x34, 0x51, 0x03, 0xa1, 0x00, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0x19, 0xfe, 0x14, 0x38, 0xe4, 0x5e, 0x6e, 0x26, 0x37, 0x38, 0xd6, 0x58, 0x9b, 0xae,
+	0xba, 0x2a, 0x09, 0x2c, 0x4c, 0x5c, 0x22, 0x24, 0xc6, 0x9d, 0x8d, 0x7b, 0x52, 0xe0, 0x08, 0x4d,
+	0xda, 0x4e, 0x9d, 0x0e, 0x0b, 0x1e, 0xc1, 0x9d, 0x4b, 0x1f, 0xc9, 0xa5, 0x8f, 0x60, 0xf0, 0x45,
+	0x4c, 0x67, 0x5a, 0x54, 0x90, 0x55, 0xe7, 0x9c, 0xdf, 0xe9, 0x7c, 0xdf, 0x7c, 0x07, 0xcc, 0x79,
+	0x14, 0x62, 0x22, 0xfb, 0x2b, 0x0c, 0x22, 0xb9, 0x2a, 0x3e, 0x5e, 0x2a, 0xb8, 0xe4, 0xd4, 0xd0,
+	0x95, 0x79, 0xb6, 0xe4, 0x7c, 0x19, 0x61, 0x5f, 0x75, 0x67, 0xeb, 0x87, 0x3e, 0xc6, 0xa9, 0xdc,
+	0xe8, 0x21, 0xd3, 0xda, 0x87, 0x8b, 0xb5, 0x08, 0x64, 0xc8, 0x93, 0x82, 0x5f, 0xec, 0x73, 0x19,
+	0xc6, 0x98, 0xc9, 0x20, 0x4e, 0xf5, 0x80, 0xf3, 0x54, 0x85, 0xee, 0x35, 0x8f, 0x53, 0x9e, 0x60,
+	0x22, 0x6f, 0x94, 0x22, 0xa5, 0x50, 0x4f, 0x82, 0x18, 0x19, 0xb1, 0x89, 0xdb, 0xf6, 0xd5, 0x99,
+	0x32, 0x68, 0x6a, 0x3f, 0x1b, 0x56, 0xb5, 0x89, 0xdb, 0xf2, 0xcb, 0x92, 0x9a, 0xd0, 0x12, 0xf8,
+	0xb8, 0x0e, 0x05, 0x2e, 0x58, 0x4d, 0xa1, 0x5d, 0x4d, 0x87, 0xd0, 0x8c, 0x02, 0x89, 0xc9, 0x7c,
+	0xc3, 0xea, 0x36, 0x71, 0x3b, 0x83, 0x53, 0x4f, 0x1b, 0xf2, 0x4a, 0x43, 0xde, 0xb8, 0x30, 0xec,
+	0x97, 0x93, 0xf4, 0x3f, 0x34, 0x50, 0x08, 0x2e, 0x58, 0x43, 0xe9, 0xeb, 0x82, 0x9e, 0x03, 0x44,
+	0x41, 0x26, 0xa7, 0x1a, 0x19, 0x0a, 0xb5, 0xf3, 0xce, 0x44, 0xe1, 0x11, 0x74, 0xbf, 0xf0, 0x34,
+	0x7f, 0x25, 0x6b, 0x2a, 0x45, 0xf3, 0x40, 0xf1, 0xbe, 0x8c, 0xc0, 0xff, 0xb3, 0xfb, 0x3f, 0xef,
+	0x39, 0x77, 0xd0, 0xd3, 0x09, 0x8c, 0x51, 0x06, 0x61, 0x84, 0x0b, 0x1f, 0xb3, 0x94, 0x27, 0x19,
+	0xd2, 0x4b, 0x80, 0x79, 0x19, 0x52, 0xc6, 0x88, 0x5d, 0x73, 0x3b, 0x83, 0x13, 0xaf, 0x58, 0xd7,
+	0x5e, 0x7c, 0xfe, 0xb7, 0xd1, 0xc1, 0x33, 0x01, 0xa3, 0x48, 0xf5, 0x6a, 0x77, 0xea, 0x1d, 0x58,
+	0x9a, 0xe4, 0x2b, 0x35, 0x8f, 0xf4, 0x9d, 0x0a, 0xbd, 0x85, 0xbf, 0x3f, 0x9d, 0x1d, 0xbd, 0xc3,
+	0x2a, 0x5d, 0xfd, 0xfe, 0x12, 0xa7, 0x32, 0xfa, 0xf7, 0xba, 0xb5, 0xc8, 0xdb, 0xd6, 0x22, 0xef,
+	0x5b, 0x8b, 0xbc, 0x7c, 0x58, 0x95, 0x99, 0xa1, 0xee, 0x18, 0x7e, 0x0e, 0x00, 0xb5, 0xf9, 0xcb,
+	0xe9, 0x8e, 0x02, 0x00, 0x00,
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+
+	"google.golang.org/grpc/metadata"
 )
 
 // NewRepo creates a pfs.Repo.
@@ -24,6 +26,14 @@ func NewCommit(repoName string, commitID string) *pfs.Commit {
 	}
 }
 
+// NewBranch creates a pfs.Branch.
+func NewBranch(repoName string, branchName string) *pfs.Branch {
+	return &pfs.Branch{
+		Repo: NewRepo(repoName),
+		Name: branchName,
+	}
+}
+
 // NewFile creates a pfs.File.
 func NewFile(repoName string, commitID string, path string) *pfs.File {
 	return &pfs.File{
@@ -104,6 +114,34 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// SetQuota sets the size limits of a Repo. Writes that would take the Repo
+// over a hard limit fail, while exceeding a soft limit only produces a warning
+// in InspectRepo. Passing nil removes the Repo's quota.
+func (c APIClient) SetQuota(repoName string, quota *pfs.Quota) error {
+	_, err := c.PfsAPIClient.SetQuota(
+		c.Ctx(),
+		&pfs.SetQuotaRequest{
+			Repo:  NewRepo(repoName),
+			Quota: quota,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetBranchQuota sets the size limits of a branch, which apply to the
+// branch's head commit, in the same way as SetQuota does for a Repo.
+func (c APIClient) SetBranchQuota(repoName string, branch string, quota *pfs.Quota) error {
+	_, err := c.PfsAPIClient.SetQuota(
+		c.Ctx(),
+		&pfs.SetQuotaRequest{
+			Repo:   NewRepo(repoName),
+			Branch: branch,
+			Quota:  quota,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -195,6 +233,20 @@ func (c APIClient) FinishCommit(repoName string, commitID string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// FinishCommitTree finishes a commit with the hashtree 'tree' (an object
+// written with PutObject) as its contents, in place of the files that were
+// put into the commit.
+func (c APIClient) FinishCommitTree(repoName string, commitID string, tree *pfs.Object) error {
+	_, err := c.PfsAPIClient.FinishCommit(
+		c.Ctx(),
+		&pfs.FinishCommitRequest{
+			Commit: NewCommit(repoName, commitID),
+			Tree:   tree,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // InspectCommit returns info about a specific Commit.
 func (c APIClient) InspectCommit(repoName string, commitID string) (*pfs.CommitInfo, error) {
 	commitInfo, err := c.PfsAPIClient.InspectCommit(
@@ -277,6 +329,54 @@ func (c APIClient) SetBranch(repoName string, commit string, branch string) erro
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateBranch creates a new branch, or updates an existing one. commit is
+// the branch's new head, and may be empty, in which case the head of an
+// existing branch is left alone. provenance is the set of branches that the
+// branch depends on; whenever the head of one of them moves to a finished
+// commit, PFS opens a new commit on the branch. It may also be empty, in which
+// case the provenance of an existing branch is left alone (see
+// ClearBranchProvenance).
+func (c APIClient) CreateBranch(repoName string, branch string, commit string, provenance []*pfs.Branch) error {
+	var head *pfs.Commit
+	if commit != "" {
+		head = NewCommit(repoName, commit)
+	}
+	_, err := c.PfsAPIClient.CreateBranch(
+		c.Ctx(),
+		&pfs.CreateBranchRequest{
+			Branch:     NewBranch(repoName, branch),
+			Head:       head,
+			Provenance: provenance,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ClearBranchProvenance removes all of the provenance of an existing branch,
+// so that PFS no longer opens commits on it.
+func (c APIClient) ClearBranchProvenance(repoName string, branch string) error {
+	_, err := c.PfsAPIClient.CreateBranch(
+		c.Ctx(),
+		&pfs.CreateBranchRequest{
+			Branch:          NewBranch(repoName, branch),
+			ClearProvenance: true,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectBranch returns information about a branch, including its head and
+// its provenance.
+func (c APIClient) InspectBranch(repoName string, branch string) (*pfs.BranchInfo, error) {
+	branchInfo, err := c.PfsAPIClient.InspectBranch(
+		c.Ctx(),
+		&pfs.InspectBranchRequest{
+			Branch: NewBranch(repoName, branch),
+		},
+	)
+	return branchInfo, grpcutil.ScrubGRPC(err)
+}
+
 // DeleteBranch deletes a branch, but leaves the commits themselves intact.
 // In other words, those commits can still be accessed via commit IDs and
 // other branches they happen to be on.
@@ -302,6 +402,55 @@ func (c APIClient) DeleteCommit(repoName string, commitID string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// SquashCommit removes a finished commit from its repo's history. The
+// commit's changes are kept in its children, which become children of the
+// commit's parent.
+func (c APIClient) SquashCommit(repoName string, commitID string) error {
+	_, err := c.PfsAPIClient.SquashCommit(
+		c.Ctx(),
+		&pfs.SquashCommitRequest{
+			Commit: NewCommit(repoName, commitID),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// RevertCommit adds a new commit to branch that undoes the changes made by
+// the given commit, and returns the new commit.
+func (c APIClient) RevertCommit(repoName string, commitID string, branch string) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.RevertCommit(
+		c.Ctx(),
+		&pfs.RevertCommitRequest{
+			Commit: NewCommit(repoName, commitID),
+			Branch: branch,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commit, nil
+}
+
+// MergeBranch merges the head of fromBranch into toBranch, creating a new
+// commit on toBranch whose parents are both heads. If the branches made
+// conflicting changes, no commit is created and the conflicts are returned
+// in the response instead.
+func (c APIClient) MergeBranch(repoName string, fromBranch string, toBranch string, description string) (*pfs.MergeBranchResponse, error) {
+	response, err := c.PfsAPIClient.MergeBranch(
+		c.Ctx(),
+		&pfs.MergeBranchRequest{
+			Repo:        NewRepo(repoName),
+			FromBranch:  fromBranch,
+			ToBranch:    toBranch,
+			Description: description,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
 // FlushCommit returns an iterator that returns commits that have the
 // specified `commits` as provenance.  Note that the iterator can block if
 // jobs have not successfully completed. This in effect waits for all of the
@@ -359,10 +508,18 @@ func (c *commitInfoIterator) Close() {
 // SubscribeCommit is like ListCommit but it keeps listening for commits as
 // they come in.
 func (c APIClient) SubscribeCommit(repo string, branch string, from string) (CommitInfoIterator, error) {
+	return c.SubscribeCommitState(repo, branch, from, pfs.CommitState_FINISHED)
+}
+
+// SubscribeCommitState is like SubscribeCommit, but returns the commits once
+// they reach 'state', e.g. pfs.CommitState_STARTED returns commits as soon as
+// they're started rather than once they're finished.
+func (c APIClient) SubscribeCommitState(repo string, branch string, from string, state pfs.CommitState) (CommitInfoIterator, error) {
 	ctx, cancel := context.WithCancel(c.Ctx())
 	req := &pfs.SubscribeCommitRequest{
 		Repo:   NewRepo(repo),
 		Branch: branch,
+		State:  state,
 	}
 	if from != "" {
 		req.From = NewCommit(repo, from)
@@ -576,6 +733,29 @@ func (c APIClient) PutFile(repoName string, commitID string, path string, reader
 	return c.PutFileSplit(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, false, reader)
 }
 
+// PutFileWithMetadata is like PutFile, but also sets the given key/value
+// pairs in the file's metadata. Keys the file already has that aren't in
+// metadata are kept. If overwrite is true the file's existing content (and
+// metadata) is replaced rather than appended to.
+func (c APIClient) PutFileWithMetadata(repoName string, commitID string, path string, reader io.Reader, overwrite bool, metadata map[string]string) (_ int, retErr error) {
+	var overwriteIndex *pfs.OverwriteIndex
+	if overwrite {
+		overwriteIndex = &pfs.OverwriteIndex{0}
+	}
+	writer, err := c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, overwriteIndex)
+	if err != nil {
+		return 0, grpcutil.ScrubGRPC(err)
+	}
+	writer.request.Metadata = metadata
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	written, err := io.Copy(writer, reader)
+	return int(written), grpcutil.ScrubGRPC(err)
+}
+
 // PutFileOverwrite is like PutFile but it overwrites the file rather than
 // appending to it.  overwriteIndex allows you to specify the index of the
 // object starting from which you'd like to overwrite.  If you want to
@@ -652,6 +832,35 @@ func (c APIClient) CopyFile(srcRepo, srcCommit, srcPath, dstRepo, dstCommit, dst
 	return nil
 }
 
+// MoveFile moves a file or directory to another path in the same open
+// commit. Unlike CopyFile followed by DeleteFile, the cost of a move doesn't
+// depend on the number of files being moved.
+func (c APIClient) MoveFile(repoName string, commitID string, srcPath string, dstPath string) error {
+	if _, err := c.PfsAPIClient.MoveFile(c.Ctx(),
+		&pfs.MoveFileRequest{
+			Src: NewFile(repoName, commitID, srcPath),
+			Dst: NewFile(repoName, commitID, dstPath),
+		}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return nil
+}
+
+// CreateSymlink creates a symlink at path pointing to target, replacing
+// anything that was previously at path. target is either a path in the same
+// commit (absolute, or relative to the symlink's directory) or a path in
+// another repo, of the form pfs://repo/commit/path.
+func (c APIClient) CreateSymlink(repoName string, commitID string, path string, target string) error {
+	if _, err := c.PfsAPIClient.CreateSymlink(c.Ctx(),
+		&pfs.CreateSymlinkRequest{
+			File:   NewFile(repoName, commitID, path),
+			Target: target,
+		}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return nil
+}
+
 // GetFile returns the contents of a file at a specific Commit.
 // offset specifies a number of bytes that should be skipped in the beginning of the file.
 // size limits the total amount of data returned, note you will get fewer bytes
@@ -743,11 +952,18 @@ func (c APIClient) ListFile(repoName string, commitID string, path string) ([]*p
 // The pattern is documented here:
 // https://golang.org/pkg/path/filepath/#Match
 func (c APIClient) GlobFile(repoName string, commitID string, pattern string) ([]*pfs.FileInfo, error) {
+	return c.GlobFileWithMetadata(repoName, commitID, pattern, nil)
+}
+
+// GlobFileWithMetadata is like GlobFile, but only returns files whose
+// metadata contains all of the key/value pairs in metadata.
+func (c APIClient) GlobFileWithMetadata(repoName string, commitID string, pattern string, metadata map[string]string) ([]*pfs.FileInfo, error) {
 	fs, err := c.PfsAPIClient.GlobFileStream(
 		c.Ctx(),
 		&pfs.GlobFileRequest{
-			Commit:  NewCommit(repoName, commitID),
-			Pattern: pattern,
+			Commit:   NewCommit(repoName, commitID),
+			Pattern:  pattern,
+			Metadata: metadata,
 		},
 	)
 	if err != nil {
@@ -791,6 +1007,24 @@ func (c APIClient) DiffFile(newRepoName, newCommitID, newPath, oldRepoName,
 	return resp.NewFiles, resp.OldFiles, nil
 }
 
+// ListFileHistory returns the commits that changed the file at path, starting
+// at commitID and walking back through its ancestors, newest first. If
+// number is 0 the file's entire history is returned, otherwise at most
+// number entries are returned.
+func (c APIClient) ListFileHistory(repoName string, commitID string, path string, number uint64) ([]*pfs.FileHistory, error) {
+	histories, err := c.PfsAPIClient.ListFileHistory(
+		c.Ctx(),
+		&pfs.ListFileHistoryRequest{
+			File:   NewFile(repoName, commitID, path),
+			Number: number,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return histories.History, nil
+}
+
 // WalkFn is the type of the function called for each file in Walk.
 // Returning a non-nil error from WalkFn will result in Walk aborting and
 // returning said error.
@@ -829,6 +1063,53 @@ func (c APIClient) DeleteFile(repoName string, commitID string, path string) err
 	return err
 }
 
+// StartTransaction starts a new transaction. Use WithTransaction to add
+// requests to it, and FinishTransaction to apply them atomically.
+func (c APIClient) StartTransaction() (*pfs.Transaction, error) {
+	txn, err := c.PfsAPIClient.StartTransaction(
+		c.Ctx(),
+		&pfs.StartTransactionRequest{},
+	)
+	return txn, grpcutil.ScrubGRPC(err)
+}
+
+// FinishTransaction applies all of the requests in a transaction atomically.
+// If any of them fails, none of them are applied.
+func (c APIClient) FinishTransaction(txn *pfs.Transaction) (*pfs.TransactionInfo, error) {
+	txnInfo, err := c.PfsAPIClient.FinishTransaction(
+		c.Ctx(),
+		&pfs.FinishTransactionRequest{
+			Transaction: txn,
+		},
+	)
+	return txnInfo, grpcutil.ScrubGRPC(err)
+}
+
+// DeleteTransaction discards a transaction without applying its requests.
+func (c APIClient) DeleteTransaction(txn *pfs.Transaction) error {
+	_, err := c.PfsAPIClient.DeleteTransaction(
+		c.Ctx(),
+		&pfs.DeleteTransactionRequest{
+			Transaction: txn,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// WithTransaction returns a new APIClient whose CreateRepo, StartCommit,
+// FinishCommit, SetBranch, PutFile and DeleteFile requests are added to txn,
+// rather than applied immediately. They're applied when txn is finished.
+func (c *APIClient) WithTransaction(txn *pfs.Transaction) *APIClient {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md[pfs.ContextTransactionKey] = []string{txn.ID}
+	return c.WithCtx(metadata.NewOutgoingContext(ctx, md))
+}
+
 type putFileWriteCloser struct {
 	request       *pfs.PutFileRequest
 	putFileClient pfs.API_PutFileClient
@@ -6,6 +6,20 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"path"
+	"strings"
+)
+
+const (
+	// ContextTransactionKey is the key of the transaction ID in the metadata
+	// of a request that's part of a transaction
+	ContextTransactionKey = "pach-transaction"
+
+	// MaxSymlinkDepth is the maximum number of symlinks that are followed
+	// while resolving a path, to catch symlink cycles
+	MaxSymlinkDepth = 16
+
+	symlinkURLPrefix = "pfs://"
 )
 
 var (
@@ -18,6 +32,40 @@ func (c *Commit) FullID() string {
 	return fmt.Sprintf("%s/%s", c.Repo.Name, c.ID)
 }
 
+// ResolveSymlink returns the file that a symlink at 'link' with the given
+// target points to. The target is either a path in the link's commit
+// (absolute, or relative to the link's directory) or a path in another repo,
+// of the form pfs://repo/commit/path.
+func ResolveSymlink(link *File, target string) (*File, error) {
+	if strings.HasPrefix(target, symlinkURLPrefix) {
+		parts := strings.SplitN(strings.TrimPrefix(target, symlinkURLPrefix), "/", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid symlink target \"%s\": must be of the form pfs://repo/commit/path", target)
+		}
+		var filePath string
+		if len(parts) == 3 {
+			filePath = parts[2]
+		}
+		return &File{
+			Commit: &Commit{
+				Repo: &Repo{Name: parts[0]},
+				ID:   parts[1],
+			},
+			Path: path.Join("/", filePath),
+		}, nil
+	}
+	if target == "" {
+		return nil, fmt.Errorf("symlink target cannot be empty")
+	}
+	if !path.IsAbs(target) {
+		target = path.Join("/", path.Dir(link.Path), target)
+	}
+	return &File{
+		Commit: link.Commit,
+		Path:   path.Clean(target),
+	}, nil
+}
+
 // NewHash returns a hash that PFS uses internally to compute checksums.
 func NewHash() hash.Hash {
 	return sha512.New()
@@ -9,6 +9,7 @@
 
 	It has these top-level messages:
 		Repo
+		Branch
 		BranchInfo
 		BranchInfos
 		File
@@ -16,6 +17,7 @@
 		Object
 		Tag
 		RepoInfo
+		Quota
 		RepoAuthInfo
 		Commit
 		CommitInfo
@@ -25,6 +27,7 @@
 		ObjectInfo
 		CreateRepoRequest
 		InspectRepoRequest
+		SetQuotaRequest
 		ListRepoRequest
 		ListRepoResponse
 		DeleteRepoRequest
@@ -36,8 +39,15 @@
 		CommitInfos
 		ListBranchRequest
 		SetBranchRequest
+		CreateBranchRequest
+		InspectBranchRequest
 		DeleteBranchRequest
 		DeleteCommitRequest
+		SquashCommitRequest
+		RevertCommitRequest
+		MergeBranchRequest
+		MergeConflict
+		MergeBranchResponse
 		FlushCommitRequest
 		SubscribeCommitRequest
 		GetFileRequest
@@ -45,14 +55,25 @@
 		PutFileRequest
 		PutFileRecord
 		PutFileRecords
+		CreateSymlinkRequest
 		CopyFileRequest
+		MoveFileRequest
 		InspectFileRequest
 		ListFileRequest
 		GlobFileRequest
 		FileInfos
 		DiffFileRequest
 		DiffFileResponse
+		ListFileHistoryRequest
+		FileHistory
+		FileHistories
 		DeleteFileRequest
+		Transaction
+		TransactionRequest
+		TransactionInfo
+		StartTransactionRequest
+		FinishTransactionRequest
+		DeleteTransactionRequest
 		PutObjectRequest
 		GetObjectsRequest
 		TagObjectRequest
@@ -101,17 +122,20 @@ const (
 	FileType_RESERVED FileType = 0
 	FileType_FILE     FileType = 1
 	FileType_DIR      FileType = 2
+	FileType_SYMLINK  FileType = 3
 )
 
 var FileType_name = map[int32]string{
 	0: "RESERVED",
 	1: "FILE",
 	2: "DIR",
+	3: "SYMLINK",
 }
 var FileType_value = map[string]int32{
 	"RESERVED": 0,
 	"FILE":     1,
 	"DIR":      2,
+	"SYMLINK":  3,
 }
 
 func (x FileType) String() string {
@@ -119,6 +143,30 @@ func (x FileType) String() string {
 }
 func (FileType) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{0} }
 
+// CommitState describes which commits SubscribeCommit returns
+type CommitState int32
+
+const (
+	// only commits that have been finished are returned
+	CommitState_FINISHED CommitState = 0
+	// commits are returned as soon as they're started
+	CommitState_STARTED CommitState = 1
+)
+
+var CommitState_name = map[int32]string{
+	0: "FINISHED",
+	1: "STARTED",
+}
+var CommitState_value = map[string]int32{
+	"FINISHED": 0,
+	"STARTED":  1,
+}
+
+func (x CommitState) String() string {
+	return proto.EnumName(CommitState_name, int32(x))
+}
+func (CommitState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+
 type Delimiter int32
 
 const (
@@ -141,7 +189,7 @@ var Delimiter_value = map[string]int32{
 func (x Delimiter) String() string {
 	return proto.EnumName(Delimiter_name, int32(x))
 }
-func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{2} }
 
 type Repo struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -159,15 +207,51 @@ func (m *Repo) GetName() string {
 	return ""
 }
 
+// Branch is a reference to a branch in a particular repo (e.g. it's used to
+// record the provenance and subvenance of a branch)
+type Branch struct {
+	Repo *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *Branch) Reset()                    { *m = Branch{} }
+func (m *Branch) String() string            { return proto.CompactTextString(m) }
+func (*Branch) ProtoMessage()               {}
+func (*Branch) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+
+func (m *Branch) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *Branch) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type BranchInfo struct {
 	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Head *Commit `protobuf:"bytes,2,opt,name=head" json:"head,omitempty"`
+	// provenance is the set of branches that this branch is derived from.
+	// Whenever the head of one of these branches moves to a finished commit,
+	// PFS opens a new commit on this branch.
+	Provenance []*Branch `protobuf:"bytes,3,rep,name=provenance" json:"provenance,omitempty"`
+	// subvenance is the set of branches that have this branch in their
+	// provenance. It's maintained by PFS and can't be set directly.
+	Subvenance []*Branch `protobuf:"bytes,4,rep,name=subvenance" json:"subvenance,omitempty"`
+	// quota limits the size of the branch's head commit. It's set with
+	// SetQuota.
+	Quota *Quota `protobuf:"bytes,5,opt,name=quota" json:"quota,omitempty"`
 }
 
 func (m *BranchInfo) Reset()                    { *m = BranchInfo{} }
 func (m *BranchInfo) String() string            { return proto.CompactTextString(m) }
 func (*BranchInfo) ProtoMessage()               {}
-func (*BranchInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+func (*BranchInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{2} }
 
 func (m *BranchInfo) GetName() string {
 	if m != nil {
@@ -183,6 +267,27 @@ func (m *BranchInfo) GetHead() *Commit {
 	return nil
 }
 
+func (m *BranchInfo) GetProvenance() []*Branch {
+	if m != nil {
+		return m.Provenance
+	}
+	return nil
+}
+
+func (m *BranchInfo) GetSubvenance() []*Branch {
+	if m != nil {
+		return m.Subvenance
+	}
+	return nil
+}
+
+func (m *BranchInfo) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
 type BranchInfos struct {
 	BranchInfo []*BranchInfo `protobuf:"bytes,1,rep,name=branch_info,json=branchInfo" json:"branch_info,omitempty"`
 }
@@ -190,7 +295,7 @@ type BranchInfos struct {
 func (m *BranchInfos) Reset()                    { *m = BranchInfos{} }
 func (m *BranchInfos) String() string            { return proto.CompactTextString(m) }
 func (*BranchInfos) ProtoMessage()               {}
-func (*BranchInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{2} }
+func (*BranchInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{3} }
 
 func (m *BranchInfos) GetBranchInfo() []*BranchInfo {
 	if m != nil {
@@ -207,7 +312,7 @@ type File struct {
 func (m *File) Reset()                    { *m = File{} }
 func (m *File) String() string            { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()               {}
-func (*File) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{3} }
+func (*File) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{4} }
 
 func (m *File) GetCommit() *Commit {
 	if m != nil {
@@ -230,7 +335,7 @@ type Block struct {
 func (m *Block) Reset()                    { *m = Block{} }
 func (m *Block) String() string            { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()               {}
-func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{4} }
+func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{5} }
 
 func (m *Block) GetHash() string {
 	if m != nil {
@@ -246,7 +351,7 @@ type Object struct {
 func (m *Object) Reset()                    { *m = Object{} }
 func (m *Object) String() string            { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()               {}
-func (*Object) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{5} }
+func (*Object) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{6} }
 
 func (m *Object) GetHash() string {
 	if m != nil {
@@ -262,7 +367,7 @@ type Tag struct {
 func (m *Tag) Reset()                    { *m = Tag{} }
 func (m *Tag) String() string            { return proto.CompactTextString(m) }
 func (*Tag) ProtoMessage()               {}
-func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{6} }
+func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{7} }
 
 func (m *Tag) GetName() string {
 	if m != nil {
@@ -282,12 +387,24 @@ type RepoInfo struct {
 	// not stored in etcd. To set a user's auth scope for a repo, use the
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
 	AuthInfo *RepoAuthInfo `protobuf:"bytes,6,opt,name=auth_info,json=authInfo" json:"auth_info,omitempty"`
+	Quota    *Quota        `protobuf:"bytes,7,opt,name=quota" json:"quota,omitempty"`
+	// file_count is the number of files in the repo's most recently finished
+	// commit. It's only tracked while the repo has a file count quota.
+	FileCount uint64 `protobuf:"varint,8,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	// quota_warnings is set by InspectRepo when the repo is over one of its
+	// soft limits, but not stored in etcd.
+	QuotaWarnings []string `protobuf:"bytes,9,rep,name=quota_warnings,json=quotaWarnings" json:"quota_warnings,omitempty"`
+	// tree_size_bytes is the size of the files in the repo's most recently
+	// finished commit, which is what its size quota limits. Unlike size_bytes,
+	// it goes down when files are deleted. It's only tracked while the repo
+	// has a size quota.
+	TreeSizeBytes uint64 `protobuf:"varint,10,opt,name=tree_size_bytes,json=treeSizeBytes,proto3" json:"tree_size_bytes,omitempty"`
 }
 
 func (m *RepoInfo) Reset()                    { *m = RepoInfo{} }
 func (m *RepoInfo) String() string            { return proto.CompactTextString(m) }
 func (*RepoInfo) ProtoMessage()               {}
-func (*RepoInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{7} }
+func (*RepoInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{8} }
 
 func (m *RepoInfo) GetRepo() *Repo {
 	if m != nil {
@@ -331,6 +448,77 @@ func (m *RepoInfo) GetAuthInfo() *RepoAuthInfo {
 	return nil
 }
 
+func (m *RepoInfo) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetFileCount() uint64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+func (m *RepoInfo) GetQuotaWarnings() []string {
+	if m != nil {
+		return m.QuotaWarnings
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetTreeSizeBytes() uint64 {
+	if m != nil {
+		return m.TreeSizeBytes
+	}
+	return 0
+}
+
+// Quota limits the size of a repo. A limit of 0 means no limit.
+type Quota struct {
+	// Writes that would take the repo over a hard limit fail.
+	SizeBytes uint64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FileCount uint64 `protobuf:"varint,2,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	// Exceeding a soft limit only produces a warning in InspectRepo.
+	SoftSizeBytes uint64 `protobuf:"varint,3,opt,name=soft_size_bytes,json=softSizeBytes,proto3" json:"soft_size_bytes,omitempty"`
+	SoftFileCount uint64 `protobuf:"varint,4,opt,name=soft_file_count,json=softFileCount,proto3" json:"soft_file_count,omitempty"`
+}
+
+func (m *Quota) Reset()                    { *m = Quota{} }
+func (m *Quota) String() string            { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()               {}
+func (*Quota) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{9} }
+
+func (m *Quota) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *Quota) GetFileCount() uint64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+func (m *Quota) GetSoftSizeBytes() uint64 {
+	if m != nil {
+		return m.SoftSizeBytes
+	}
+	return 0
+}
+
+func (m *Quota) GetSoftFileCount() uint64 {
+	if m != nil {
+		return m.SoftFileCount
+	}
+	return 0
+}
+
 // RepoAuthInfo includes the caller's access scope for a repo, and is returned
 // by ListRepo and InspectRepo but not persisted in etcd. It's used by the
 // Pachyderm dashboard to render repo access appropriately. To set a user's auth
@@ -344,7 +532,7 @@ type RepoAuthInfo struct {
 func (m *RepoAuthInfo) Reset()                    { *m = RepoAuthInfo{} }
 func (m *RepoAuthInfo) String() string            { return proto.CompactTextString(m) }
 func (*RepoAuthInfo) ProtoMessage()               {}
-func (*RepoAuthInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{8} }
+func (*RepoAuthInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{10} }
 
 func (m *RepoAuthInfo) GetAccessLevel() auth.Scope {
 	if m != nil {
@@ -364,7 +552,7 @@ type Commit struct {
 func (m *Commit) Reset()                    { *m = Commit{} }
 func (m *Commit) String() string            { return proto.CompactTextString(m) }
 func (*Commit) ProtoMessage()               {}
-func (*Commit) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{9} }
+func (*Commit) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{11} }
 
 func (m *Commit) GetRepo() *Repo {
 	if m != nil {
@@ -393,12 +581,15 @@ type CommitInfo struct {
 	// this is the block that stores the serialized form of a tree that
 	// represents the entire file system hierarchy of the repo at this commit
 	Tree *Object `protobuf:"bytes,7,opt,name=tree" json:"tree,omitempty"`
+	// merge_parent is the second parent of a commit created by MergeBranch
+	// (the head of the branch that was merged in)
+	MergeParent *Commit `protobuf:"bytes,9,opt,name=merge_parent,json=mergeParent" json:"merge_parent,omitempty"`
 }
 
 func (m *CommitInfo) Reset()                    { *m = CommitInfo{} }
 func (m *CommitInfo) String() string            { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()               {}
-func (*CommitInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{10} }
+func (*CommitInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{12} }
 
 func (m *CommitInfo) GetCommit() *Commit {
 	if m != nil {
@@ -456,6 +647,13 @@ func (m *CommitInfo) GetTree() *Object {
 	return nil
 }
 
+func (m *CommitInfo) GetMergeParent() *Commit {
+	if m != nil {
+		return m.MergeParent
+	}
+	return nil
+}
+
 type FileInfo struct {
 	File      *File    `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 	FileType  FileType `protobuf:"varint,2,opt,name=file_type,json=fileType,proto3,enum=pfs.FileType" json:"file_type,omitempty"`
@@ -465,12 +663,21 @@ type FileInfo struct {
 	Children []string  `protobuf:"bytes,6,rep,name=children" json:"children,omitempty"`
 	Objects  []*Object `protobuf:"bytes,8,rep,name=objects" json:"objects,omitempty"`
 	Hash     []byte    `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	// metadata is the user-defined key/value attributes of a file.
+	Metadata map[string]string `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// modified_commit is the commit in which the file was last modified, and
+	// modified is the time at which that commit was finished.
+	ModifiedCommit *Commit                     `protobuf:"bytes,10,opt,name=modified_commit,json=modifiedCommit" json:"modified_commit,omitempty"`
+	Modified       *google_protobuf1.Timestamp `protobuf:"bytes,11,opt,name=modified" json:"modified,omitempty"`
+	// symlink_target is the target of a SYMLINK, either a path in the same
+	// commit or a path of the form pfs://repo/commit/path.
+	SymlinkTarget string `protobuf:"bytes,12,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
 }
 
 func (m *FileInfo) Reset()                    { *m = FileInfo{} }
 func (m *FileInfo) String() string            { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()               {}
-func (*FileInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{11} }
+func (*FileInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{13} }
 
 func (m *FileInfo) GetFile() *File {
 	if m != nil {
@@ -514,6 +721,34 @@ func (m *FileInfo) GetHash() []byte {
 	return nil
 }
 
+func (m *FileInfo) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *FileInfo) GetModifiedCommit() *Commit {
+	if m != nil {
+		return m.ModifiedCommit
+	}
+	return nil
+}
+
+func (m *FileInfo) GetModified() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+func (m *FileInfo) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 type ByteRange struct {
 	Lower uint64 `protobuf:"varint,1,opt,name=lower,proto3" json:"lower,omitempty"`
 	Upper uint64 `protobuf:"varint,2,opt,name=upper,proto3" json:"upper,omitempty"`
@@ -522,7 +757,7 @@ type ByteRange struct {
 func (m *ByteRange) Reset()                    { *m = ByteRange{} }
 func (m *ByteRange) String() string            { return proto.CompactTextString(m) }
 func (*ByteRange) ProtoMessage()               {}
-func (*ByteRange) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{12} }
+func (*ByteRange) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{14} }
 
 func (m *ByteRange) GetLower() uint64 {
 	if m != nil {
@@ -546,7 +781,7 @@ type BlockRef struct {
 func (m *BlockRef) Reset()                    { *m = BlockRef{} }
 func (m *BlockRef) String() string            { return proto.CompactTextString(m) }
 func (*BlockRef) ProtoMessage()               {}
-func (*BlockRef) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{13} }
+func (*BlockRef) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{15} }
 
 func (m *BlockRef) GetBlock() *Block {
 	if m != nil {
@@ -570,7 +805,7 @@ type ObjectInfo struct {
 func (m *ObjectInfo) Reset()                    { *m = ObjectInfo{} }
 func (m *ObjectInfo) String() string            { return proto.CompactTextString(m) }
 func (*ObjectInfo) ProtoMessage()               {}
-func (*ObjectInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{14} }
+func (*ObjectInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{16} }
 
 func (m *ObjectInfo) GetObject() *Object {
 	if m != nil {
@@ -596,7 +831,7 @@ type CreateRepoRequest struct {
 func (m *CreateRepoRequest) Reset()                    { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()               {}
-func (*CreateRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{15} }
+func (*CreateRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{17} }
 
 func (m *CreateRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -633,7 +868,7 @@ type InspectRepoRequest struct {
 func (m *InspectRepoRequest) Reset()                    { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()               {}
-func (*InspectRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{16} }
+func (*InspectRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{18} }
 
 func (m *InspectRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -642,6 +877,40 @@ func (m *InspectRepoRequest) GetRepo() *Repo {
 	return nil
 }
 
+type SetQuotaRequest struct {
+	Repo  *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Quota *Quota `protobuf:"bytes,2,opt,name=quota" json:"quota,omitempty"`
+	// branch, if set, is the branch of 'repo' whose quota is set, rather than
+	// the repo's.
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (m *SetQuotaRequest) Reset()                    { *m = SetQuotaRequest{} }
+func (m *SetQuotaRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetQuotaRequest) ProtoMessage()               {}
+func (*SetQuotaRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{19} }
+
+func (m *SetQuotaRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *SetQuotaRequest) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *SetQuotaRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
 type ListRepoRequest struct {
 	Provenance []*Repo `protobuf:"bytes,1,rep,name=provenance" json:"provenance,omitempty"`
 }
@@ -649,7 +918,7 @@ type ListRepoRequest struct {
 func (m *ListRepoRequest) Reset()                    { *m = ListRepoRequest{} }
 func (m *ListRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListRepoRequest) ProtoMessage()               {}
-func (*ListRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{17} }
+func (*ListRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{20} }
 
 func (m *ListRepoRequest) GetProvenance() []*Repo {
 	if m != nil {
@@ -665,7 +934,7 @@ type ListRepoResponse struct {
 func (m *ListRepoResponse) Reset()                    { *m = ListRepoResponse{} }
 func (m *ListRepoResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListRepoResponse) ProtoMessage()               {}
-func (*ListRepoResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{18} }
+func (*ListRepoResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{21} }
 
 func (m *ListRepoResponse) GetRepoInfo() []*RepoInfo {
 	if m != nil {
@@ -683,7 +952,7 @@ type DeleteRepoRequest struct {
 func (m *DeleteRepoRequest) Reset()                    { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()               {}
-func (*DeleteRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{19} }
+func (*DeleteRepoRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{22} }
 
 func (m *DeleteRepoRequest) GetRepo() *Repo {
 	if m != nil {
@@ -719,7 +988,7 @@ type StartCommitRequest struct {
 func (m *StartCommitRequest) Reset()                    { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()               {}
-func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{20} }
+func (*StartCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{23} }
 
 func (m *StartCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -759,7 +1028,7 @@ type BuildCommitRequest struct {
 func (m *BuildCommitRequest) Reset()                    { *m = BuildCommitRequest{} }
 func (m *BuildCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*BuildCommitRequest) ProtoMessage()               {}
-func (*BuildCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{21} }
+func (*BuildCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{24} }
 
 func (m *BuildCommitRequest) GetParent() *Commit {
 	if m != nil {
@@ -794,12 +1063,18 @@ type FinishCommitRequest struct {
 	// description is a user-provided string describing this commit. Setting this
 	// will overwrite the description set in StartCommit
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// tree, if set, is the hashtree to use as the commit's contents, in place of
+	// the files that were put into the commit
+	Tree *Object `protobuf:"bytes,3,opt,name=tree" json:"tree,omitempty"`
+	// empty, if set, finishes the commit with no files, in place of the files
+	// that were put into it (e.g. because the job writing it failed)
+	Empty bool `protobuf:"varint,4,opt,name=empty,proto3" json:"empty,omitempty"`
 }
 
 func (m *FinishCommitRequest) Reset()                    { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()               {}
-func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{22} }
+func (*FinishCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{25} }
 
 func (m *FinishCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -815,6 +1090,20 @@ func (m *FinishCommitRequest) GetDescription() string {
 	return ""
 }
 
+func (m *FinishCommitRequest) GetTree() *Object {
+	if m != nil {
+		return m.Tree
+	}
+	return nil
+}
+
+func (m *FinishCommitRequest) GetEmpty() bool {
+	if m != nil {
+		return m.Empty
+	}
+	return false
+}
+
 type InspectCommitRequest struct {
 	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 }
@@ -822,7 +1111,7 @@ type InspectCommitRequest struct {
 func (m *InspectCommitRequest) Reset()                    { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()               {}
-func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{23} }
+func (*InspectCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{26} }
 
 func (m *InspectCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -841,7 +1130,7 @@ type ListCommitRequest struct {
 func (m *ListCommitRequest) Reset()                    { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()               {}
-func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{24} }
+func (*ListCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{27} }
 
 func (m *ListCommitRequest) GetRepo() *Repo {
 	if m != nil {
@@ -878,7 +1167,7 @@ type CommitInfos struct {
 func (m *CommitInfos) Reset()                    { *m = CommitInfos{} }
 func (m *CommitInfos) String() string            { return proto.CompactTextString(m) }
 func (*CommitInfos) ProtoMessage()               {}
-func (*CommitInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{25} }
+func (*CommitInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{28} }
 
 func (m *CommitInfos) GetCommitInfo() []*CommitInfo {
 	if m != nil {
@@ -894,7 +1183,7 @@ type ListBranchRequest struct {
 func (m *ListBranchRequest) Reset()                    { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()               {}
-func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{26} }
+func (*ListBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{29} }
 
 func (m *ListBranchRequest) GetRepo() *Repo {
 	if m != nil {
@@ -911,7 +1200,7 @@ type SetBranchRequest struct {
 func (m *SetBranchRequest) Reset()                    { *m = SetBranchRequest{} }
 func (m *SetBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetBranchRequest) ProtoMessage()               {}
-func (*SetBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{27} }
+func (*SetBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{30} }
 
 func (m *SetBranchRequest) GetCommit() *Commit {
 	if m != nil {
@@ -927,6 +1216,67 @@ func (m *SetBranchRequest) GetBranch() string {
 	return ""
 }
 
+type CreateBranchRequest struct {
+	Branch *Branch `protobuf:"bytes,1,opt,name=branch" json:"branch,omitempty"`
+	// head is the commit that the new branch will point to. It may be nil, in
+	// which case the branch is created without a head (a head will be created
+	// once all of the branch's provenance has a head).
+	Head       *Commit   `protobuf:"bytes,2,opt,name=head" json:"head,omitempty"`
+	Provenance []*Branch `protobuf:"bytes,3,rep,name=provenance" json:"provenance,omitempty"`
+	// clear_provenance removes all of an existing branch's provenance. It can't
+	// be set along with provenance.
+	ClearProvenance bool `protobuf:"varint,4,opt,name=clear_provenance,json=clearProvenance,proto3" json:"clear_provenance,omitempty"`
+}
+
+func (m *CreateBranchRequest) Reset()                    { *m = CreateBranchRequest{} }
+func (m *CreateBranchRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateBranchRequest) ProtoMessage()               {}
+func (*CreateBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{31} }
+
+func (m *CreateBranchRequest) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *CreateBranchRequest) GetHead() *Commit {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *CreateBranchRequest) GetProvenance() []*Branch {
+	if m != nil {
+		return m.Provenance
+	}
+	return nil
+}
+
+func (m *CreateBranchRequest) GetClearProvenance() bool {
+	if m != nil {
+		return m.ClearProvenance
+	}
+	return false
+}
+
+type InspectBranchRequest struct {
+	Branch *Branch `protobuf:"bytes,1,opt,name=branch" json:"branch,omitempty"`
+}
+
+func (m *InspectBranchRequest) Reset()                    { *m = InspectBranchRequest{} }
+func (m *InspectBranchRequest) String() string            { return proto.CompactTextString(m) }
+func (*InspectBranchRequest) ProtoMessage()               {}
+func (*InspectBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{32} }
+
+func (m *InspectBranchRequest) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
 type DeleteBranchRequest struct {
 	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
 	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -935,7 +1285,7 @@ type DeleteBranchRequest struct {
 func (m *DeleteBranchRequest) Reset()                    { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()               {}
-func (*DeleteBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{28} }
+func (*DeleteBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
 
 func (m *DeleteBranchRequest) GetRepo() *Repo {
 	if m != nil {
@@ -958,7 +1308,7 @@ type DeleteCommitRequest struct {
 func (m *DeleteCommitRequest) Reset()                    { *m = DeleteCommitRequest{} }
 func (m *DeleteCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCommitRequest) ProtoMessage()               {}
-func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{29} }
+func (*DeleteCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
 
 func (m *DeleteCommitRequest) GetCommit() *Commit {
 	if m != nil {
@@ -967,113 +1317,255 @@ func (m *DeleteCommitRequest) GetCommit() *Commit {
 	return nil
 }
 
-type FlushCommitRequest struct {
-	Commits []*Commit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
-	ToRepos []*Repo   `protobuf:"bytes,2,rep,name=to_repos,json=toRepos" json:"to_repos,omitempty"`
+type SquashCommitRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 }
 
-func (m *FlushCommitRequest) Reset()                    { *m = FlushCommitRequest{} }
-func (m *FlushCommitRequest) String() string            { return proto.CompactTextString(m) }
-func (*FlushCommitRequest) ProtoMessage()               {}
-func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{30} }
-
-func (m *FlushCommitRequest) GetCommits() []*Commit {
-	if m != nil {
-		return m.Commits
-	}
-	return nil
-}
+func (m *SquashCommitRequest) Reset()                    { *m = SquashCommitRequest{} }
+func (m *SquashCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*SquashCommitRequest) ProtoMessage()               {}
+func (*SquashCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
 
-func (m *FlushCommitRequest) GetToRepos() []*Repo {
+func (m *SquashCommitRequest) GetCommit() *Commit {
 	if m != nil {
-		return m.ToRepos
+		return m.Commit
 	}
 	return nil
 }
 
-type SubscribeCommitRequest struct {
-	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+type RevertCommitRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	// branch is the branch that the reverting commit is added to
 	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	// only commits created since this commit are returned
-	From *Commit `protobuf:"bytes,3,opt,name=from" json:"from,omitempty"`
 }
 
-func (m *SubscribeCommitRequest) Reset()                    { *m = SubscribeCommitRequest{} }
-func (m *SubscribeCommitRequest) String() string            { return proto.CompactTextString(m) }
-func (*SubscribeCommitRequest) ProtoMessage()               {}
-func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{31} }
+func (m *RevertCommitRequest) Reset()                    { *m = RevertCommitRequest{} }
+func (m *RevertCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevertCommitRequest) ProtoMessage()               {}
+func (*RevertCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
 
-func (m *SubscribeCommitRequest) GetRepo() *Repo {
+func (m *RevertCommitRequest) GetCommit() *Commit {
 	if m != nil {
-		return m.Repo
+		return m.Commit
 	}
 	return nil
 }
 
-func (m *SubscribeCommitRequest) GetBranch() string {
+func (m *RevertCommitRequest) GetBranch() string {
 	if m != nil {
 		return m.Branch
 	}
 	return ""
 }
 
-func (m *SubscribeCommitRequest) GetFrom() *Commit {
+type MergeBranchRequest struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	// from_branch is merged into to_branch
+	FromBranch string `protobuf:"bytes,2,opt,name=from_branch,json=fromBranch,proto3" json:"from_branch,omitempty"`
+	ToBranch   string `protobuf:"bytes,3,opt,name=to_branch,json=toBranch,proto3" json:"to_branch,omitempty"`
+	// description is a user-provided string describing the merge commit
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *MergeBranchRequest) Reset()                    { *m = MergeBranchRequest{} }
+func (m *MergeBranchRequest) String() string            { return proto.CompactTextString(m) }
+func (*MergeBranchRequest) ProtoMessage()               {}
+func (*MergeBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
+
+func (m *MergeBranchRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.From
+		return m.Repo
 	}
 	return nil
 }
 
-type GetFileRequest struct {
-	File        *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	OffsetBytes int64 `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
-	SizeBytes   int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+func (m *MergeBranchRequest) GetFromBranch() string {
+	if m != nil {
+		return m.FromBranch
+	}
+	return ""
 }
 
-func (m *GetFileRequest) Reset()                    { *m = GetFileRequest{} }
-func (m *GetFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetFileRequest) ProtoMessage()               {}
-func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{32} }
+func (m *MergeBranchRequest) GetToBranch() string {
+	if m != nil {
+		return m.ToBranch
+	}
+	return ""
+}
 
-func (m *GetFileRequest) GetFile() *File {
+func (m *MergeBranchRequest) GetDescription() string {
 	if m != nil {
-		return m.File
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *GetFileRequest) GetOffsetBytes() int64 {
+// MergeConflict is a path that was changed differently on both sides of a
+// merge.
+type MergeConflict struct {
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MergeConflict) Reset()                    { *m = MergeConflict{} }
+func (m *MergeConflict) String() string            { return proto.CompactTextString(m) }
+func (*MergeConflict) ProtoMessage()               {}
+func (*MergeConflict) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{38} }
+
+func (m *MergeConflict) GetPath() string {
 	if m != nil {
-		return m.OffsetBytes
+		return m.Path
 	}
-	return 0
+	return ""
 }
 
-func (m *GetFileRequest) GetSizeBytes() int64 {
+func (m *MergeConflict) GetReason() string {
 	if m != nil {
-		return m.SizeBytes
+		return m.Reason
 	}
-	return 0
+	return ""
 }
 
-// An OverwriteIndex specifies the index of objects from which new writes
-// are applied to.  Existing objects starting from the index are deleted.
-// We want a separate message for ObjectIndex because we want to be able to
-// distinguish between a zero index and a non-existent index.
-type OverwriteIndex struct {
-	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+type MergeBranchResponse struct {
+	// commit is the new head of to_branch. It's nil if there were conflicts.
+	Commit    *Commit          `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	Conflicts []*MergeConflict `protobuf:"bytes,2,rep,name=conflicts" json:"conflicts,omitempty"`
 }
 
-func (m *OverwriteIndex) Reset()                    { *m = OverwriteIndex{} }
-func (m *OverwriteIndex) String() string            { return proto.CompactTextString(m) }
-func (*OverwriteIndex) ProtoMessage()               {}
-func (*OverwriteIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
+func (m *MergeBranchResponse) Reset()                    { *m = MergeBranchResponse{} }
+func (m *MergeBranchResponse) String() string            { return proto.CompactTextString(m) }
+func (*MergeBranchResponse) ProtoMessage()               {}
+func (*MergeBranchResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
 
-func (m *OverwriteIndex) GetIndex() int64 {
+func (m *MergeBranchResponse) GetCommit() *Commit {
 	if m != nil {
-		return m.Index
+		return m.Commit
 	}
-	return 0
+	return nil
+}
+
+func (m *MergeBranchResponse) GetConflicts() []*MergeConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+type FlushCommitRequest struct {
+	Commits []*Commit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
+	ToRepos []*Repo   `protobuf:"bytes,2,rep,name=to_repos,json=toRepos" json:"to_repos,omitempty"`
+}
+
+func (m *FlushCommitRequest) Reset()                    { *m = FlushCommitRequest{} }
+func (m *FlushCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*FlushCommitRequest) ProtoMessage()               {}
+func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
+
+func (m *FlushCommitRequest) GetCommits() []*Commit {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+func (m *FlushCommitRequest) GetToRepos() []*Repo {
+	if m != nil {
+		return m.ToRepos
+	}
+	return nil
+}
+
+type SubscribeCommitRequest struct {
+	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// only commits created since this commit are returned
+	From  *Commit     `protobuf:"bytes,3,opt,name=from" json:"from,omitempty"`
+	State CommitState `protobuf:"varint,4,opt,name=state,proto3,enum=pfs.CommitState" json:"state,omitempty"`
+}
+
+func (m *SubscribeCommitRequest) Reset()                    { *m = SubscribeCommitRequest{} }
+func (m *SubscribeCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeCommitRequest) ProtoMessage()               {}
+func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{41} }
+
+func (m *SubscribeCommitRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *SubscribeCommitRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *SubscribeCommitRequest) GetFrom() *Commit {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *SubscribeCommitRequest) GetState() CommitState {
+	if m != nil {
+		return m.State
+	}
+	return CommitState_FINISHED
+}
+
+type GetFileRequest struct {
+	File        *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	OffsetBytes int64 `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	SizeBytes   int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (m *GetFileRequest) Reset()                    { *m = GetFileRequest{} }
+func (m *GetFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetFileRequest) ProtoMessage()               {}
+func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
+
+func (m *GetFileRequest) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *GetFileRequest) GetOffsetBytes() int64 {
+	if m != nil {
+		return m.OffsetBytes
+	}
+	return 0
+}
+
+func (m *GetFileRequest) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+// An OverwriteIndex specifies the index of objects from which new writes
+// are applied to.  Existing objects starting from the index are deleted.
+// We want a separate message for ObjectIndex because we want to be able to
+// distinguish between a zero index and a non-existent index.
+type OverwriteIndex struct {
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *OverwriteIndex) Reset()                    { *m = OverwriteIndex{} }
+func (m *OverwriteIndex) String() string            { return proto.CompactTextString(m) }
+func (*OverwriteIndex) ProtoMessage()               {}
+func (*OverwriteIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
+
+func (m *OverwriteIndex) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
 type PutFileRequest struct {
@@ -1095,12 +1587,15 @@ type PutFileRequest struct {
 	// overwrite_index is the object index where the write starts from.  All
 	// existing objects starting from the index are deleted.
 	OverwriteIndex *OverwriteIndex `protobuf:"bytes,10,opt,name=overwrite_index,json=overwriteIndex" json:"overwrite_index,omitempty"`
+	// metadata is set on the written file(s), in addition to any metadata the
+	// file already has.
+	Metadata map[string]string `protobuf:"bytes,11,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *PutFileRequest) Reset()                    { *m = PutFileRequest{} }
 func (m *PutFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRequest) ProtoMessage()               {}
-func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
+func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
 
 func (m *PutFileRequest) GetFile() *File {
 	if m != nil {
@@ -1158,6 +1653,13 @@ func (m *PutFileRequest) GetOverwriteIndex() *OverwriteIndex {
 	return nil
 }
 
+func (m *PutFileRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // PutFileRecord is used to record PutFile requests in etcd temporarily.
 type PutFileRecord struct {
 	SizeBytes      int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -1168,7 +1670,7 @@ type PutFileRecord struct {
 func (m *PutFileRecord) Reset()                    { *m = PutFileRecord{} }
 func (m *PutFileRecord) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRecord) ProtoMessage()               {}
-func (*PutFileRecord) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
+func (*PutFileRecord) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
 
 func (m *PutFileRecord) GetSizeBytes() int64 {
 	if m != nil {
@@ -1192,15 +1694,21 @@ func (m *PutFileRecord) GetOverwriteIndex() *OverwriteIndex {
 }
 
 type PutFileRecords struct {
-	Split     bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
-	Records   []*PutFileRecord `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
-	Tombstone bool             `protobuf:"varint,3,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	Split     bool              `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
+	Records   []*PutFileRecord  `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+	Tombstone bool              `protobuf:"varint,3,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	Metadata  map[string]string `protobuf:"bytes,4,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// symlink_target, if set, replaces the file with a symlink to this target.
+	SymlinkTarget string `protobuf:"bytes,5,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	// tree, if set, is a serialized hashtree whose contents replace the file.
+	// It's written by MoveFile.
+	Tree *Object `protobuf:"bytes,6,opt,name=tree" json:"tree,omitempty"`
 }
 
 func (m *PutFileRecords) Reset()                    { *m = PutFileRecords{} }
 func (m *PutFileRecords) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRecords) ProtoMessage()               {}
-func (*PutFileRecords) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
+func (*PutFileRecords) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
 
 func (m *PutFileRecords) GetSplit() bool {
 	if m != nil {
@@ -1223,6 +1731,51 @@ func (m *PutFileRecords) GetTombstone() bool {
 	return false
 }
 
+func (m *PutFileRecords) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PutFileRecords) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
+func (m *PutFileRecords) GetTree() *Object {
+	if m != nil {
+		return m.Tree
+	}
+	return nil
+}
+
+type CreateSymlinkRequest struct {
+	File   *File  `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *CreateSymlinkRequest) Reset()                    { *m = CreateSymlinkRequest{} }
+func (m *CreateSymlinkRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSymlinkRequest) ProtoMessage()               {}
+func (*CreateSymlinkRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
+
+func (m *CreateSymlinkRequest) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *CreateSymlinkRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
 type CopyFileRequest struct {
 	Src       *File `protobuf:"bytes,1,opt,name=src" json:"src,omitempty"`
 	Dst       *File `protobuf:"bytes,2,opt,name=dst" json:"dst,omitempty"`
@@ -1232,7 +1785,7 @@ type CopyFileRequest struct {
 func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
 func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*CopyFileRequest) ProtoMessage()               {}
-func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
+func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
 
 func (m *CopyFileRequest) GetSrc() *File {
 	if m != nil {
@@ -1255,6 +1808,30 @@ func (m *CopyFileRequest) GetOverwrite() bool {
 	return false
 }
 
+type MoveFileRequest struct {
+	Src *File `protobuf:"bytes,1,opt,name=src" json:"src,omitempty"`
+	Dst *File `protobuf:"bytes,2,opt,name=dst" json:"dst,omitempty"`
+}
+
+func (m *MoveFileRequest) Reset()                    { *m = MoveFileRequest{} }
+func (m *MoveFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*MoveFileRequest) ProtoMessage()               {}
+func (*MoveFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
+
+func (m *MoveFileRequest) GetSrc() *File {
+	if m != nil {
+		return m.Src
+	}
+	return nil
+}
+
+func (m *MoveFileRequest) GetDst() *File {
+	if m != nil {
+		return m.Dst
+	}
+	return nil
+}
+
 type InspectFileRequest struct {
 	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 }
@@ -1262,7 +1839,7 @@ type InspectFileRequest struct {
 func (m *InspectFileRequest) Reset()                    { *m = InspectFileRequest{} }
 func (m *InspectFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectFileRequest) ProtoMessage()               {}
-func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{38} }
+func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
 
 func (m *InspectFileRequest) GetFile() *File {
 	if m != nil {
@@ -1284,7 +1861,7 @@ type ListFileRequest struct {
 func (m *ListFileRequest) Reset()                    { *m = ListFileRequest{} }
 func (m *ListFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListFileRequest) ProtoMessage()               {}
-func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
+func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
 
 func (m *ListFileRequest) GetFile() *File {
 	if m != nil {
@@ -1303,12 +1880,15 @@ func (m *ListFileRequest) GetFull() bool {
 type GlobFileRequest struct {
 	Commit  *Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
 	Pattern string  `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// metadata, if set, restricts the result to files whose metadata contains
+	// all of these key/value pairs.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *GlobFileRequest) Reset()                    { *m = GlobFileRequest{} }
 func (m *GlobFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*GlobFileRequest) ProtoMessage()               {}
-func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
+func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
 
 func (m *GlobFileRequest) GetCommit() *Commit {
 	if m != nil {
@@ -1324,6 +1904,13 @@ func (m *GlobFileRequest) GetPattern() string {
 	return ""
 }
 
+func (m *GlobFileRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // FileInfos is the result of both ListFile and GlobFile
 type FileInfos struct {
 	FileInfo []*FileInfo `protobuf:"bytes,1,rep,name=file_info,json=fileInfo" json:"file_info,omitempty"`
@@ -1332,7 +1919,7 @@ type FileInfos struct {
 func (m *FileInfos) Reset()                    { *m = FileInfos{} }
 func (m *FileInfos) String() string            { return proto.CompactTextString(m) }
 func (*FileInfos) ProtoMessage()               {}
-func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{41} }
+func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{53} }
 
 func (m *FileInfos) GetFileInfo() []*FileInfo {
 	if m != nil {
@@ -1352,7 +1939,7 @@ type DiffFileRequest struct {
 func (m *DiffFileRequest) Reset()                    { *m = DiffFileRequest{} }
 func (m *DiffFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*DiffFileRequest) ProtoMessage()               {}
-func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
+func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{54} }
 
 func (m *DiffFileRequest) GetNewFile() *File {
 	if m != nil {
@@ -1383,7 +1970,7 @@ type DiffFileResponse struct {
 func (m *DiffFileResponse) Reset()                    { *m = DiffFileResponse{} }
 func (m *DiffFileResponse) String() string            { return proto.CompactTextString(m) }
 func (*DiffFileResponse) ProtoMessage()               {}
-func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
+func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{55} }
 
 func (m *DiffFileResponse) GetNewFiles() []*FileInfo {
 	if m != nil {
@@ -1399,121 +1986,385 @@ func (m *DiffFileResponse) GetOldFiles() []*FileInfo {
 	return nil
 }
 
-type DeleteFileRequest struct {
+type ListFileHistoryRequest struct {
+	// File is the path whose history is returned, starting at File's commit or
+	// branch and following the commit's parents.
 	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// Number is the maximum number of history entries to return, 0 means all.
+	Number uint64 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *DeleteFileRequest) Reset()                    { *m = DeleteFileRequest{} }
-func (m *DeleteFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteFileRequest) ProtoMessage()               {}
-func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
+func (m *ListFileHistoryRequest) Reset()                    { *m = ListFileHistoryRequest{} }
+func (m *ListFileHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListFileHistoryRequest) ProtoMessage()               {}
+func (*ListFileHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{56} }
 
-func (m *DeleteFileRequest) GetFile() *File {
+func (m *ListFileHistoryRequest) GetFile() *File {
 	if m != nil {
 		return m.File
 	}
 	return nil
 }
 
-type PutObjectRequest struct {
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Tags  []*Tag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+func (m *ListFileHistoryRequest) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
 }
 
-func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
-func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
-func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
+// FileHistory describes a commit that changed a file.
+type FileHistory struct {
+	Commit   *Commit                     `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
+	Finished *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=finished" json:"finished,omitempty"`
+	// FileInfo is the file as of this commit, or nil if the commit deleted it.
+	FileInfo *FileInfo `protobuf:"bytes,3,opt,name=file_info,json=fileInfo" json:"file_info,omitempty"`
+	// SizeDelta is the change in the file's size made by this commit.
+	SizeDelta int64 `protobuf:"varint,4,opt,name=size_delta,json=sizeDelta,proto3" json:"size_delta,omitempty"`
+}
 
-func (m *PutObjectRequest) GetValue() []byte {
+func (m *FileHistory) Reset()                    { *m = FileHistory{} }
+func (m *FileHistory) String() string            { return proto.CompactTextString(m) }
+func (*FileHistory) ProtoMessage()               {}
+func (*FileHistory) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{57} }
+
+func (m *FileHistory) GetCommit() *Commit {
 	if m != nil {
-		return m.Value
+		return m.Commit
 	}
 	return nil
 }
 
-func (m *PutObjectRequest) GetTags() []*Tag {
+func (m *FileHistory) GetFinished() *google_protobuf1.Timestamp {
 	if m != nil {
-		return m.Tags
+		return m.Finished
 	}
 	return nil
 }
 
-type GetObjectsRequest struct {
-	Objects     []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
-	OffsetBytes uint64    `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
-	// The number of bytes we intend to read.
-	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	// The total amount of bytes in these objects.  It's OK if it's not
-	// entirely accurate or if it's unknown (in which case it'd be set to 0).
-	// It's used primarily as a hint for cache eviction.
-	TotalSize uint64 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
-}
-
-func (m *GetObjectsRequest) Reset()                    { *m = GetObjectsRequest{} }
-func (m *GetObjectsRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetObjectsRequest) ProtoMessage()               {}
-func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
-
-func (m *GetObjectsRequest) GetObjects() []*Object {
+func (m *FileHistory) GetFileInfo() *FileInfo {
 	if m != nil {
-		return m.Objects
+		return m.FileInfo
 	}
 	return nil
 }
 
-func (m *GetObjectsRequest) GetOffsetBytes() uint64 {
+func (m *FileHistory) GetSizeDelta() int64 {
 	if m != nil {
-		return m.OffsetBytes
+		return m.SizeDelta
 	}
 	return 0
 }
 
-func (m *GetObjectsRequest) GetSizeBytes() uint64 {
+type FileHistories struct {
+	History []*FileHistory `protobuf:"bytes,1,rep,name=history" json:"history,omitempty"`
+}
+
+func (m *FileHistories) Reset()                    { *m = FileHistories{} }
+func (m *FileHistories) String() string            { return proto.CompactTextString(m) }
+func (*FileHistories) ProtoMessage()               {}
+func (*FileHistories) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{58} }
+
+func (m *FileHistories) GetHistory() []*FileHistory {
 	if m != nil {
-		return m.SizeBytes
+		return m.History
 	}
-	return 0
+	return nil
 }
 
-func (m *GetObjectsRequest) GetTotalSize() uint64 {
+type DeleteFileRequest struct {
+	File *File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+}
+
+func (m *DeleteFileRequest) Reset()                    { *m = DeleteFileRequest{} }
+func (m *DeleteFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteFileRequest) ProtoMessage()               {}
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{59} }
+
+func (m *DeleteFileRequest) GetFile() *File {
 	if m != nil {
-		return m.TotalSize
+		return m.File
 	}
-	return 0
+	return nil
 }
 
-type TagObjectRequest struct {
-	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
-	Tags   []*Tag  `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+// Transaction is a reference to a set of PFS requests that are applied
+// atomically when the transaction is finished.
+type Transaction struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *TagObjectRequest) Reset()                    { *m = TagObjectRequest{} }
-func (m *TagObjectRequest) String() string            { return proto.CompactTextString(m) }
-func (*TagObjectRequest) ProtoMessage()               {}
-func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
+func (m *Transaction) Reset()                    { *m = Transaction{} }
+func (m *Transaction) String() string            { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()               {}
+func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{60} }
 
-func (m *TagObjectRequest) GetObject() *Object {
+func (m *Transaction) GetID() string {
 	if m != nil {
-		return m.Object
+		return m.ID
+	}
+	return ""
+}
+
+// TransactionRequest is a single request that has been added to a
+// transaction. Exactly one of the request fields is set.
+type TransactionRequest struct {
+	CreateRepo *CreateRepoRequest `protobuf:"bytes,1,opt,name=create_repo,json=createRepo" json:"create_repo,omitempty"`
+	// start_commit is applied with the ID in 'commit', which is allocated when
+	// the request is added to the transaction, so that later requests in the
+	// same transaction can refer to the new commit.
+	StartCommit  *StartCommitRequest  `protobuf:"bytes,2,opt,name=start_commit,json=startCommit" json:"start_commit,omitempty"`
+	Commit       *Commit              `protobuf:"bytes,3,opt,name=commit" json:"commit,omitempty"`
+	FinishCommit *FinishCommitRequest `protobuf:"bytes,4,opt,name=finish_commit,json=finishCommit" json:"finish_commit,omitempty"`
+	SetBranch    *SetBranchRequest    `protobuf:"bytes,5,opt,name=set_branch,json=setBranch" json:"set_branch,omitempty"`
+	DeleteFile   *DeleteFileRequest   `protobuf:"bytes,6,opt,name=delete_file,json=deleteFile" json:"delete_file,omitempty"`
+	// put_file holds the records of a PutFile request, whose data has already
+	// been written to object storage.
+	PutFile        *File           `protobuf:"bytes,7,opt,name=put_file,json=putFile" json:"put_file,omitempty"`
+	PutFileRecords *PutFileRecords `protobuf:"bytes,8,opt,name=put_file_records,json=putFileRecords" json:"put_file_records,omitempty"`
+}
+
+func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
+func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactionRequest) ProtoMessage()               {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{61} }
+
+func (m *TransactionRequest) GetCreateRepo() *CreateRepoRequest {
+	if m != nil {
+		return m.CreateRepo
 	}
 	return nil
 }
 
-func (m *TagObjectRequest) GetTags() []*Tag {
+func (m *TransactionRequest) GetStartCommit() *StartCommitRequest {
 	if m != nil {
-		return m.Tags
+		return m.StartCommit
 	}
 	return nil
 }
 
-type ListObjectsRequest struct {
+func (m *TransactionRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
 }
 
-func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
-func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
+func (m *TransactionRequest) GetFinishCommit() *FinishCommitRequest {
+	if m != nil {
+		return m.FinishCommit
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetSetBranch() *SetBranchRequest {
+	if m != nil {
+		return m.SetBranch
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDeleteFile() *DeleteFileRequest {
+	if m != nil {
+		return m.DeleteFile
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetPutFile() *File {
+	if m != nil {
+		return m.PutFile
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetPutFileRecords() *PutFileRecords {
+	if m != nil {
+		return m.PutFileRecords
+	}
+	return nil
+}
+
+type TransactionInfo struct {
+	Transaction *Transaction                `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	Requests    []*TransactionRequest       `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
+	Started     *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=started" json:"started,omitempty"`
+	// num_requests is the number of requests added to the transaction so far.
+	NumRequests int64 `protobuf:"varint,4,opt,name=num_requests,json=numRequests,proto3" json:"num_requests,omitempty"`
+}
+
+func (m *TransactionInfo) Reset()                    { *m = TransactionInfo{} }
+func (m *TransactionInfo) String() string            { return proto.CompactTextString(m) }
+func (*TransactionInfo) ProtoMessage()               {}
+func (*TransactionInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{62} }
+
+func (m *TransactionInfo) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetRequests() []*TransactionRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetStarted() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetNumRequests() int64 {
+	if m != nil {
+		return m.NumRequests
+	}
+	return 0
+}
+
+type StartTransactionRequest struct {
+}
+
+func (m *StartTransactionRequest) Reset()                    { *m = StartTransactionRequest{} }
+func (m *StartTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartTransactionRequest) ProtoMessage()               {}
+func (*StartTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{63} }
+
+type FinishTransactionRequest struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+}
+
+func (m *FinishTransactionRequest) Reset()                    { *m = FinishTransactionRequest{} }
+func (m *FinishTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*FinishTransactionRequest) ProtoMessage()               {}
+func (*FinishTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{64} }
+
+func (m *FinishTransactionRequest) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type DeleteTransactionRequest struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+}
+
+func (m *DeleteTransactionRequest) Reset()                    { *m = DeleteTransactionRequest{} }
+func (m *DeleteTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTransactionRequest) ProtoMessage()               {}
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{65} }
+
+func (m *DeleteTransactionRequest) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type PutObjectRequest struct {
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Tags  []*Tag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
+func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutObjectRequest) ProtoMessage()               {}
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{66} }
+
+func (m *PutObjectRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *PutObjectRequest) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type GetObjectsRequest struct {
+	Objects     []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+	OffsetBytes uint64    `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	// The number of bytes we intend to read.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The total amount of bytes in these objects.  It's OK if it's not
+	// entirely accurate or if it's unknown (in which case it'd be set to 0).
+	// It's used primarily as a hint for cache eviction.
+	TotalSize uint64 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (m *GetObjectsRequest) Reset()                    { *m = GetObjectsRequest{} }
+func (m *GetObjectsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetObjectsRequest) ProtoMessage()               {}
+func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{67} }
+
+func (m *GetObjectsRequest) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetObjectsRequest) GetOffsetBytes() uint64 {
+	if m != nil {
+		return m.OffsetBytes
+	}
+	return 0
+}
+
+func (m *GetObjectsRequest) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *GetObjectsRequest) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type TagObjectRequest struct {
+	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
+	Tags   []*Tag  `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (m *TagObjectRequest) Reset()                    { *m = TagObjectRequest{} }
+func (m *TagObjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*TagObjectRequest) ProtoMessage()               {}
+func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{68} }
+
+func (m *TagObjectRequest) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *TagObjectRequest) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ListObjectsRequest struct {
+}
+
+func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
+func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListObjectsRequest) ProtoMessage()               {}
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{69} }
 
 type ListTagsRequest struct {
 	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
@@ -1523,7 +2374,7 @@ type ListTagsRequest struct {
 func (m *ListTagsRequest) Reset()                    { *m = ListTagsRequest{} }
 func (m *ListTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListTagsRequest) ProtoMessage()               {}
-func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
+func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{70} }
 
 func (m *ListTagsRequest) GetPrefix() string {
 	if m != nil {
@@ -1547,7 +2398,7 @@ type ListTagsResponse struct {
 func (m *ListTagsResponse) Reset()                    { *m = ListTagsResponse{} }
 func (m *ListTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListTagsResponse) ProtoMessage()               {}
-func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
+func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{71} }
 
 func (m *ListTagsResponse) GetTag() string {
 	if m != nil {
@@ -1570,7 +2421,7 @@ type DeleteObjectsRequest struct {
 func (m *DeleteObjectsRequest) Reset()                    { *m = DeleteObjectsRequest{} }
 func (m *DeleteObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectsRequest) ProtoMessage()               {}
-func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
+func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{72} }
 
 func (m *DeleteObjectsRequest) GetObjects() []*Object {
 	if m != nil {
@@ -1585,7 +2436,7 @@ type DeleteObjectsResponse struct {
 func (m *DeleteObjectsResponse) Reset()                    { *m = DeleteObjectsResponse{} }
 func (m *DeleteObjectsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectsResponse) ProtoMessage()               {}
-func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
+func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{73} }
 
 type DeleteTagsRequest struct {
 	Tags []string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
@@ -1594,7 +2445,7 @@ type DeleteTagsRequest struct {
 func (m *DeleteTagsRequest) Reset()                    { *m = DeleteTagsRequest{} }
 func (m *DeleteTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteTagsRequest) ProtoMessage()               {}
-func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{53} }
+func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{74} }
 
 func (m *DeleteTagsRequest) GetTags() []string {
 	if m != nil {
@@ -1609,7 +2460,7 @@ type DeleteTagsResponse struct {
 func (m *DeleteTagsResponse) Reset()                    { *m = DeleteTagsResponse{} }
 func (m *DeleteTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteTagsResponse) ProtoMessage()               {}
-func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{54} }
+func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{75} }
 
 type CheckObjectRequest struct {
 	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
@@ -1618,7 +2469,7 @@ type CheckObjectRequest struct {
 func (m *CheckObjectRequest) Reset()                    { *m = CheckObjectRequest{} }
 func (m *CheckObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*CheckObjectRequest) ProtoMessage()               {}
-func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{55} }
+func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{76} }
 
 func (m *CheckObjectRequest) GetObject() *Object {
 	if m != nil {
@@ -1634,7 +2485,7 @@ type CheckObjectResponse struct {
 func (m *CheckObjectResponse) Reset()                    { *m = CheckObjectResponse{} }
 func (m *CheckObjectResponse) String() string            { return proto.CompactTextString(m) }
 func (*CheckObjectResponse) ProtoMessage()               {}
-func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{56} }
+func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{77} }
 
 func (m *CheckObjectResponse) GetExists() bool {
 	if m != nil {
@@ -1650,7 +2501,7 @@ type Objects struct {
 func (m *Objects) Reset()                    { *m = Objects{} }
 func (m *Objects) String() string            { return proto.CompactTextString(m) }
 func (*Objects) ProtoMessage()               {}
-func (*Objects) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{57} }
+func (*Objects) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{78} }
 
 func (m *Objects) GetObjects() []*Object {
 	if m != nil {
@@ -1667,7 +2518,7 @@ type ObjectIndex struct {
 func (m *ObjectIndex) Reset()                    { *m = ObjectIndex{} }
 func (m *ObjectIndex) String() string            { return proto.CompactTextString(m) }
 func (*ObjectIndex) ProtoMessage()               {}
-func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{58} }
+func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{79} }
 
 func (m *ObjectIndex) GetObjects() map[string]*BlockRef {
 	if m != nil {
@@ -1685,6 +2536,7 @@ func (m *ObjectIndex) GetTags() map[string]*Object {
 
 func init() {
 	proto.RegisterType((*Repo)(nil), "pfs.Repo")
+	proto.RegisterType((*Branch)(nil), "pfs.Branch")
 	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
 	proto.RegisterType((*BranchInfos)(nil), "pfs.BranchInfos")
 	proto.RegisterType((*File)(nil), "pfs.File")
@@ -1692,6 +2544,7 @@ func init() {
 	proto.RegisterType((*Object)(nil), "pfs.Object")
 	proto.RegisterType((*Tag)(nil), "pfs.Tag")
 	proto.RegisterType((*RepoInfo)(nil), "pfs.RepoInfo")
+	proto.RegisterType((*Quota)(nil), "pfs.Quota")
 	proto.RegisterType((*RepoAuthInfo)(nil), "pfs.RepoAuthInfo")
 	proto.RegisterType((*Commit)(nil), "pfs.Commit")
 	proto.RegisterType((*CommitInfo)(nil), "pfs.CommitInfo")
@@ -1701,6 +2554,7 @@ func init() {
 	proto.RegisterType((*ObjectInfo)(nil), "pfs.ObjectInfo")
 	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
 	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
+	proto.RegisterType((*SetQuotaRequest)(nil), "pfs.SetQuotaRequest")
 	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
 	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
 	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
@@ -1712,8 +2566,15 @@ func init() {
 	proto.RegisterType((*CommitInfos)(nil), "pfs.CommitInfos")
 	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
 	proto.RegisterType((*SetBranchRequest)(nil), "pfs.SetBranchRequest")
+	proto.RegisterType((*CreateBranchRequest)(nil), "pfs.CreateBranchRequest")
+	proto.RegisterType((*InspectBranchRequest)(nil), "pfs.InspectBranchRequest")
 	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
 	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
+	proto.RegisterType((*SquashCommitRequest)(nil), "pfs.SquashCommitRequest")
+	proto.RegisterType((*RevertCommitRequest)(nil), "pfs.RevertCommitRequest")
+	proto.RegisterType((*MergeBranchRequest)(nil), "pfs.MergeBranchRequest")
+	proto.RegisterType((*MergeConflict)(nil), "pfs.MergeConflict")
+	proto.RegisterType((*MergeBranchResponse)(nil), "pfs.MergeBranchResponse")
 	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
 	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
 	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
@@ -1721,14 +2582,25 @@ func init() {
 	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
 	proto.RegisterType((*PutFileRecord)(nil), "pfs.PutFileRecord")
 	proto.RegisterType((*PutFileRecords)(nil), "pfs.PutFileRecords")
+	proto.RegisterType((*CreateSymlinkRequest)(nil), "pfs.CreateSymlinkRequest")
 	proto.RegisterType((*CopyFileRequest)(nil), "pfs.CopyFileRequest")
+	proto.RegisterType((*MoveFileRequest)(nil), "pfs.MoveFileRequest")
 	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
 	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
 	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
 	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
 	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
 	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
+	proto.RegisterType((*ListFileHistoryRequest)(nil), "pfs.ListFileHistoryRequest")
+	proto.RegisterType((*FileHistory)(nil), "pfs.FileHistory")
+	proto.RegisterType((*FileHistories)(nil), "pfs.FileHistories")
 	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
+	proto.RegisterType((*Transaction)(nil), "pfs.Transaction")
+	proto.RegisterType((*TransactionRequest)(nil), "pfs.TransactionRequest")
+	proto.RegisterType((*TransactionInfo)(nil), "pfs.TransactionInfo")
+	proto.RegisterType((*StartTransactionRequest)(nil), "pfs.StartTransactionRequest")
+	proto.RegisterType((*FinishTransactionRequest)(nil), "pfs.FinishTransactionRequest")
+	proto.RegisterType((*DeleteTransactionRequest)(nil), "pfs.DeleteTransactionRequest")
 	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
 	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
 	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
@@ -1744,6 +2616,7 @@ func init() {
 	proto.RegisterType((*Objects)(nil), "pfs.Objects")
 	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
 	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs.CommitState", CommitState_name, CommitState_value)
 	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
 }
 
@@ -1768,6 +2641,9 @@ type APIClient interface {
 	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
 	// DeleteRepo deletes a repo.
 	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// SetQuota sets the size limits of a repo. Only cluster admins may set
+	// quotas when auth is active.
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// Commit rpcs
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
@@ -1782,6 +2658,16 @@ type APIClient interface {
 	ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error)
 	// DeleteCommit deletes a commit.
 	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// SquashCommit removes a finished commit from its repo's history by
+	// merging its changes into its children.
+	SquashCommit(ctx context.Context, in *SquashCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// RevertCommit adds a new commit to a branch, which undoes the changes
+	// made by the given commit.
+	RevertCommit(ctx context.Context, in *RevertCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// MergeBranch does a three-way merge of one branch into another. Either a
+	// merge commit is added to the target branch, or the conflicting paths
+	// are returned.
+	MergeBranch(ctx context.Context, in *MergeBranchRequest, opts ...grpc.CallOption) (*MergeBranchResponse, error)
 	// FlushCommit waits for downstream commits to finish
 	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
 	// SubscribeCommit subscribes for new commits on a given branch
@@ -1792,6 +2678,11 @@ type APIClient interface {
 	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
 	// SetBranch assigns a commit and its ancestors to a branch.
 	SetBranch(ctx context.Context, in *SetBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// CreateBranch creates a new branch (or updates an existing one) with the
+	// given head and provenance.
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// InspectBranch returns info about a branch, including its provenance.
+	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
 	// DeleteBranch deletes a branch; note that the commits still exist.
 	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// File rpcs
@@ -1799,6 +2690,12 @@ type APIClient interface {
 	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
 	// CopyFile copies the contents of one file to another.
 	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// CreateSymlink creates a symbolic link to another file, which is followed
+	// by GetFile and ListFile.
+	CreateSymlink(ctx context.Context, in *CreateSymlinkRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// MoveFile moves a file or directory to another path in the same open
+	// commit.
+	MoveFile(ctx context.Context, in *MoveFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// GetFile returns a byte stream of the contents of the file.
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
 	// InspectFile returns info about a file.
@@ -1819,10 +2716,22 @@ type APIClient interface {
 	GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error)
 	// DiffFile returns the differences between 2 paths at 2 commits.
 	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
+	// ListFileHistory returns the commits that changed a file, newest first.
+	ListFileHistory(ctx context.Context, in *ListFileHistoryRequest, opts ...grpc.CallOption) (*FileHistories, error)
 	// DeleteFile deletes a file.
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// Transaction rpcs
+	// StartTransaction starts a new transaction. CreateRepo, StartCommit,
+	// FinishCommit, SetBranch, PutFile and DeleteFile requests that carry the
+	// transaction's ID in their metadata are added to the transaction instead
+	// of being applied immediately.
+	StartTransaction(ctx context.Context, in *StartTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// FinishTransaction applies all of the requests in a transaction atomically.
+	FinishTransaction(ctx context.Context, in *FinishTransactionRequest, opts ...grpc.CallOption) (*TransactionInfo, error)
+	// DeleteTransaction discards a transaction without applying its requests.
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 }
 
 type aPIClient struct {
@@ -1869,6 +2778,15 @@ func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts
 	return out, nil
 }
 
+func (c *aPIClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/SetQuota", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
 	out := new(Commit)
 	err := grpc.Invoke(ctx, "/pfs.API/StartCommit", in, out, c.cc, opts...)
@@ -1946,6 +2864,33 @@ func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) SquashCommit(ctx context.Context, in *SquashCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/SquashCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RevertCommit(ctx context.Context, in *RevertCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := grpc.Invoke(ctx, "/pfs.API/RevertCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) MergeBranch(ctx context.Context, in *MergeBranchRequest, opts ...grpc.CallOption) (*MergeBranchResponse, error) {
+	out := new(MergeBranchResponse)
+	err := grpc.Invoke(ctx, "/pfs.API/MergeBranch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/pfs.API/FlushCommit", opts...)
 	if err != nil {
@@ -2037,6 +2982,24 @@ func (c *aPIClient) SetBranch(ctx context.Context, in *SetBranchRequest, opts ..
 	return out, nil
 }
 
+func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/CreateBranch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
+	out := new(BranchInfo)
+	err := grpc.Invoke(ctx, "/pfs.API/InspectBranch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, c.cc, opts...)
@@ -2089,6 +3052,24 @@ func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...g
 	return out, nil
 }
 
+func (c *aPIClient) CreateSymlink(ctx context.Context, in *CreateSymlinkRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/CreateSymlink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) MoveFile(ctx context.Context, in *MoveFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/MoveFile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[4], c.cc, "/pfs.API/GetFile", opts...)
 	if err != nil {
@@ -2221,6 +3202,15 @@ func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...g
 	return out, nil
 }
 
+func (c *aPIClient) ListFileHistory(ctx context.Context, in *ListFileHistoryRequest, opts ...grpc.CallOption) (*FileHistories, error) {
+	out := new(FileHistories)
+	err := grpc.Invoke(ctx, "/pfs.API/ListFileHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, c.cc, opts...)
@@ -2239,6 +3229,33 @@ func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, op
 	return out, nil
 }
 
+func (c *aPIClient) StartTransaction(ctx context.Context, in *StartTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := grpc.Invoke(ctx, "/pfs.API/StartTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) FinishTransaction(ctx context.Context, in *FinishTransactionRequest, opts ...grpc.CallOption) (*TransactionInfo, error) {
+	out := new(TransactionInfo)
+	err := grpc.Invoke(ctx, "/pfs.API/FinishTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for API service
 
 type APIServer interface {
@@ -2252,6 +3269,9 @@ type APIServer interface {
 	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
 	// DeleteRepo deletes a repo.
 	DeleteRepo(context.Context, *DeleteRepoRequest) (*google_protobuf.Empty, error)
+	// SetQuota sets the size limits of a repo. Only cluster admins may set
+	// quotas when auth is active.
+	SetQuota(context.Context, *SetQuotaRequest) (*google_protobuf.Empty, error)
 	// Commit rpcs
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
@@ -2266,6 +3286,16 @@ type APIServer interface {
 	ListCommitStream(*ListCommitRequest, API_ListCommitStreamServer) error
 	// DeleteCommit deletes a commit.
 	DeleteCommit(context.Context, *DeleteCommitRequest) (*google_protobuf.Empty, error)
+	// SquashCommit removes a finished commit from its repo's history by
+	// merging its changes into its children.
+	SquashCommit(context.Context, *SquashCommitRequest) (*google_protobuf.Empty, error)
+	// RevertCommit adds a new commit to a branch, which undoes the changes
+	// made by the given commit.
+	RevertCommit(context.Context, *RevertCommitRequest) (*Commit, error)
+	// MergeBranch does a three-way merge of one branch into another. Either a
+	// merge commit is added to the target branch, or the conflicting paths
+	// are returned.
+	MergeBranch(context.Context, *MergeBranchRequest) (*MergeBranchResponse, error)
 	// FlushCommit waits for downstream commits to finish
 	FlushCommit(*FlushCommitRequest, API_FlushCommitServer) error
 	// SubscribeCommit subscribes for new commits on a given branch
@@ -2276,6 +3306,11 @@ type APIServer interface {
 	ListBranch(context.Context, *ListBranchRequest) (*BranchInfos, error)
 	// SetBranch assigns a commit and its ancestors to a branch.
 	SetBranch(context.Context, *SetBranchRequest) (*google_protobuf.Empty, error)
+	// CreateBranch creates a new branch (or updates an existing one) with the
+	// given head and provenance.
+	CreateBranch(context.Context, *CreateBranchRequest) (*google_protobuf.Empty, error)
+	// InspectBranch returns info about a branch, including its provenance.
+	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
 	// DeleteBranch deletes a branch; note that the commits still exist.
 	DeleteBranch(context.Context, *DeleteBranchRequest) (*google_protobuf.Empty, error)
 	// File rpcs
@@ -2283,6 +3318,12 @@ type APIServer interface {
 	PutFile(API_PutFileServer) error
 	// CopyFile copies the contents of one file to another.
 	CopyFile(context.Context, *CopyFileRequest) (*google_protobuf.Empty, error)
+	// CreateSymlink creates a symbolic link to another file, which is followed
+	// by GetFile and ListFile.
+	CreateSymlink(context.Context, *CreateSymlinkRequest) (*google_protobuf.Empty, error)
+	// MoveFile moves a file or directory to another path in the same open
+	// commit.
+	MoveFile(context.Context, *MoveFileRequest) (*google_protobuf.Empty, error)
 	// GetFile returns a byte stream of the contents of the file.
 	GetFile(*GetFileRequest, API_GetFileServer) error
 	// InspectFile returns info about a file.
@@ -2303,10 +3344,22 @@ type APIServer interface {
 	GlobFileStream(*GlobFileRequest, API_GlobFileStreamServer) error
 	// DiffFile returns the differences between 2 paths at 2 commits.
 	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
+	// ListFileHistory returns the commits that changed a file, newest first.
+	ListFileHistory(context.Context, *ListFileHistoryRequest) (*FileHistories, error)
 	// DeleteFile deletes a file.
 	DeleteFile(context.Context, *DeleteFileRequest) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	// Transaction rpcs
+	// StartTransaction starts a new transaction. CreateRepo, StartCommit,
+	// FinishCommit, SetBranch, PutFile and DeleteFile requests that carry the
+	// transaction's ID in their metadata are added to the transaction instead
+	// of being applied immediately.
+	StartTransaction(context.Context, *StartTransactionRequest) (*Transaction, error)
+	// FinishTransaction applies all of the requests in a transaction atomically.
+	FinishTransaction(context.Context, *FinishTransactionRequest) (*TransactionInfo, error)
+	// DeleteTransaction discards a transaction without applying its requests.
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*google_protobuf.Empty, error)
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -2385,6 +3438,24 @@ func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartCommitRequest)
 	if err := dec(in); err != nil {
@@ -2496,7 +3567,61 @@ func _API_DeleteCommit_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+func _API_SquashCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquashCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SquashCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SquashCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SquashCommit(ctx, req.(*SquashCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RevertCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RevertCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/RevertCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevertCommit(ctx, req.(*RevertCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_MergeBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).MergeBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/MergeBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).MergeBranch(ctx, req.(*MergeBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(FlushCommitRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
@@ -2592,6 +3717,42 @@ func _API_SetBranch_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CreateBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteBranchRequest)
 	if err := dec(in); err != nil {
@@ -2654,6 +3815,42 @@ func _API_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CreateSymlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSymlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateSymlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CreateSymlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateSymlink(ctx, req.(*CreateSymlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_MoveFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).MoveFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/MoveFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).MoveFile(ctx, req.(*MoveFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetFileRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2789,6 +3986,24 @@ func _API_DiffFile_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListFileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListFileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListFileHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListFileHistory(ctx, req.(*ListFileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteFileRequest)
 	if err := dec(in); err != nil {
@@ -2825,6 +4040,60 @@ func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_StartTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).StartTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/StartTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartTransaction(ctx, req.(*StartTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_FinishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).FinishTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/FinishTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FinishTransaction(ctx, req.(*FinishTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pfs.API",
 	HandlerType: (*APIServer)(nil),
@@ -2845,6 +4114,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepo",
 			Handler:    _API_DeleteRepo_Handler,
 		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _API_SetQuota_Handler,
+		},
 		{
 			MethodName: "StartCommit",
 			Handler:    _API_StartCommit_Handler,
@@ -2865,6 +4138,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCommit",
 			Handler:    _API_DeleteCommit_Handler,
 		},
+		{
+			MethodName: "SquashCommit",
+			Handler:    _API_SquashCommit_Handler,
+		},
+		{
+			MethodName: "RevertCommit",
+			Handler:    _API_RevertCommit_Handler,
+		},
+		{
+			MethodName: "MergeBranch",
+			Handler:    _API_MergeBranch_Handler,
+		},
 		{
 			MethodName: "BuildCommit",
 			Handler:    _API_BuildCommit_Handler,
@@ -2877,6 +4162,14 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBranch",
 			Handler:    _API_SetBranch_Handler,
 		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _API_CreateBranch_Handler,
+		},
+		{
+			MethodName: "InspectBranch",
+			Handler:    _API_InspectBranch_Handler,
+		},
 		{
 			MethodName: "DeleteBranch",
 			Handler:    _API_DeleteBranch_Handler,
@@ -2885,6 +4178,14 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CopyFile",
 			Handler:    _API_CopyFile_Handler,
 		},
+		{
+			MethodName: "CreateSymlink",
+			Handler:    _API_CreateSymlink_Handler,
+		},
+		{
+			MethodName: "MoveFile",
+			Handler:    _API_MoveFile_Handler,
+		},
 		{
 			MethodName: "InspectFile",
 			Handler:    _API_InspectFile_Handler,
@@ -2901,6 +4202,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DiffFile",
 			Handler:    _API_DiffFile_Handler,
 		},
+		{
+			MethodName: "ListFileHistory",
+			Handler:    _API_ListFileHistory_Handler,
+		},
 		{
 			MethodName: "DeleteFile",
 			Handler:    _API_DeleteFile_Handler,
@@ -2909,6 +4214,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
 		},
+		{
+			MethodName: "StartTransaction",
+			Handler:    _API_StartTransaction_Handler,
+		},
+		{
+			MethodName: "FinishTransaction",
+			Handler:    _API_FinishTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _API_DeleteTransaction_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3675,6 +4992,40 @@ func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Branch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Branch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n1, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
 func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3700,11 +5051,45 @@ func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
-		n1, err := m.Head.MarshalTo(dAtA[i:])
+		n2, err := m.Head.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n2
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Subvenance) > 0 {
+		for _, msg := range m.Subvenance {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n86, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
 	}
 	return i, nil
 }
@@ -3758,11 +5143,11 @@ func (m *File) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n2, err := m.Commit.MarshalTo(dAtA[i:])
+		n3, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if len(m.Path) > 0 {
 		dAtA[i] = 0x12
@@ -3864,21 +5249,21 @@ func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n3, err := m.Repo.MarshalTo(dAtA[i:])
+		n4, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.Created != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
-		n4, err := m.Created.MarshalTo(dAtA[i:])
+		n5, err := m.Created.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	if m.SizeBytes != 0 {
 		dAtA[i] = 0x18
@@ -3907,27 +5292,100 @@ func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.AuthInfo.Size()))
-		n5, err := m.AuthInfo.MarshalTo(dAtA[i:])
+		n6, err := m.AuthInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
-	return i, nil
-}
-
-func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Quota != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n7, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	if m.FileCount != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
+	}
+	if len(m.QuotaWarnings) > 0 {
+		for _, s := range m.QuotaWarnings {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.TreeSizeBytes != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TreeSizeBytes))
+	}
+	return i, nil
+}
+
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.FileCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
+	}
+	if m.SoftSizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SoftSizeBytes))
+	}
+	if m.SoftFileCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SoftFileCount))
+	}
+	return i, nil
+}
+
+func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
 	_ = i
 	var l int
 	_ = l
@@ -3958,11 +5416,11 @@ func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n6, err := m.Repo.MarshalTo(dAtA[i:])
+		n8, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
 	}
 	if len(m.ID) > 0 {
 		dAtA[i] = 0x12
@@ -3992,41 +5450,41 @@ func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n7, err := m.Commit.MarshalTo(dAtA[i:])
+		n9, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	if m.ParentCommit != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.ParentCommit.Size()))
-		n8, err := m.ParentCommit.MarshalTo(dAtA[i:])
+		n10, err := m.ParentCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	if m.Started != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
-		n9, err := m.Started.MarshalTo(dAtA[i:])
+		n11, err := m.Started.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if m.Finished != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
-		n10, err := m.Finished.MarshalTo(dAtA[i:])
+		n12, err := m.Finished.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.SizeBytes != 0 {
 		dAtA[i] = 0x28
@@ -4049,11 +5507,11 @@ func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n11, err := m.Tree.MarshalTo(dAtA[i:])
+		n13, err := m.Tree.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	if len(m.Description) > 0 {
 		dAtA[i] = 0x42
@@ -4061,6 +5519,16 @@ func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
 		i += copy(dAtA[i:], m.Description)
 	}
+	if m.MergeParent != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MergeParent.Size()))
+		n14, err := m.MergeParent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
 	return i, nil
 }
 
@@ -4083,11 +5551,11 @@ func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n12, err := m.File.MarshalTo(dAtA[i:])
+		n15, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n15
 	}
 	if m.FileType != 0 {
 		dAtA[i] = 0x10
@@ -4132,6 +5600,49 @@ func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x4a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.ModifiedCommit != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ModifiedCommit.Size()))
+		n16, err := m.ModifiedCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Modified != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Modified.Size()))
+		n17, err := m.Modified.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
+	}
 	return i, nil
 }
 
@@ -4182,21 +5693,21 @@ func (m *BlockRef) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
-		n13, err := m.Block.MarshalTo(dAtA[i:])
+		n18, err := m.Block.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n18
 	}
 	if m.Range != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Range.Size()))
-		n14, err := m.Range.MarshalTo(dAtA[i:])
+		n19, err := m.Range.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n19
 	}
 	return i, nil
 }
@@ -4220,21 +5731,21 @@ func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n15, err := m.Object.MarshalTo(dAtA[i:])
+		n20, err := m.Object.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n20
 	}
 	if m.BlockRef != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.BlockRef.Size()))
-		n16, err := m.BlockRef.MarshalTo(dAtA[i:])
+		n21, err := m.BlockRef.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n21
 	}
 	return i, nil
 }
@@ -4258,11 +5769,11 @@ func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n17, err := m.Repo.MarshalTo(dAtA[i:])
+		n22, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n22
 	}
 	if len(m.Provenance) > 0 {
 		for _, msg := range m.Provenance {
@@ -4314,11 +5825,55 @@ func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n18, err := m.Repo.MarshalTo(dAtA[i:])
+		n23, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *SetQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n24, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n25, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
 	return i, nil
 }
@@ -4402,11 +5957,11 @@ func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n19, err := m.Repo.MarshalTo(dAtA[i:])
+		n26, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n26
 	}
 	if m.Force {
 		dAtA[i] = 0x10
@@ -4450,11 +6005,11 @@ func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n20, err := m.Parent.MarshalTo(dAtA[i:])
+		n27, err := m.Parent.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n27
 	}
 	if len(m.Provenance) > 0 {
 		for _, msg := range m.Provenance {
@@ -4502,11 +6057,11 @@ func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n21, err := m.Parent.MarshalTo(dAtA[i:])
+		n28, err := m.Parent.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n28
 	}
 	if len(m.Provenance) > 0 {
 		for _, msg := range m.Provenance {
@@ -4524,11 +6079,11 @@ func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n22, err := m.Tree.MarshalTo(dAtA[i:])
+		n29, err := m.Tree.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n29
 	}
 	if len(m.Branch) > 0 {
 		dAtA[i] = 0x22
@@ -4558,11 +6113,11 @@ func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n23, err := m.Commit.MarshalTo(dAtA[i:])
+		n30, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n30
 	}
 	if len(m.Description) > 0 {
 		dAtA[i] = 0x12
@@ -4570,6 +6125,26 @@ func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
 		i += copy(dAtA[i:], m.Description)
 	}
+	if m.Tree != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n85, err := m.Tree.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	if m.Empty {
+		dAtA[i] = 0x20
+		i++
+		if m.Empty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -4592,11 +6167,11 @@ func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n24, err := m.Commit.MarshalTo(dAtA[i:])
+		n31, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n31
 	}
 	return i, nil
 }
@@ -4620,31 +6195,31 @@ func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n25, err := m.Repo.MarshalTo(dAtA[i:])
+		n32, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n32
 	}
 	if m.From != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n26, err := m.From.MarshalTo(dAtA[i:])
+		n33, err := m.From.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n33
 	}
 	if m.To != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
-		n27, err := m.To.MarshalTo(dAtA[i:])
+		n34, err := m.To.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n34
 	}
 	if m.Number != 0 {
 		dAtA[i] = 0x20
@@ -4703,11 +6278,11 @@ func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n28, err := m.Repo.MarshalTo(dAtA[i:])
+		n35, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n35
 	}
 	return i, nil
 }
@@ -4731,11 +6306,11 @@ func (m *SetBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n29, err := m.Commit.MarshalTo(dAtA[i:])
+		n36, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n36
 	}
 	if len(m.Branch) > 0 {
 		dAtA[i] = 0x12
@@ -4746,7 +6321,7 @@ func (m *SetBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4756,31 +6331,57 @@ func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
+	if m.Branch != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n30, err := m.Repo.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n37, err := m.Branch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n37
 	}
-	if len(m.Branch) > 0 {
+	if m.Head != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
+		n38, err := m.Head.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ClearProvenance {
+		dAtA[i] = 0x20
+		i++
+		if m.ClearProvenance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
 
-func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4790,25 +6391,25 @@ func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
+	if m.Branch != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n31, err := m.Commit.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n39, err := m.Branch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n39
 	}
 	return i, nil
 }
 
-func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4818,24 +6419,264 @@ func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
-		for _, msg := range m.Commits {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.ToRepos) > 0 {
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n40, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	return i, nil
+}
+
+func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n41, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	return i, nil
+}
+
+func (m *SquashCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SquashCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n42, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	return i, nil
+}
+
+func (m *RevertCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n43, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	return i, nil
+}
+
+func (m *MergeBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n44, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if len(m.FromBranch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FromBranch)))
+		i += copy(dAtA[i:], m.FromBranch)
+	}
+	if len(m.ToBranch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ToBranch)))
+		i += copy(dAtA[i:], m.ToBranch)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	return i, nil
+}
+
+func (m *MergeConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeConflict) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *MergeBranchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeBranchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n45, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if len(m.Conflicts) > 0 {
+		for _, msg := range m.Conflicts {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ToRepos) > 0 {
 		for _, msg := range m.ToRepos {
 			dAtA[i] = 0x12
 			i++
@@ -4869,11 +6710,11 @@ func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n32, err := m.Repo.MarshalTo(dAtA[i:])
+		n46, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n46
 	}
 	if len(m.Branch) > 0 {
 		dAtA[i] = 0x12
@@ -4885,11 +6726,16 @@ func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n33, err := m.From.MarshalTo(dAtA[i:])
+		n47, err := m.From.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n47
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.State))
 	}
 	return i, nil
 }
@@ -4913,11 +6759,11 @@ func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n34, err := m.File.MarshalTo(dAtA[i:])
+		n48, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n48
 	}
 	if m.OffsetBytes != 0 {
 		dAtA[i] = 0x10
@@ -4974,11 +6820,11 @@ func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n35, err := m.File.MarshalTo(dAtA[i:])
+		n49, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n49
 	}
 	if len(m.Value) > 0 {
 		dAtA[i] = 0x1a
@@ -5021,11 +6867,28 @@ func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n36, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		n50, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n50
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x5a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
 	return i, nil
 }
@@ -5060,11 +6923,11 @@ func (m *PutFileRecord) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n37, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		n51, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n51
 	}
 	return i, nil
 }
@@ -5116,11 +6979,78 @@ func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x22
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
+	}
+	if m.Tree != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n52, err := m.Tree.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	return i, nil
+}
+
+func (m *CreateSymlinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSymlinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n53, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if len(m.Target) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
+	}
+	return i, nil
+}
+
+func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
 	if err != nil {
@@ -5138,21 +7068,21 @@ func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
-		n38, err := m.Src.MarshalTo(dAtA[i:])
+		n54, err := m.Src.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n54
 	}
 	if m.Dst != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
-		n39, err := m.Dst.MarshalTo(dAtA[i:])
+		n55, err := m.Dst.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n55
 	}
 	if m.Overwrite {
 		dAtA[i] = 0x18
@@ -5167,6 +7097,44 @@ func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MoveFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Src != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
+		n56, err := m.Src.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if m.Dst != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
+		n57, err := m.Dst.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	return i, nil
+}
+
 func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5186,11 +7154,11 @@ func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n40, err := m.File.MarshalTo(dAtA[i:])
+		n58, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n58
 	}
 	return i, nil
 }
@@ -5214,11 +7182,11 @@ func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n41, err := m.File.MarshalTo(dAtA[i:])
+		n59, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n59
 	}
 	if m.Full {
 		dAtA[i] = 0x10
@@ -5252,11 +7220,11 @@ func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n42, err := m.Commit.MarshalTo(dAtA[i:])
+		n60, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n60
 	}
 	if len(m.Pattern) > 0 {
 		dAtA[i] = 0x12
@@ -5264,6 +7232,23 @@ func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
 		i += copy(dAtA[i:], m.Pattern)
 	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -5316,21 +7301,21 @@ func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
-		n43, err := m.NewFile.MarshalTo(dAtA[i:])
+		n61, err := m.NewFile.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n61
 	}
 	if m.OldFile != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
-		n44, err := m.OldFile.MarshalTo(dAtA[i:])
+		n62, err := m.OldFile.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n62
 	}
 	if m.Shallow {
 		dAtA[i] = 0x18
@@ -5387,7 +7372,7 @@ func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListFileHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5397,7 +7382,7 @@ func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListFileHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5406,16 +7391,21 @@ func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n45, err := m.File.MarshalTo(dAtA[i:])
+		n63, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n63
+	}
+	if m.Number != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
 	}
 	return i, nil
 }
 
-func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *FileHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5425,33 +7415,50 @@ func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileHistory) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
+	if m.Commit != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n64, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
 	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Finished != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
+		n65, err := m.Finished.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	if m.FileInfo != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileInfo.Size()))
+		n66, err := m.FileInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n66
+	}
+	if m.SizeDelta != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeDelta))
 	}
 	return i, nil
 }
 
-func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *FileHistories) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5461,13 +7468,13 @@ func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileHistories) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
+	if len(m.History) > 0 {
+		for _, msg := range m.History {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
@@ -5478,25 +7485,10 @@ func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
-	}
 	return i, nil
 }
 
-func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5506,37 +7498,25 @@ func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
+	if m.File != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n46, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n67, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n67
 	}
 	return i, nil
 }
 
-func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5546,15 +7526,21 @@ func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
 	return i, nil
 }
 
-func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TransactionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5564,31 +7550,95 @@ func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransactionRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prefix) > 0 {
+	if m.CreateRepo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
-		i += copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPfs(dAtA, i, uint64(m.CreateRepo.Size()))
+		n68, err := m.CreateRepo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
 	}
-	if m.IncludeObject {
-		dAtA[i] = 0x10
+	if m.StartCommit != nil {
+		dAtA[i] = 0x12
 		i++
-		if m.IncludeObject {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPfs(dAtA, i, uint64(m.StartCommit.Size()))
+		n69, err := m.StartCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n70, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if m.FinishCommit != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FinishCommit.Size()))
+		n71, err := m.FinishCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if m.SetBranch != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SetBranch.Size()))
+		n72, err := m.SetBranch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	if m.DeleteFile != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.DeleteFile.Size()))
+		n73, err := m.DeleteFile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	if m.PutFile != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PutFile.Size()))
+		n74, err := m.PutFile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n74
+	}
+	if m.PutFileRecords != nil {
+		dAtA[i] = 0x42
 		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PutFileRecords.Size()))
+		n75, err := m.PutFileRecords.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
 	}
 	return i, nil
 }
 
-func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransactionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5598,31 +7648,52 @@ func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransactionInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tag) > 0 {
+	if m.Transaction != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
-		i += copy(dAtA[i:], m.Tag)
-	}
-	if m.Object != nil {
-		dAtA[i] = 0x12
+		i = encodeVarintPfs(dAtA, i, uint64(m.Transaction.Size()))
+		n76, err := m.Transaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Started != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n47, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
+		n77, err := m.Started.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n77
+	}
+	if m.NumRequests != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.NumRequests))
 	}
 	return i, nil
 }
 
-func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *StartTransactionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5632,27 +7703,43 @@ func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return i, nil
+}
+
+func (m *FinishTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinishTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Transaction.Size()))
+		n78, err := m.Transaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n78
 	}
 	return i, nil
 }
 
-func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteTransactionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5662,15 +7749,25 @@ func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Transaction != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Transaction.Size()))
+		n79, err := m.Transaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
+	}
 	return i, nil
 }
 
-func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5680,30 +7777,33 @@ func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
 	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			dAtA[i] = 0xa
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
 			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+			i += n
 		}
 	}
 	return i, nil
 }
 
-func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5713,15 +7813,42 @@ func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
+	}
 	return i, nil
 }
 
-func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5731,7 +7858,7 @@ func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5740,16 +7867,28 @@ func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n48, err := m.Object.MarshalTo(dAtA[i:])
+		n80, err := m.Object.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n80
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5759,15 +7898,39 @@ func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Exists {
-		dAtA[i] = 0x8
+	return i, nil
+}
+
+func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0xa
 		i++
-		if m.Exists {
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if m.IncludeObject {
+		dAtA[i] = 0x10
+		i++
+		if m.IncludeObject {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -5777,7 +7940,7 @@ func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Objects) Marshal() (dAtA []byte, err error) {
+func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5787,7 +7950,41 @@ func (m *Objects) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
+		i += copy(dAtA[i:], m.Tag)
+	}
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n81, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	return i, nil
+}
+
+func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5807,7 +8004,7 @@ func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5817,36 +8014,191 @@ func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for k, _ := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			v := m.Objects[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+	return i, nil
+}
+
+func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
 				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n49, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n49
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n82, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	return i, nil
+}
+
+func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		dAtA[i] = 0x8
+		i++
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Objects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for k, _ := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			v := m.Objects[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n83, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n83
 			}
 		}
 	}
@@ -5870,11 +8222,11 @@ func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n50, err := v.MarshalTo(dAtA[i:])
+				n84, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n50
+				i += n84
 			}
 		}
 	}
@@ -5900,6 +8252,20 @@ func (m *Repo) Size() (n int) {
 	return n
 }
 
+func (m *Branch) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
 func (m *BranchInfo) Size() (n int) {
 	var l int
 	_ = l
@@ -5911,6 +8277,22 @@ func (m *BranchInfo) Size() (n int) {
 		l = m.Head.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.Subvenance) > 0 {
+		for _, e := range m.Subvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
@@ -5998,6 +8380,40 @@ func (m *RepoInfo) Size() (n int) {
 		l = m.AuthInfo.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
+	}
+	if len(m.QuotaWarnings) > 0 {
+		for _, s := range m.QuotaWarnings {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.TreeSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.TreeSizeBytes))
+	}
+	return n
+}
+
+func (m *Quota) Size() (n int) {
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
+	}
+	if m.SoftSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SoftSizeBytes))
+	}
+	if m.SoftFileCount != 0 {
+		n += 1 + sovPfs(uint64(m.SoftFileCount))
+	}
 	return n
 }
 
@@ -6060,6 +8476,10 @@ func (m *CommitInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.MergeParent != nil {
+		l = m.MergeParent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
@@ -6092,6 +8512,26 @@ func (m *FileInfo) Size() (n int) {
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.ModifiedCommit != nil {
+		l = m.ModifiedCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Modified != nil {
+		l = m.Modified.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
@@ -6168,6 +8608,24 @@ func (m *InspectRepoRequest) Size() (n int) {
 	return n
 }
 
+func (m *SetQuotaRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
 func (m *ListRepoRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -6267,6 +8725,13 @@ func (m *FinishCommitRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Empty {
+		n += 2
+	}
 	return n
 }
 
@@ -6337,6 +8802,39 @@ func (m *SetBranchRequest) Size() (n int) {
 	return n
 }
 
+func (m *CreateBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.ClearProvenance {
+		n += 2
+	}
+	return n
+}
+
+func (m *InspectBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
 func (m *DeleteBranchRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -6361,10 +8859,86 @@ func (m *DeleteCommitRequest) Size() (n int) {
 	return n
 }
 
-func (m *FlushCommitRequest) Size() (n int) {
+func (m *SquashCommitRequest) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *RevertCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeBranchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.FromBranch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ToBranch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeConflict) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeBranchResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlushCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
 		for _, e := range m.Commits {
 			l = e.Size()
 			n += 1 + l + sovPfs(uint64(l))
@@ -6394,6 +8968,9 @@ func (m *SubscribeCommitRequest) Size() (n int) {
 		l = m.From.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.State != 0 {
+		n += 1 + sovPfs(uint64(m.State))
+	}
 	return n
 }
 
@@ -6453,6 +9030,14 @@ func (m *PutFileRequest) Size() (n int) {
 		l = m.OverwriteIndex.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -6488,6 +9073,36 @@ func (m *PutFileRecords) Size() (n int) {
 	if m.Tombstone {
 		n += 2
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateSymlinkRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
@@ -6508,6 +9123,20 @@ func (m *CopyFileRequest) Size() (n int) {
 	return n
 }
 
+func (m *MoveFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Dst != nil {
+		l = m.Dst.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
 func (m *InspectFileRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -6542,6 +9171,14 @@ func (m *GlobFileRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -6592,6 +9229,52 @@ func (m *DiffFileResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListFileHistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *FileHistory) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileInfo != nil {
+		l = m.FileInfo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeDelta != 0 {
+		n += 1 + sovPfs(uint64(m.SizeDelta))
+	}
+	return n
+}
+
+func (m *FileHistories) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DeleteFileRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -6602,6 +9285,103 @@ func (m *DeleteFileRequest) Size() (n int) {
 	return n
 }
 
+func (m *Transaction) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.CreateRepo != nil {
+		l = m.CreateRepo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.StartCommit != nil {
+		l = m.StartCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FinishCommit != nil {
+		l = m.FinishCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SetBranch != nil {
+		l = m.SetBranch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.DeleteFile != nil {
+		l = m.DeleteFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.PutFile != nil {
+		l = m.PutFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.PutFileRecords != nil {
+		l = m.PutFileRecords.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.NumRequests != 0 {
+		n += 1 + sovPfs(uint64(m.NumRequests))
+	}
+	return n
+}
+
+func (m *StartTransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FinishTransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteTransactionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
 func (m *PutObjectRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -6767,40 +9547,2738 @@ func (m *ObjectIndex) Size() (n int) {
 				l = v.Size()
 				l += 1 + sovPfs(uint64(l))
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Tags) > 0 {
-		for k, v := range m.Tags {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovPfs(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPfs(x uint64) (n int) {
+	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Repo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Branch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subvenance = append(m.Subvenance, &Branch{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
+			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *File) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &RepoAuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotaWarnings = append(m.QuotaWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeSizeBytes", wireType)
+			}
+			m.TreeSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreeSizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftSizeBytes", wireType)
+			}
+			m.SoftSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SoftSizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftFileCount", wireType)
+			}
+			m.SoftFileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SoftFileCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			}
+			m.AccessLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessLevel |= (auth.Scope(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeParent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeParent == nil {
+				m.MergeParent = &Commit{}
+			}
+			if err := m.MergeParent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			}
+			m.FileType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileType |= (FileType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedCommit == nil {
+				m.ModifiedCommit = &Commit{}
+			}
+			if err := m.ModifiedCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Modified.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByteRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			m.Lower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lower |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			m.Upper = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upper |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &ByteRange{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRef == nil {
+				m.BlockRef = &BlockRef{}
+			}
+			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovPfs(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozPfs(x uint64) (n int) {
-	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Repo) Unmarshal(dAtA []byte) error {
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6823,17 +12301,17 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6843,20 +12321,24 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6879,7 +12361,7 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+func (m *SetQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6902,17 +12384,17 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -6922,24 +12404,28 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6963,13 +12449,42 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -6991,7 +12506,7 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7014,15 +12529,15 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7046,8 +12561,8 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
-			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Provenance = append(m.Provenance, &Repo{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7072,7 +12587,7 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *File) Unmarshal(dAtA []byte) error {
+func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7095,15 +12610,15 @@ func (m *File) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7127,42 +12642,11 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
+			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7184,7 +12668,7 @@ func (m *File) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7207,17 +12691,70 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7227,21 +12764,12 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7263,7 +12791,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Object) Unmarshal(dAtA []byte) error {
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7286,17 +12814,17 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7306,74 +12834,88 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
+			if m.Parent == nil {
+				m.Parent = &Commit{}
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Tag) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7398,7 +12940,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7421,7 +12963,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7444,15 +12986,15 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7476,16 +13018,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7509,35 +13051,126 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &google_protobuf1.Timestamp{}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7561,12 +13194,14 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -7595,9 +13230,9 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7621,68 +13256,18 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &RepoAuthInfo{}
+			if m.Tree == nil {
+				m.Tree = &Object{}
 			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
 			}
-			m.AccessLevel = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -7692,11 +13277,12 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessLevel |= (auth.Scope(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Empty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7718,7 +13304,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7741,15 +13327,15 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7773,42 +13359,13 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -7830,7 +13387,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7853,15 +13410,15 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7885,16 +13442,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7918,16 +13475,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7951,16 +13508,85 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			if m.To == nil {
+				m.To = &Commit{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7984,35 +13610,64 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &google_protobuf1.Timestamp{}
-			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
+			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8036,14 +13691,66 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8067,16 +13774,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8101,7 +13808,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8124,7 +13831,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8147,15 +13854,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8179,18 +13886,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
-			m.FileType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8200,35 +13907,30 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileType |= (FileType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8238,26 +13940,28 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearProvenance", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8267,26 +13971,65 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			m.ClearProvenance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8310,8 +14053,10 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8336,7 +14081,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ByteRange) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8359,17 +14104,17 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			m.Lower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8379,16 +14124,30 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lower |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			m.Upper = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8398,11 +14157,21 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Upper |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8424,7 +14193,7 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRef) Unmarshal(dAtA []byte) error {
+func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8447,15 +14216,15 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8479,16 +14248,66 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &Block{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SquashCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SquashCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SquashCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8512,10 +14331,10 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &ByteRange{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8540,7 +14359,7 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *RevertCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8563,15 +14382,15 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevertCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevertCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8595,18 +14414,18 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8616,24 +14435,20 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRef == nil {
-				m.BlockRef = &BlockRef{}
-			}
-			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8656,7 +14471,7 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8679,10 +14494,10 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8720,9 +14535,9 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8732,26 +14547,24 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8776,13 +14589,13 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ToBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8792,12 +14605,21 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Update = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8819,7 +14641,7 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeConflict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8842,17 +14664,17 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeConflict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeConflict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8862,24 +14684,49 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8902,7 +14749,7 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeBranchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8925,15 +14772,15 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeBranchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeBranchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8957,8 +14804,41 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Repo{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, &MergeConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8983,7 +14863,7 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9006,15 +14886,15 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9038,8 +14918,39 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
-			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRepos = append(m.ToRepos, &Repo{})
+			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9064,7 +14975,7 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9087,10 +14998,10 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9127,10 +15038,10 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9140,17 +15051,59 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9160,12 +15113,11 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.State |= (CommitState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9187,7 +15139,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9210,15 +15162,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9242,18 +15194,18 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
 			}
-			var msglen int
+			m.OffsetBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9263,28 +15215,16 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.OffsetBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9294,26 +15234,66 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9323,21 +15303,11 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Index |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9359,7 +15329,7 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9382,15 +15352,15 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9414,18 +15384,18 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9435,28 +15405,28 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9466,30 +15436,26 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
-			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9499,74 +15465,72 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Recursive = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			m.Delimiter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			m.TargetFileDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TargetFileBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9590,18 +15554,18 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9611,20 +15575,109 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9647,7 +15700,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9670,15 +15723,63 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9702,10 +15803,10 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9730,7 +15831,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9753,17 +15854,17 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9773,28 +15874,15 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Split = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9818,18 +15906,16 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &PutFileRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9839,30 +15925,17 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.To == nil {
-				m.To = &Commit{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Tombstone = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.Number = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9872,66 +15945,115 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9941,76 +16063,24 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
-			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10034,10 +16104,10 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Tree == nil {
+				m.Tree = &Object{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10062,7 +16132,7 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateSymlinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10085,15 +16155,15 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSymlinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSymlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10117,16 +16187,16 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10151,7 +16221,7 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10174,7 +16244,7 @@ func (m *SetBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10197,15 +16267,15 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10229,18 +16299,18 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Src == nil {
+				m.Src = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10250,21 +16320,45 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Dst == nil {
+				m.Dst = &File{}
+			}
+			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10286,7 +16380,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *MoveFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10309,15 +16403,15 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10341,10 +16435,43 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Src == nil {
+				m.Src = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dst == nil {
+				m.Dst = &File{}
+			}
+			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10369,7 +16496,7 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10392,15 +16519,15 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10424,39 +16551,10 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.File == nil {
+				m.File = &File{}
 			}
-			m.ToRepos = append(m.ToRepos, &Repo{})
-			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10481,7 +16579,7 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10504,15 +16602,15 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10536,47 +16634,18 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10586,25 +16655,12 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10626,7 +16682,7 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10649,15 +16705,15 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10681,18 +16737,18 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			m.OffsetBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10702,16 +16758,26 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffsetBytes |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10721,11 +16787,110 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10747,7 +16912,7 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
+func (m *FileInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10770,17 +16935,17 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10790,11 +16955,23 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileInfo = append(m.FileInfo, &FileInfo{})
+			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10816,7 +16993,7 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10839,50 +17016,17 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10892,28 +17036,30 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.NewFile == nil {
+				m.NewFile = &File{}
+			}
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10923,24 +17069,28 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			if m.OldFile == nil {
+				m.OldFile = &File{}
+			}
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10957,50 +17107,62 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Recursive = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
+			m.Shallow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Delimiter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TargetFileDatums = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TargetFileBytes = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11010,14 +17172,26 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFiles = append(m.NewFiles, &FileInfo{})
+			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11041,10 +17215,8 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
-			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OldFiles = append(m.OldFiles, &FileInfo{})
+			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11069,7 +17241,7 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
+func (m *ListFileHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11092,36 +17264,17 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFileHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFileHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11131,26 +17284,30 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectHash = string(dAtA[iNdEx:postIndex])
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11160,25 +17317,11 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Number |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
-			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11200,7 +17343,7 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
+func (m *FileHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11223,17 +17366,50 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11243,15 +17419,28 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Split = bool(v != 0)
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11275,16 +17464,18 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &PutFileRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FileInfo == nil {
+				m.FileInfo = &FileInfo{}
+			}
+			if err := m.FileInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeDelta", wireType)
 			}
-			var v int
+			m.SizeDelta = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11294,12 +17485,11 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.SizeDelta |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11321,7 +17511,7 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
+func (m *FileHistories) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11344,15 +17534,15 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileHistories: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileHistories: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11376,16 +17566,64 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Src == nil {
-				m.Src = &File{}
-			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, &FileHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11409,33 +17647,13 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Dst == nil {
-				m.Dst = &File{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11457,7 +17675,7 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
+func (m *Transaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11480,17 +17698,17 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11500,24 +17718,20 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11540,7 +17754,7 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11563,15 +17777,147 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateRepo == nil {
+				m.CreateRepo = &CreateRepoRequest{}
+			}
+			if err := m.CreateRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartCommit == nil {
+				m.StartCommit = &StartCommitRequest{}
+			}
+			if err := m.StartCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishCommit == nil {
+				m.FinishCommit = &FinishCommitRequest{}
+			}
+			if err := m.FinishCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBranch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11595,18 +17941,18 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.SetBranch == nil {
+				m.SetBranch = &SetBranchRequest{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SetBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteFile", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11616,65 +17962,28 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Full = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DeleteFile == nil {
+				m.DeleteFile = &DeleteFileRequest{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DeleteFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11698,18 +18007,18 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.PutFile == nil {
+				m.PutFile = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PutFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutFileRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11719,20 +18028,24 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			if m.PutFileRecords == nil {
+				m.PutFileRecords = &PutFileRecords{}
+			}
+			if err := m.PutFileRecords.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11755,7 +18068,7 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfos) Unmarshal(dAtA []byte) error {
+func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11778,15 +18091,15 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11810,64 +18123,16 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileInfo = append(m.FileInfo, &FileInfo{})
-			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11891,16 +18156,14 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFile == nil {
-				m.NewFile = &File{}
-			}
-			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &TransactionRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11924,18 +18187,18 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldFile == nil {
-				m.OldFile = &File{}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
 			}
-			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRequests", wireType)
 			}
-			var v int
+			m.NumRequests = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11945,12 +18208,11 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.NumRequests |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Shallow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11972,7 +18234,7 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+func (m *StartTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11995,46 +18257,65 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFiles = append(m.NewFiles, &FileInfo{})
-			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinishTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinishTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinishTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12058,8 +18339,10 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldFiles = append(m.OldFiles, &FileInfo{})
-			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12084,7 +18367,7 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12107,15 +18390,15 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12139,10 +18422,10 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13690,164 +19973,229 @@ var (
 func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptorPfs) }
 
 var fileDescriptorPfs = []byte{
-	// 2542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd6, 0x92, 0x14, 0xb9, 0x3c, 0xa4, 0x24, 0x6a, 0xac, 0xc8, 0x0c, 0x65, 0x5b, 0xca, 0xd8,
-	0x69, 0x6d, 0xc7, 0x95, 0x05, 0x39, 0xa9, 0xe3, 0xab, 0x60, 0x5d, 0xec, 0x28, 0x30, 0x2c, 0x63,
-	0xa5, 0xe6, 0xa1, 0x68, 0x41, 0x2c, 0xc9, 0x21, 0xb5, 0xf1, 0x72, 0x77, 0xb3, 0x3b, 0x94, 0xac,
-	0xfc, 0x81, 0xf6, 0xa5, 0xcf, 0x2d, 0xd0, 0x87, 0xf6, 0xaf, 0xf4, 0xad, 0x40, 0x81, 0xa2, 0xbf,
-	0xa0, 0x08, 0xdc, 0x5f, 0xd0, 0xe7, 0xbe, 0x14, 0x73, 0xd9, 0xdd, 0xd9, 0x0b, 0x45, 0x32, 0x40,
-	0x1e, 0x12, 0xcd, 0xce, 0xb9, 0xcc, 0xb9, 0xcd, 0x99, 0xef, 0xd0, 0xb0, 0xd2, 0xb5, 0x2d, 0xe2,
-	0xd0, 0xfb, 0x5e, 0x3f, 0x60, 0xff, 0x6d, 0x7a, 0xbe, 0x4b, 0x5d, 0x54, 0xf4, 0xfa, 0x41, 0x6b,
-	0x6d, 0xe0, 0xba, 0x03, 0x9b, 0xdc, 0xe7, 0x5b, 0x9d, 0x51, 0xff, 0x3e, 0x19, 0x7a, 0xf4, 0x42,
-	0x70, 0xb4, 0xd6, 0xd3, 0x44, 0x6a, 0x0d, 0x49, 0x40, 0xcd, 0xa1, 0x27, 0x19, 0x6e, 0xa4, 0x19,
-	0xce, 0x7d, 0xd3, 0xf3, 0x88, 0x2f, 0x8f, 0x68, 0xad, 0x0c, 0xdc, 0x81, 0xcb, 0x97, 0xf7, 0xd9,
-	0x4a, 0xee, 0xae, 0x4a, 0x73, 0xcc, 0x11, 0x3d, 0xe5, 0xff, 0x13, 0xfb, 0xb8, 0x05, 0x25, 0x83,
-	0x78, 0x2e, 0x42, 0x50, 0x72, 0xcc, 0x21, 0x69, 0x6a, 0x1b, 0xda, 0xed, 0xaa, 0xc1, 0xd7, 0xf8,
-	0x05, 0xc0, 0xae, 0x6f, 0x3a, 0xdd, 0xd3, 0x43, 0xa7, 0x9f, 0xcb, 0x81, 0xd6, 0xa1, 0x74, 0x4a,
-	0xcc, 0x5e, 0xb3, 0xb0, 0xa1, 0xdd, 0xae, 0x6d, 0xd7, 0x36, 0x99, 0xa3, 0x7b, 0xee, 0x70, 0x68,
-	0x51, 0x83, 0x13, 0xf0, 0x0e, 0xd4, 0x62, 0x15, 0x01, 0xda, 0x82, 0x5a, 0x87, 0x7f, 0xb6, 0x2d,
-	0xa7, 0xef, 0x36, 0xb5, 0x8d, 0xe2, 0xed, 0xda, 0xf6, 0x12, 0x17, 0x8b, 0xd9, 0x0c, 0xe8, 0x44,
-	0x6b, 0xbc, 0x03, 0xa5, 0x97, 0x96, 0x4d, 0xd0, 0x4d, 0x28, 0x77, 0xb9, 0x62, 0x7e, 0x7e, 0xea,
-	0x2c, 0x49, 0x62, 0x26, 0x7a, 0x26, 0x3d, 0xe5, 0xe6, 0x54, 0x0d, 0xbe, 0xc6, 0x6b, 0x30, 0xbf,
-	0x6b, 0xbb, 0xdd, 0x77, 0x8c, 0x78, 0x6a, 0x06, 0xa7, 0xa1, 0xfd, 0x6c, 0x8d, 0xaf, 0x41, 0xf9,
-	0xa8, 0xf3, 0x2d, 0xe9, 0xd2, 0x5c, 0xea, 0xc7, 0x50, 0x3c, 0x31, 0x07, 0xb9, 0xa1, 0xf9, 0x9f,
-	0x06, 0x3a, 0x8b, 0x1b, 0x8f, 0xcc, 0x75, 0x28, 0xf9, 0xc4, 0x73, 0xa5, 0x65, 0x55, 0x6e, 0x19,
-	0x23, 0x1a, 0x7c, 0x1b, 0x7d, 0x0e, 0x95, 0xae, 0x4f, 0x4c, 0x4a, 0xc2, 0x38, 0xb5, 0x36, 0x45,
-	0x0a, 0x37, 0xc3, 0x14, 0x6e, 0x9e, 0x84, 0x39, 0x36, 0x42, 0x56, 0x74, 0x1d, 0x20, 0xb0, 0xbe,
-	0x27, 0xed, 0xce, 0x05, 0x25, 0x41, 0xb3, 0xb8, 0xa1, 0xdd, 0x2e, 0x19, 0x55, 0xb6, 0xb3, 0xcb,
-	0x36, 0xd0, 0x1d, 0x00, 0xcf, 0x77, 0xcf, 0x88, 0x63, 0x3a, 0x5d, 0xd2, 0x2c, 0xf1, 0x40, 0x2a,
-	0x27, 0x2b, 0x44, 0xb4, 0x01, 0xb5, 0x1e, 0x09, 0xba, 0xbe, 0xe5, 0x51, 0xcb, 0x75, 0x9a, 0xf3,
-	0xdc, 0x0d, 0x75, 0x0b, 0x6d, 0x42, 0x95, 0x95, 0x84, 0x48, 0x4a, 0x99, 0xdb, 0xb8, 0x1c, 0xe9,
-	0x7a, 0x31, 0xa2, 0x22, 0x2d, 0xba, 0x29, 0x57, 0xf8, 0x39, 0xd4, 0x55, 0x0a, 0xda, 0x84, 0xba,
-	0xd9, 0xed, 0x92, 0x20, 0x68, 0xdb, 0xe4, 0x8c, 0xd8, 0x3c, 0x10, 0x8b, 0xdb, 0xb5, 0x4d, 0x5e,
-	0x67, 0xc7, 0x5d, 0xd7, 0x23, 0x46, 0x4d, 0x30, 0xbc, 0x66, 0x74, 0xbc, 0x03, 0x65, 0x91, 0xb9,
-	0x49, 0xa1, 0x5b, 0x85, 0x82, 0x25, 0xa2, 0x56, 0xdd, 0x2d, 0x7f, 0xf8, 0xf7, 0x7a, 0xe1, 0x70,
-	0xdf, 0x28, 0x58, 0x3d, 0xfc, 0x43, 0x01, 0x40, 0x68, 0xe0, 0xe7, 0x4f, 0x55, 0x1c, 0x5b, 0xb0,
-	0xe0, 0x99, 0x3e, 0x71, 0x68, 0x5b, 0xf2, 0xe6, 0x14, 0x6d, 0x5d, 0x70, 0x48, 0xe3, 0x3e, 0x87,
-	0x4a, 0x40, 0x4d, 0x9f, 0x25, 0xae, 0x38, 0x39, 0x71, 0x92, 0x15, 0xfd, 0x12, 0xf4, 0xbe, 0xe5,
-	0x58, 0xc1, 0x29, 0xe9, 0x35, 0x4b, 0x13, 0xc5, 0x22, 0xde, 0x54, 0xc2, 0xe7, 0xd3, 0x09, 0xff,
-	0x2c, 0x91, 0xf0, 0x32, 0x4f, 0x78, 0xc2, 0x76, 0x35, 0xe5, 0xeb, 0x50, 0xa2, 0x3e, 0x21, 0xcd,
-	0x8a, 0xe2, 0xa2, 0x28, 0x74, 0x83, 0x13, 0xd2, 0x35, 0xa1, 0x67, 0x6a, 0x02, 0xff, 0x43, 0x03,
-	0x9d, 0xdd, 0xbc, 0xb0, 0xc2, 0xfb, 0x96, 0x4d, 0x12, 0x69, 0x62, 0x44, 0x83, 0x6f, 0xa3, 0xbb,
-	0x50, 0x65, 0x7f, 0xdb, 0xf4, 0xc2, 0x23, 0x3c, 0xac, 0x8b, 0xdb, 0x0b, 0x11, 0xcf, 0xc9, 0x85,
-	0x47, 0x98, 0x9b, 0x62, 0x35, 0xa9, 0xae, 0x5b, 0xa0, 0x77, 0x4f, 0x2d, 0xbb, 0xe7, 0x13, 0x87,
-	0x3b, 0x59, 0x35, 0xa2, 0xef, 0xe8, 0x8e, 0x32, 0xaf, 0xea, 0xe2, 0x8e, 0xa2, 0x4f, 0xa1, 0xe2,
-	0x72, 0xc7, 0x82, 0xa6, 0xae, 0xc4, 0x44, 0x3a, 0x1b, 0xd2, 0xf0, 0x43, 0xa8, 0x32, 0xfd, 0x86,
-	0xe9, 0x0c, 0x08, 0x5a, 0x81, 0x79, 0xdb, 0x3d, 0x27, 0x3e, 0x77, 0xa7, 0x64, 0x88, 0x0f, 0xb6,
-	0x3b, 0x62, 0x7d, 0x94, 0x3b, 0x50, 0x32, 0xc4, 0x07, 0x36, 0x40, 0xe7, 0xed, 0xc3, 0x20, 0x7d,
-	0xb4, 0x01, 0xf3, 0x1d, 0xb6, 0x96, 0x61, 0x00, 0xd1, 0xb7, 0x38, 0x55, 0x10, 0xd0, 0x2d, 0x98,
-	0xf7, 0xd9, 0x11, 0xb2, 0xb6, 0x16, 0x05, 0x47, 0x78, 0xb0, 0x21, 0x88, 0xf8, 0xb7, 0x00, 0xc2,
-	0xbe, 0xb0, 0x78, 0x85, 0x95, 0x89, 0xe2, 0x95, 0x0e, 0x48, 0x12, 0x8b, 0x30, 0x3f, 0xa1, 0xed,
-	0x93, 0xbe, 0x54, 0xbe, 0xa0, 0x1c, 0x4f, 0xfa, 0x86, 0xde, 0x91, 0x2b, 0xfc, 0x47, 0x0d, 0x96,
-	0xf7, 0x78, 0x17, 0xe1, 0x37, 0x89, 0x7c, 0x37, 0x22, 0xc1, 0xc4, 0x9b, 0x96, 0xec, 0x27, 0x85,
-	0x19, 0xfa, 0x49, 0x31, 0xdb, 0x4f, 0x56, 0xa1, 0x3c, 0xf2, 0x7a, 0x26, 0x25, 0xfc, 0x02, 0xe8,
-	0x86, 0xfc, 0xc2, 0x0f, 0x00, 0x1d, 0x3a, 0x81, 0xc7, 0x1c, 0x9b, 0xda, 0x32, 0xfc, 0x14, 0x96,
-	0x5e, 0x5b, 0x41, 0x42, 0x22, 0x69, 0xac, 0x76, 0x89, 0xb1, 0xf8, 0x39, 0x34, 0x62, 0xe9, 0xc0,
-	0x73, 0x9d, 0x80, 0x97, 0x2b, 0xd3, 0xac, 0xbe, 0x41, 0x0b, 0x91, 0xb4, 0x68, 0x75, 0xbe, 0x5c,
-	0xe1, 0x5f, 0xc3, 0xf2, 0x3e, 0xb1, 0xc9, 0x4c, 0xb1, 0x5c, 0x81, 0xf9, 0xbe, 0xeb, 0x77, 0x45,
-	0x15, 0xe8, 0x86, 0xf8, 0x40, 0x0d, 0x28, 0x9a, 0xb6, 0xcd, 0xc3, 0xa5, 0x1b, 0x6c, 0x89, 0xff,
-	0xaa, 0x01, 0x3a, 0x66, 0x5d, 0x43, 0xde, 0x60, 0xa9, 0xfd, 0x26, 0x94, 0x45, 0x1b, 0xca, 0xed,
-	0x66, 0x82, 0x94, 0x6a, 0x07, 0x85, 0xcb, 0xdb, 0xc1, 0x2a, 0x94, 0xc5, 0x93, 0x2a, 0x93, 0x25,
-	0xbf, 0xd2, 0x99, 0x2c, 0x65, 0xbb, 0xc0, 0x5f, 0x34, 0x40, 0xbb, 0x23, 0xcb, 0xee, 0xfd, 0xd4,
-	0x26, 0x86, 0x1d, 0xab, 0x38, 0xae, 0x63, 0xc5, 0x3e, 0x94, 0x54, 0x1f, 0xf0, 0x6f, 0xe0, 0xca,
-	0x4b, 0xde, 0x42, 0x33, 0x16, 0x4e, 0x7e, 0x12, 0x52, 0xfe, 0x17, 0xb2, 0xfe, 0x3f, 0x81, 0x15,
-	0x59, 0xb1, 0xb3, 0xab, 0xc7, 0xbf, 0xd7, 0x60, 0x99, 0x15, 0x5f, 0x52, 0x74, 0x42, 0xf1, 0xac,
-	0x43, 0xa9, 0xef, 0xbb, 0xc3, 0x5c, 0x48, 0xc5, 0x08, 0x68, 0x0d, 0x0a, 0xd4, 0x4d, 0xc4, 0x49,
-	0x92, 0x0b, 0x94, 0x3d, 0x98, 0x65, 0x67, 0x34, 0xec, 0x10, 0x9f, 0x47, 0xa9, 0x64, 0xc8, 0x2f,
-	0x86, 0xc3, 0xe2, 0xf7, 0x92, 0xe3, 0x30, 0x61, 0x63, 0x16, 0x87, 0xc5, 0x6c, 0x06, 0x74, 0xa3,
-	0x35, 0xde, 0x16, 0xae, 0x08, 0x94, 0x36, 0xe5, 0xcd, 0x3d, 0x82, 0xc6, 0x31, 0x49, 0x89, 0x4c,
-	0x95, 0x97, 0x38, 0xd7, 0x85, 0x44, 0xae, 0x5f, 0xc3, 0x15, 0x71, 0x19, 0x67, 0x31, 0x63, 0xac,
-	0xb6, 0xc7, 0xa1, 0xb6, 0x1f, 0x91, 0x5a, 0x13, 0xd0, 0x4b, 0x7b, 0x94, 0x2e, 0xba, 0x4f, 0xa1,
-	0x22, 0xe8, 0x81, 0x0c, 0x69, 0x42, 0x36, 0xa4, 0xa1, 0x5b, 0xa0, 0x53, 0xb7, 0xcd, 0x6c, 0x0b,
-	0xb2, 0x9d, 0xb6, 0x42, 0x5d, 0xf6, 0x37, 0xc0, 0x1e, 0xac, 0x1e, 0x8f, 0x3a, 0xac, 0x14, 0x3b,
-	0x64, 0xa6, 0x0a, 0x1a, 0xe3, 0x6f, 0x54, 0x59, 0xc5, 0x31, 0x95, 0x85, 0xbf, 0x83, 0xc5, 0x57,
-	0x84, 0xf2, 0x77, 0x3d, 0x3e, 0xe9, 0xb2, 0x77, 0xff, 0x13, 0xa8, 0xbb, 0xfd, 0x7e, 0x40, 0xa8,
-	0x7c, 0xcd, 0xd9, 0x79, 0x45, 0xa3, 0x26, 0xf6, 0xc4, 0x7b, 0x9e, 0x7d, 0xee, 0x8b, 0xca, 0x73,
-	0x8f, 0x7f, 0x06, 0x8b, 0x47, 0x67, 0xc4, 0x3f, 0xf7, 0x2d, 0x4a, 0x0e, 0x9d, 0x1e, 0x79, 0xcf,
-	0x9a, 0xa7, 0xc5, 0x16, 0xfc, 0xcc, 0xa2, 0x21, 0x3e, 0xf0, 0xdf, 0x0a, 0xb0, 0xf8, 0x76, 0x34,
-	0x8b, 0x6d, 0x2b, 0x30, 0x7f, 0x66, 0xda, 0x23, 0xd1, 0x51, 0xea, 0x86, 0xf8, 0x60, 0x4d, 0x78,
-	0xe4, 0xdb, 0x12, 0x03, 0xb3, 0x25, 0xba, 0xc6, 0x1e, 0x83, 0xee, 0xc8, 0x0f, 0xac, 0x33, 0xc2,
-	0xb1, 0xaf, 0x6e, 0xc4, 0x1b, 0xe8, 0x1e, 0x54, 0x7b, 0xc4, 0xb6, 0x86, 0x16, 0x25, 0x3e, 0xc7,
-	0x1d, 0x8b, 0xf2, 0x51, 0xdf, 0x0f, 0x77, 0x8d, 0x98, 0x01, 0xdd, 0x03, 0x44, 0x4d, 0x7f, 0x40,
-	0x68, 0x9b, 0xc3, 0xa1, 0x9e, 0x49, 0x47, 0xc3, 0x80, 0x83, 0xab, 0xa2, 0xd1, 0x10, 0x14, 0x66,
-	0xe1, 0x3e, 0xdf, 0x47, 0x77, 0x61, 0x59, 0xe5, 0x16, 0x11, 0xaa, 0x72, 0xe6, 0xa5, 0x98, 0x59,
-	0x84, 0xf1, 0x29, 0x2c, 0xb9, 0x61, 0x9c, 0xda, 0x22, 0x3e, 0xc0, 0xfd, 0xbe, 0x22, 0x3a, 0x65,
-	0x22, 0x86, 0xc6, 0xa2, 0x9b, 0xf8, 0xfe, 0xba, 0xa4, 0x17, 0x1a, 0x45, 0xfc, 0x07, 0x0d, 0x16,
-	0xa2, 0x18, 0x76, 0x5d, 0x3f, 0x0d, 0x39, 0xb5, 0x54, 0x72, 0xd0, 0x3a, 0xd4, 0x04, 0xfc, 0x68,
-	0x73, 0xd8, 0x25, 0xaa, 0x09, 0xc4, 0xd6, 0x57, 0x0c, 0x7c, 0xe5, 0x58, 0x55, 0x9c, 0xda, 0x2a,
-	0xec, 0x2b, 0x29, 0x65, 0xe6, 0x04, 0x2c, 0x67, 0x81, 0x67, 0xcb, 0x9b, 0xa7, 0x1b, 0xe2, 0x03,
-	0xdd, 0x83, 0x8a, 0x2f, 0x18, 0xe4, 0x6d, 0x41, 0x5c, 0x7b, 0x42, 0xd6, 0x08, 0x59, 0x58, 0x3e,
-	0xa9, 0x3b, 0xec, 0x04, 0xd4, 0x75, 0x88, 0x7c, 0x6c, 0xe3, 0x0d, 0x6c, 0xc1, 0xd2, 0x9e, 0xeb,
-	0x5d, 0xa8, 0x75, 0xb4, 0x06, 0xc5, 0xc0, 0xef, 0x66, 0xcb, 0x88, 0xed, 0x32, 0x62, 0x2f, 0x08,
-	0x47, 0x05, 0x95, 0xd8, 0x0b, 0x28, 0x3b, 0x2a, 0x72, 0x29, 0x3c, 0x2a, 0xda, 0x50, 0xc0, 0xce,
-	0xf4, 0x55, 0x8b, 0xf7, 0x05, 0xd8, 0x99, 0xa1, 0xce, 0x11, 0x94, 0xfa, 0x23, 0xdb, 0x96, 0x58,
-	0x83, 0xaf, 0xf1, 0x5b, 0x58, 0x7a, 0x65, 0xbb, 0x1d, 0x55, 0xcb, 0x54, 0x7d, 0xb7, 0x09, 0x15,
-	0xcf, 0xa4, 0x94, 0xf8, 0xe1, 0x5b, 0x18, 0x7e, 0x32, 0xfc, 0x1c, 0x0e, 0x03, 0x41, 0x04, 0xf7,
-	0x33, 0xf8, 0x29, 0x64, 0x11, 0x70, 0x9f, 0xbf, 0x1b, 0xe7, 0xb0, 0xb4, 0x6f, 0xf5, 0xfb, 0xaa,
-	0x29, 0xb7, 0x40, 0x77, 0xc8, 0x79, 0x3b, 0xdf, 0xa9, 0x8a, 0x43, 0xce, 0xf9, 0xc0, 0x7f, 0x0b,
-	0x74, 0xd7, 0xee, 0x09, 0xae, 0x4c, 0xf8, 0x2b, 0xae, 0xdd, 0xe3, 0x5c, 0x4d, 0xa8, 0x04, 0xa7,
-	0xa6, 0x6d, 0xbb, 0xe7, 0x32, 0x01, 0xe1, 0x27, 0xfe, 0x16, 0x1a, 0xf1, 0xc1, 0x31, 0xf0, 0x0b,
-	0x4f, 0x0e, 0xc6, 0x18, 0x2e, 0x8f, 0xe7, 0x4e, 0x86, 0xe7, 0x87, 0x75, 0x97, 0xe6, 0x95, 0x46,
-	0x04, 0xec, 0x71, 0x14, 0x2f, 0xc9, 0x0c, 0x99, 0x7e, 0x09, 0x8d, 0xb7, 0x23, 0x2a, 0x21, 0x8e,
-	0x14, 0x89, 0x7a, 0x96, 0xa6, 0xf6, 0xac, 0x6b, 0x50, 0xa2, 0xe6, 0x20, 0x34, 0x42, 0xe7, 0x8a,
-	0x4e, 0xcc, 0x81, 0xc1, 0x77, 0xf1, 0x9f, 0x35, 0x58, 0x7e, 0x45, 0xa4, 0xa2, 0x40, 0x79, 0x89,
-	0xc2, 0xb1, 0x48, 0x1b, 0x3f, 0x16, 0xe5, 0x36, 0xf0, 0xd2, 0xa4, 0x06, 0x9e, 0x98, 0xd7, 0xae,
-	0x03, 0x50, 0x97, 0x9a, 0x76, 0x9b, 0x6d, 0x49, 0xd0, 0x51, 0xe5, 0x3b, 0xc7, 0xd6, 0xf7, 0x04,
-	0xff, 0x0a, 0x1a, 0x27, 0xe6, 0x20, 0xe9, 0xe5, 0x54, 0x03, 0xcf, 0xe5, 0x4e, 0xaf, 0x00, 0x62,
-	0xd7, 0x24, 0xe9, 0x34, 0x3e, 0x12, 0x97, 0xe7, 0xc4, 0x1c, 0x44, 0x71, 0x58, 0x85, 0xb2, 0xe7,
-	0x93, 0xbe, 0xf5, 0x5e, 0xfe, 0x7a, 0x23, 0xbf, 0xd0, 0x2d, 0x58, 0xb0, 0x9c, 0xae, 0x3d, 0xea,
-	0x11, 0xa1, 0x43, 0x5e, 0x9f, 0xe4, 0x26, 0x3e, 0x14, 0xc3, 0x83, 0x50, 0x28, 0x6b, 0xa8, 0x01,
-	0x45, 0x6a, 0x0e, 0xa4, 0x3a, 0xb6, 0x54, 0xfc, 0x29, 0x8c, 0xf5, 0x07, 0x3f, 0x83, 0x15, 0x51,
-	0x22, 0x3f, 0x2a, 0x51, 0xf8, 0x2a, 0x7c, 0x94, 0x12, 0x17, 0xe6, 0xe0, 0x9f, 0x87, 0xa5, 0xa7,
-	0x7a, 0x8d, 0x64, 0xf0, 0x34, 0x3e, 0x40, 0x47, 0x21, 0x53, 0x19, 0xa5, 0xf8, 0x23, 0x40, 0x7b,
-	0xa7, 0xa4, 0xfb, 0x6e, 0xf6, 0x0c, 0xe1, 0x5f, 0xc0, 0x95, 0x84, 0xa8, 0x8c, 0xcf, 0x2a, 0x94,
-	0xc9, 0x7b, 0x2b, 0xa0, 0x81, 0x6c, 0xe2, 0xf2, 0x0b, 0x6f, 0x41, 0x45, 0xda, 0x3e, 0xad, 0xcf,
-	0xbf, 0x2b, 0x40, 0x2d, 0x9c, 0x93, 0x19, 0x32, 0x78, 0x98, 0x16, 0xbb, 0xae, 0x88, 0x71, 0x16,
-	0xb9, 0x0e, 0x0e, 0x1c, 0xea, 0x5f, 0xc4, 0x55, 0xbe, 0x99, 0xa8, 0xa5, 0x56, 0x46, 0x8a, 0x45,
-	0x44, 0x88, 0x70, 0xbe, 0xd6, 0x21, 0xd4, 0x55, 0x45, 0x2c, 0xe5, 0xef, 0xc8, 0x45, 0x98, 0xf2,
-	0x77, 0xe4, 0x02, 0xdd, 0x0c, 0x2f, 0x6a, 0xee, 0x28, 0x2e, 0x68, 0x8f, 0x0b, 0x5f, 0x6a, 0xad,
-	0x7d, 0xa8, 0x46, 0xda, 0x73, 0xf4, 0x7c, 0x92, 0xd4, 0x93, 0x88, 0x43, 0xac, 0xe5, 0xee, 0x67,
-	0xe2, 0xa7, 0x18, 0xfe, 0xfb, 0x49, 0x1d, 0x74, 0xe3, 0xe0, 0xf8, 0xc0, 0xf8, 0xe6, 0x60, 0xbf,
-	0x31, 0x87, 0x74, 0x28, 0xbd, 0x3c, 0x7c, 0x7d, 0xd0, 0xd0, 0x50, 0x05, 0x8a, 0xfb, 0x87, 0x46,
-	0xa3, 0x70, 0xf7, 0x0e, 0x54, 0x23, 0x70, 0xc2, 0xe8, 0x6f, 0x8e, 0xde, 0x1c, 0x08, 0xce, 0xaf,
-	0x8f, 0x8f, 0xde, 0x34, 0x34, 0xb6, 0x7a, 0x7d, 0xf8, 0xe6, 0xa0, 0x51, 0xd8, 0xfe, 0x6f, 0x1d,
-	0x8a, 0x2f, 0xde, 0x1e, 0xa2, 0xe7, 0x00, 0xf1, 0x0f, 0x06, 0x68, 0x55, 0x3c, 0x0d, 0xe9, 0x5f,
-	0x10, 0x5a, 0xab, 0x99, 0x9f, 0xb1, 0x0e, 0x86, 0x1e, 0xbd, 0xc0, 0x73, 0xe8, 0x21, 0xd4, 0x94,
-	0xb9, 0x1e, 0x5d, 0xe5, 0x0a, 0xb2, 0x93, 0x7e, 0x2b, 0x39, 0x65, 0xe3, 0x39, 0xf4, 0x08, 0xf4,
-	0x70, 0x3a, 0x47, 0x2b, 0x9c, 0x98, 0x1a, 0xf5, 0x5b, 0x1f, 0xa5, 0x76, 0x65, 0xdd, 0xce, 0x31,
-	0x9b, 0xe3, 0xc1, 0x5c, 0xda, 0x9c, 0x99, 0xd4, 0x2f, 0xb1, 0xf9, 0x0b, 0xa8, 0x29, 0xb3, 0xb7,
-	0xb4, 0x39, 0x3b, 0x8d, 0xb7, 0xd4, 0x87, 0x12, 0xcf, 0xa1, 0x5d, 0xa8, 0xab, 0xe3, 0x26, 0x6a,
-	0xca, 0xbe, 0x9e, 0x99, 0x40, 0x2f, 0x39, 0xfa, 0x19, 0x2c, 0x24, 0x86, 0x4a, 0xf4, 0xb1, 0x1a,
-	0xb0, 0xa4, 0x96, 0xf4, 0x50, 0x86, 0xe7, 0xd0, 0x97, 0x00, 0xf1, 0x54, 0x29, 0x3d, 0xcf, 0x8c,
-	0x99, 0xad, 0x46, 0x4a, 0x30, 0xc0, 0x73, 0x68, 0x47, 0xf4, 0x33, 0xb1, 0x79, 0x4c, 0x7d, 0x62,
-	0x0e, 0xc7, 0xca, 0x67, 0x0f, 0xde, 0xd2, 0x98, 0xf7, 0xea, 0xc8, 0x24, 0xbd, 0xcf, 0x99, 0xa2,
-	0x2e, 0xf1, 0xfe, 0x09, 0xd4, 0x94, 0xd1, 0x49, 0x06, 0x3e, 0x3b, 0x4c, 0xe5, 0x1b, 0xb0, 0x07,
-	0x4b, 0xa9, 0xa1, 0x08, 0xad, 0x89, 0xcc, 0xe5, 0x8e, 0x4a, 0xf9, 0x4a, 0xbe, 0x80, 0x9a, 0xf2,
-	0x9b, 0x86, 0xb4, 0x20, 0xfb, 0x2b, 0x47, 0x3a, 0xf5, 0x32, 0xee, 0x62, 0xf6, 0x54, 0xe2, 0x96,
-	0x18, 0x46, 0x65, 0xdc, 0x95, 0x7f, 0xf4, 0xc0, 0x73, 0xe8, 0x29, 0x54, 0xa3, 0x41, 0x18, 0x89,
-	0x8a, 0x4e, 0x0f, 0xc6, 0x97, 0x04, 0x2c, 0x0a, 0xba, 0x54, 0xa0, 0x06, 0x7d, 0x5a, 0x1d, 0x8f,
-	0xa1, 0x22, 0xf1, 0x32, 0xba, 0x92, 0x44, 0xcf, 0x13, 0x24, 0x6f, 0x6b, 0xe8, 0x31, 0xe8, 0x21,
-	0x66, 0x96, 0x97, 0x34, 0x05, 0xa1, 0x2f, 0x39, 0x77, 0x07, 0x2a, 0x72, 0xa4, 0x94, 0xe7, 0x26,
-	0x07, 0xcc, 0xd6, 0x5a, 0x46, 0x92, 0xc3, 0x8a, 0x6f, 0x58, 0xeb, 0xe3, 0xb9, 0x8a, 0x5b, 0x0b,
-	0x57, 0x92, 0x68, 0x2d, 0xaa, 0xa2, 0x24, 0x36, 0xc3, 0x73, 0x68, 0x5b, 0xb4, 0x16, 0xc5, 0xea,
-	0x14, 0xb0, 0x6e, 0x2d, 0x26, 0x44, 0x02, 0xde, 0x8e, 0x16, 0x43, 0x26, 0x79, 0x3b, 0xf2, 0x25,
-	0xd3, 0x87, 0x6d, 0x69, 0xec, 0xb8, 0x10, 0x72, 0x4b, 0xa1, 0x14, 0x02, 0xcf, 0x3f, 0x2e, 0x64,
-	0x4a, 0x1c, 0x97, 0x96, 0xcc, 0x39, 0xee, 0x11, 0xe8, 0x21, 0xba, 0x95, 0x42, 0x29, 0x94, 0x2d,
-	0x1b, 0x67, 0x1a, 0x02, 0xab, 0x8d, 0x93, 0x0b, 0xab, 0x8d, 0x73, 0xba, 0x94, 0x3e, 0xe3, 0xef,
-	0x0b, 0xa1, 0xe4, 0x85, 0x6d, 0xa3, 0x31, 0x6c, 0xe3, 0xc5, 0xb7, 0xff, 0x59, 0x86, 0xaa, 0x78,
-	0xe1, 0xd8, 0xcb, 0xf3, 0x00, 0xaa, 0x11, 0x0a, 0x96, 0x37, 0x23, 0x8d, 0x8a, 0x5b, 0xea, 0xab,
-	0xc8, 0x0b, 0xf2, 0x11, 0x1f, 0x1c, 0xc5, 0xc6, 0x31, 0x1f, 0x11, 0xc7, 0x48, 0xd6, 0x15, 0xc9,
-	0x40, 0x8a, 0x56, 0x23, 0xb0, 0x8c, 0x54, 0xc5, 0x93, 0x2b, 0xf1, 0x00, 0x20, 0xc6, 0xd9, 0x32,
-	0x6e, 0x19, 0xe0, 0x3d, 0x59, 0xcd, 0x53, 0x8e, 0x08, 0x12, 0x1e, 0xa7, 0x11, 0xf2, 0x25, 0xc1,
-	0xbf, 0x1f, 0x3d, 0x1d, 0x79, 0x3e, 0x2c, 0x25, 0xa0, 0x0d, 0xbf, 0x06, 0xbb, 0x50, 0x53, 0x50,
-	0x9a, 0xbc, 0x3f, 0x59, 0xc8, 0xd7, 0x6a, 0x66, 0x09, 0x51, 0xc5, 0x3c, 0x84, 0x9a, 0x82, 0xb6,
-	0xa5, 0x8e, 0x2c, 0xfe, 0x4e, 0x25, 0x6a, 0x4b, 0x43, 0x5f, 0xc1, 0x42, 0x02, 0xb5, 0xca, 0x87,
-	0x2e, 0x0f, 0x08, 0xb7, 0x5a, 0x79, 0xa4, 0xc8, 0x84, 0x07, 0x50, 0x7e, 0x45, 0x18, 0x10, 0x47,
-	0xd1, 0x28, 0x30, 0x39, 0xd4, 0x77, 0x00, 0x64, 0xb0, 0x92, 0x82, 0x39, 0x61, 0x7a, 0x22, 0xba,
-	0x05, 0xc3, 0x6a, 0xca, 0x9d, 0x57, 0x30, 0xb5, 0x02, 0x44, 0x12, 0x00, 0x9a, 0x9d, 0xb3, 0x13,
-	0xde, 0x28, 0x2e, 0xae, 0xde, 0x28, 0x55, 0xc1, 0xd5, 0xcc, 0x7e, 0xe4, 0xdd, 0x13, 0xa8, 0xec,
-	0xb9, 0x43, 0xcf, 0xec, 0xd2, 0xd9, 0x2f, 0xd4, 0x6e, 0xe3, 0xef, 0x1f, 0x6e, 0x68, 0xff, 0xfa,
-	0x70, 0x43, 0xfb, 0xe1, 0xc3, 0x0d, 0xed, 0x4f, 0xff, 0xb9, 0x31, 0xd7, 0x29, 0x73, 0x9e, 0x07,
-	0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x49, 0x5a, 0x9d, 0x83, 0x20, 0x00, 0x00,
+	// 3570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x59, 0x6f, 0x1b, 0xc9,
+	0xd1, 0x1a, 0x0e, 0xc5, 0xa3, 0x78, 0xba, 0x25, 0xcb, 0x5c, 0xca, 0x67, 0xaf, 0xed, 0xcf, 0xf6,
+	0xee, 0x27, 0x1b, 0xb2, 0xf7, 0xf3, 0xfa, 0x86, 0x75, 0xd8, 0xd6, 0xae, 0xaf, 0x1d, 0xe9, 0xdb,
+	0x0f, 0x5f, 0x80, 0x80, 0x18, 0x91, 0x4d, 0x6a, 0xd6, 0xc3, 0x19, 0x7a, 0x66, 0x68, 0xad, 0xf2,
+	0x07, 0x12, 0x20, 0x08, 0x10, 0x60, 0xb1, 0xc8, 0x06, 0x79, 0x48, 0xfe, 0x43, 0x90, 0xc7, 0x7d,
+	0xc8, 0x5b, 0x5e, 0x12, 0x04, 0x08, 0x90, 0xc7, 0x20, 0x70, 0xfe, 0x48, 0xd0, 0xd7, 0x4c, 0xcf,
+	0x41, 0x8a, 0x72, 0xbc, 0x0f, 0xb6, 0xa6, 0xab, 0xab, 0xab, 0xab, 0xab, 0xaa, 0xeb, 0x6a, 0xc2,
+	0x62, 0xd7, 0xb6, 0x88, 0x13, 0x5c, 0x1d, 0xf5, 0x7d, 0xfa, 0x6f, 0x65, 0xe4, 0xb9, 0x81, 0x8b,
+	0xf4, 0x51, 0xdf, 0x6f, 0x2f, 0x0f, 0x5c, 0x77, 0x60, 0x93, 0xab, 0x0c, 0xb4, 0x3b, 0xee, 0x5f,
+	0x25, 0xc3, 0x51, 0x70, 0xc0, 0x31, 0xda, 0x67, 0x92, 0x93, 0x81, 0x35, 0x24, 0x7e, 0x60, 0x0e,
+	0x47, 0x02, 0xe1, 0x74, 0x12, 0x61, 0xdf, 0x33, 0x47, 0x23, 0xe2, 0x89, 0x2d, 0xda, 0x8b, 0x03,
+	0x77, 0xe0, 0xb2, 0xcf, 0xab, 0xf4, 0x4b, 0x40, 0x97, 0x04, 0x3b, 0xe6, 0x38, 0xd8, 0x63, 0xff,
+	0x71, 0x38, 0x6e, 0x43, 0xde, 0x20, 0x23, 0x17, 0x21, 0xc8, 0x3b, 0xe6, 0x90, 0xb4, 0xb4, 0xb3,
+	0xda, 0xa5, 0xb2, 0xc1, 0xbe, 0xf1, 0x1d, 0x28, 0xac, 0x79, 0xa6, 0xd3, 0xdd, 0x43, 0xa7, 0x20,
+	0xef, 0x91, 0x91, 0xcb, 0x66, 0x2b, 0xab, 0xe5, 0x15, 0x7a, 0x20, 0xba, 0xcc, 0xc8, 0x7b, 0xea,
+	0xe2, 0x9c, 0xb2, 0xf8, 0x7b, 0x0d, 0x80, 0xaf, 0xde, 0x72, 0xfa, 0x99, 0xf4, 0xd1, 0x19, 0xc8,
+	0xef, 0x11, 0xb3, 0xc7, 0x96, 0x55, 0x56, 0x2b, 0x8c, 0xea, 0xba, 0x3b, 0x1c, 0x5a, 0x81, 0xc1,
+	0x26, 0xd0, 0x47, 0x00, 0x23, 0xcf, 0x7d, 0x43, 0x1c, 0xd3, 0xe9, 0x92, 0x96, 0x7e, 0x56, 0x0f,
+	0xd1, 0x38, 0x65, 0x43, 0x99, 0xa6, 0xc8, 0xfe, 0x78, 0x57, 0x22, 0xe7, 0x33, 0x90, 0xa3, 0x69,
+	0x74, 0x16, 0xe6, 0x5f, 0x8f, 0xdd, 0xc0, 0x6c, 0xcd, 0xb3, 0xbd, 0x81, 0xe1, 0x7d, 0x41, 0x21,
+	0x06, 0x9f, 0xc0, 0x0f, 0xa0, 0x12, 0xb1, 0xef, 0xa3, 0x6b, 0x50, 0xd9, 0x65, 0xc3, 0x8e, 0xe5,
+	0xf4, 0xa9, 0x20, 0x28, 0xf9, 0x86, 0x42, 0x9e, 0xa2, 0x19, 0xb0, 0x1b, 0x7e, 0xe3, 0x07, 0x90,
+	0x7f, 0x64, 0xd9, 0x04, 0x7d, 0x08, 0x85, 0x2e, 0x3b, 0x94, 0x90, 0x5e, 0xec, 0x9c, 0x62, 0x8a,
+	0x8a, 0x67, 0x64, 0x06, 0x7b, 0x52, 0x82, 0xf4, 0x1b, 0x2f, 0xc3, 0xfc, 0x9a, 0xed, 0x76, 0x5f,
+	0xd1, 0xc9, 0x3d, 0xd3, 0xdf, 0x93, 0xb2, 0xa3, 0xdf, 0xf8, 0x24, 0x14, 0x5e, 0xec, 0x7e, 0x45,
+	0xba, 0x41, 0xe6, 0xec, 0x07, 0xa0, 0xef, 0x98, 0x83, 0x4c, 0xa5, 0xfe, 0x5c, 0x87, 0x12, 0x55,
+	0x1d, 0xd3, 0xca, 0x21, 0x7a, 0xbd, 0x01, 0xc5, 0xae, 0x47, 0xcc, 0x80, 0x48, 0x1d, 0xb5, 0x57,
+	0xb8, 0xf1, 0xad, 0x48, 0xe3, 0x5b, 0xd9, 0x91, 0xd6, 0x69, 0x48, 0x54, 0x74, 0x0a, 0xc0, 0xb7,
+	0x7e, 0x42, 0x3a, 0xbb, 0x07, 0x01, 0xf1, 0x5b, 0xfa, 0x59, 0xed, 0x52, 0xde, 0x28, 0x53, 0xc8,
+	0x1a, 0x05, 0xa0, 0xcb, 0x31, 0xa5, 0x72, 0x3d, 0x29, 0x3b, 0xab, 0x2a, 0x3d, 0x0b, 0x95, 0x1e,
+	0xf1, 0xbb, 0x9e, 0x35, 0x0a, 0x2c, 0xd7, 0x61, 0xba, 0x2a, 0x1b, 0x2a, 0x08, 0xad, 0x40, 0x99,
+	0x1a, 0x33, 0x57, 0x4a, 0x81, 0xf1, 0x78, 0x2c, 0xa4, 0xf5, 0x70, 0x1c, 0x70, 0xb5, 0x94, 0x4c,
+	0xf1, 0x15, 0xe9, 0xbd, 0x38, 0x41, 0xef, 0x94, 0xfb, 0xbe, 0x65, 0x93, 0x4e, 0xd7, 0x1d, 0x3b,
+	0x41, 0xab, 0xc4, 0xb9, 0xa7, 0x90, 0x75, 0x0a, 0x40, 0x17, 0xa0, 0xce, 0xf0, 0x3a, 0xfb, 0xa6,
+	0xe7, 0x58, 0xce, 0xc0, 0x6f, 0x95, 0xcf, 0xea, 0x97, 0xca, 0x46, 0x8d, 0x41, 0xff, 0x4f, 0x00,
+	0xd1, 0x45, 0x68, 0x04, 0x1e, 0x21, 0x1d, 0x45, 0x10, 0xc0, 0x48, 0xd5, 0x28, 0x78, 0x5b, 0x0a,
+	0x03, 0x7f, 0xab, 0xc1, 0xfc, 0x17, 0x72, 0x5f, 0x05, 0x59, 0x4b, 0x4a, 0x2d, 0xce, 0x56, 0x2e,
+	0xc9, 0xd6, 0x45, 0x68, 0xf8, 0x6e, 0x3f, 0xe8, 0xa4, 0x04, 0x5f, 0xa3, 0xe0, 0x70, 0xbf, 0x10,
+	0x4f, 0xa1, 0x95, 0x8f, 0xf0, 0x1e, 0x49, 0x7a, 0xf8, 0x3e, 0x54, 0x55, 0x09, 0xa2, 0x15, 0xa8,
+	0x9a, 0xdd, 0x2e, 0xf1, 0xfd, 0x8e, 0x4d, 0xde, 0x10, 0x9b, 0xf1, 0x57, 0x5f, 0xad, 0xac, 0x30,
+	0x4f, 0xb2, 0xdd, 0x75, 0x47, 0xc4, 0xa8, 0x70, 0x84, 0xa7, 0x74, 0x1e, 0x3f, 0x80, 0x02, 0xb7,
+	0xf0, 0xc3, 0x4c, 0x6c, 0x09, 0x72, 0x16, 0xb7, 0xae, 0xf2, 0x5a, 0xe1, 0xed, 0x3f, 0xce, 0xe4,
+	0xb6, 0x36, 0x8c, 0x9c, 0xd5, 0xc3, 0xbf, 0xd4, 0x01, 0x38, 0x05, 0xb6, 0xff, 0x4c, 0x97, 0xe8,
+	0x1a, 0xd4, 0x46, 0xa6, 0x47, 0x9c, 0xa0, 0x23, 0x70, 0x33, 0x1c, 0x4b, 0x95, 0x63, 0x08, 0xe6,
+	0x6e, 0x40, 0xd1, 0x0f, 0x4c, 0x8f, 0x1a, 0xb8, 0x7e, 0xb8, 0x81, 0x0b, 0x54, 0xf4, 0x3f, 0x50,
+	0xea, 0x5b, 0x8e, 0xe5, 0xef, 0x91, 0x5e, 0x2b, 0x7f, 0xe8, 0xb2, 0x10, 0x37, 0xa1, 0xe2, 0xf9,
+	0xa4, 0x8a, 0xe3, 0xde, 0xae, 0xa0, 0x38, 0x30, 0xc1, 0xbb, 0x32, 0x4d, 0x7d, 0x27, 0xb5, 0x24,
+	0x61, 0xc7, 0x1c, 0x8d, 0x3b, 0x04, 0x83, 0x4d, 0x24, 0xef, 0x4e, 0x29, 0xeb, 0xee, 0x54, 0x87,
+	0xc4, 0x1b, 0x90, 0x0e, 0x17, 0x49, 0xab, 0x9c, 0x96, 0x56, 0x85, 0x21, 0xbc, 0x64, 0xf3, 0xf8,
+	0xef, 0x3a, 0x94, 0xa8, 0x85, 0x48, 0xcf, 0x41, 0x6d, 0x28, 0xa6, 0x56, 0x3a, 0x69, 0x30, 0x30,
+	0xba, 0x02, 0xcc, 0x38, 0x3b, 0xc1, 0xc1, 0x88, 0x87, 0x85, 0xfa, 0x6a, 0x2d, 0xc4, 0xd9, 0x39,
+	0x18, 0x11, 0x2a, 0x16, 0xfe, 0x75, 0x98, 0xbf, 0x68, 0x43, 0xa9, 0xbb, 0x67, 0xd9, 0x3d, 0x8f,
+	0x38, 0x4c, 0x28, 0x65, 0x23, 0x1c, 0x87, 0xbe, 0x8f, 0x4a, 0xa1, 0xca, 0x7d, 0x1f, 0xba, 0x00,
+	0x45, 0x97, 0x09, 0xc2, 0x6f, 0x95, 0xce, 0xea, 0x49, 0xe1, 0xc8, 0x39, 0x74, 0x13, 0x4a, 0x43,
+	0x12, 0x98, 0x3d, 0x33, 0x30, 0xd9, 0x15, 0xae, 0xac, 0x2e, 0x87, 0x0c, 0xd2, 0x13, 0xae, 0x3c,
+	0x13, 0xb3, 0x9b, 0x4e, 0xe0, 0x1d, 0x18, 0x21, 0x32, 0xba, 0x01, 0x8d, 0xa1, 0xdb, 0xb3, 0xfa,
+	0x16, 0xe9, 0x49, 0x3b, 0x83, 0xb4, 0xe4, 0xea, 0x12, 0x87, 0x8f, 0xa9, 0xcd, 0x48, 0x48, 0xab,
+	0x72, 0xb8, 0xcd, 0x48, 0x5c, 0xea, 0x6f, 0xfc, 0x83, 0xa1, 0x6d, 0x39, 0xaf, 0x3a, 0x81, 0xe9,
+	0x0d, 0x48, 0xd0, 0xaa, 0x32, 0x4d, 0xd6, 0x04, 0x74, 0x87, 0x01, 0xdb, 0x77, 0xa0, 0x16, 0xe3,
+	0x17, 0x35, 0x41, 0x7f, 0x45, 0x0e, 0x84, 0xe7, 0xa7, 0x9f, 0x68, 0x11, 0xe6, 0xdf, 0x98, 0xf6,
+	0x58, 0x46, 0x69, 0x3e, 0xb8, 0x9d, 0xfb, 0x54, 0xc3, 0x37, 0xa1, 0x4c, 0x45, 0x6d, 0x98, 0xce,
+	0x80, 0x50, 0x34, 0xdb, 0xdd, 0x27, 0x9e, 0x70, 0x41, 0x7c, 0x40, 0xa1, 0x63, 0x9a, 0x64, 0x08,
+	0xcf, 0xc3, 0x07, 0xd8, 0x80, 0x12, 0x8b, 0x50, 0x06, 0xe9, 0x53, 0xcf, 0xba, 0x4b, 0xbf, 0x5b,
+	0x9a, 0xe2, 0x59, 0xf9, 0x2c, 0x9f, 0x40, 0xe7, 0x61, 0xde, 0xa3, 0x5b, 0x88, 0x6b, 0x59, 0xe7,
+	0x18, 0x72, 0x63, 0x83, 0x4f, 0xe2, 0x1f, 0x03, 0x70, 0x55, 0xc9, 0x7b, 0xcf, 0x15, 0x16, 0xbb,
+	0xf7, 0x42, 0x97, 0x62, 0x8a, 0x1a, 0x1b, 0xdb, 0xa1, 0xe3, 0x91, 0xbe, 0x20, 0x5e, 0x53, 0xb6,
+	0x27, 0x7d, 0xa3, 0xb4, 0x2b, 0xbe, 0xf0, 0xaf, 0x34, 0x38, 0xb6, 0xce, 0x02, 0x15, 0x73, 0x42,
+	0xe4, 0xf5, 0x98, 0xf8, 0x87, 0x3a, 0xa9, 0x78, 0xc8, 0xca, 0x1d, 0x21, 0x64, 0xe9, 0xe9, 0x6b,
+	0xb7, 0x04, 0x85, 0xf1, 0xa8, 0x67, 0x06, 0x84, 0xf9, 0x8e, 0x92, 0x21, 0x46, 0xf8, 0x3a, 0xa0,
+	0x2d, 0xc7, 0x1f, 0xd1, 0x83, 0xcd, 0xcc, 0x19, 0xfe, 0x0a, 0x1a, 0xdb, 0x24, 0xe0, 0x01, 0x6c,
+	0xb6, 0xb3, 0x84, 0x11, 0x30, 0x37, 0x29, 0x02, 0x2e, 0x41, 0x81, 0xa7, 0x31, 0x82, 0x7b, 0x31,
+	0xc2, 0x77, 0xa1, 0xf1, 0xd4, 0xf2, 0x63, 0xdc, 0xc5, 0x05, 0xa3, 0x4d, 0x11, 0x0c, 0xbe, 0x0f,
+	0xcd, 0x68, 0xb5, 0x3f, 0x72, 0x1d, 0x9f, 0x79, 0x09, 0xca, 0x93, 0x9a, 0x52, 0xd5, 0xc2, 0xd5,
+	0x3c, 0x72, 0x7b, 0xe2, 0x0b, 0xff, 0x08, 0x8e, 0x6d, 0x10, 0x9b, 0x1c, 0x49, 0x6f, 0x8b, 0x30,
+	0xdf, 0x77, 0xbd, 0x2e, 0xb7, 0xb8, 0x92, 0xc1, 0x07, 0xf4, 0x6a, 0x98, 0xb6, 0xcd, 0x0e, 0x57,
+	0x32, 0xe8, 0x27, 0xfe, 0x9d, 0x06, 0x68, 0x9b, 0x3a, 0x77, 0x71, 0x79, 0x05, 0xf5, 0x0f, 0xa1,
+	0x20, 0x5c, 0x63, 0x56, 0xd0, 0xe1, 0x53, 0xe8, 0xa3, 0x0c, 0xdb, 0x98, 0xe8, 0xb5, 0x27, 0x88,
+	0x36, 0x69, 0x35, 0xf9, 0x94, 0xd5, 0xe0, 0xdf, 0x6a, 0x80, 0xd6, 0xc6, 0x96, 0xdd, 0xfb, 0xa1,
+	0x59, 0x94, 0x81, 0x45, 0x9f, 0x14, 0x58, 0xa2, 0x33, 0xe4, 0x63, 0xe6, 0xf1, 0x8d, 0x06, 0x0b,
+	0x8f, 0x58, 0xa8, 0x4b, 0xb1, 0x78, 0x78, 0xe8, 0x4e, 0x08, 0x20, 0x97, 0xbe, 0x36, 0x87, 0xf2,
+	0xb5, 0x08, 0xf3, 0xac, 0x8e, 0x12, 0xd7, 0x8a, 0x0f, 0xf0, 0x1d, 0x58, 0x14, 0xb7, 0xea, 0xe8,
+	0x5c, 0xe1, 0x9f, 0x69, 0x70, 0x8c, 0x1a, 0x6d, 0x7c, 0xe9, 0x21, 0x46, 0x77, 0x06, 0xf2, 0x7d,
+	0xcf, 0x1d, 0x66, 0x56, 0x35, 0x74, 0x02, 0x2d, 0x43, 0x2e, 0x70, 0x5b, 0x7a, 0x7a, 0x3a, 0x17,
+	0xd0, 0x7c, 0xa8, 0xe0, 0x8c, 0x87, 0xbb, 0xc4, 0x13, 0x79, 0x99, 0x18, 0xd1, 0x72, 0x24, 0x4a,
+	0x87, 0x58, 0x39, 0xc2, 0x79, 0x4c, 0x97, 0x23, 0x11, 0x9a, 0x01, 0xdd, 0xf0, 0x1b, 0xaf, 0xf2,
+	0xa3, 0x88, 0x5a, 0x68, 0x36, 0xef, 0xf2, 0x02, 0x9a, 0xdb, 0x24, 0xb1, 0x64, 0x26, 0x75, 0x46,
+	0x36, 0x92, 0x8b, 0xd9, 0xc8, 0xef, 0x35, 0x58, 0xe0, 0xde, 0x37, 0x45, 0x54, 0xe0, 0xab, 0x44,
+	0x05, 0x8e, 0x98, 0x7a, 0xcf, 0xe5, 0xe2, 0x65, 0x68, 0x76, 0x6d, 0x62, 0x7a, 0x9d, 0x58, 0x31,
+	0x42, 0x2d, 0xa7, 0xc1, 0xe0, 0x2f, 0x23, 0xd7, 0x15, 0xd9, 0xd0, 0xd1, 0xb9, 0xc6, 0x4f, 0x61,
+	0x81, 0xfb, 0xad, 0xa3, 0x48, 0x7e, 0xa2, 0x00, 0x6f, 0x4b, 0x6a, 0xef, 0x60, 0xcd, 0xb7, 0x61,
+	0x61, 0xfb, 0xf5, 0xd8, 0x7c, 0x97, 0xfb, 0x89, 0x0d, 0x58, 0x30, 0xc8, 0x1b, 0xe2, 0xbd, 0xc3,
+	0x2d, 0x9a, 0x78, 0x96, 0x6f, 0x34, 0x40, 0xcf, 0x68, 0x7e, 0x79, 0x24, 0xc9, 0x9c, 0x81, 0x0a,
+	0xbd, 0x45, 0x9d, 0x18, 0x49, 0xa0, 0x20, 0x4e, 0x06, 0x2d, 0x43, 0x39, 0x70, 0x3b, 0x31, 0x37,
+	0x5b, 0x0a, 0xdc, 0xb5, 0x59, 0x1d, 0x2d, 0xcb, 0xa4, 0xbc, 0x01, 0x59, 0x77, 0x9d, 0xbe, 0x6d,
+	0x75, 0xa3, 0xd2, 0x5c, 0x8b, 0x4a, 0x73, 0x7a, 0x24, 0x8f, 0x98, 0x7e, 0xe8, 0xa9, 0xc4, 0x08,
+	0xdb, 0xb0, 0x10, 0x3b, 0x91, 0x88, 0x73, 0x33, 0x56, 0x2f, 0xe5, 0xae, 0xd8, 0xd3, 0x17, 0x4e,
+	0x1a, 0x31, 0xbc, 0x18, 0x3b, 0x46, 0x84, 0x84, 0x4d, 0x40, 0x8f, 0xec, 0x71, 0x52, 0x9f, 0x17,
+	0xa0, 0xc8, 0x29, 0xfa, 0xc2, 0x2d, 0xc4, 0x76, 0x93, 0x73, 0xe8, 0x3c, 0x94, 0x02, 0xb7, 0x43,
+	0x45, 0xea, 0xa7, 0x33, 0x9a, 0x62, 0xe0, 0xd2, 0xbf, 0x3e, 0xfe, 0x4e, 0x83, 0xa5, 0xed, 0xf1,
+	0x2e, 0x15, 0xcf, 0x2e, 0x39, 0x92, 0x1b, 0x9c, 0xa0, 0xf5, 0xd0, 0x3d, 0xea, 0x93, 0xdc, 0xe3,
+	0x45, 0x98, 0xf7, 0x03, 0x99, 0x1e, 0xd5, 0x57, 0x9b, 0x0a, 0xc6, 0x36, 0x85, 0x1b, 0x7c, 0x1a,
+	0xbf, 0x86, 0xfa, 0x63, 0xc2, 0x4a, 0x56, 0x85, 0xa3, 0x69, 0x35, 0xc9, 0x39, 0xa8, 0xba, 0xfd,
+	0xbe, 0x4f, 0x02, 0x51, 0x69, 0x50, 0xbe, 0x74, 0xa3, 0xc2, 0x61, 0x61, 0x95, 0x9d, 0x28, 0x45,
+	0x74, 0xa5, 0x14, 0xc1, 0x17, 0xa1, 0xfe, 0xe2, 0x0d, 0xf1, 0xf6, 0x3d, 0x2b, 0x20, 0x5b, 0x4e,
+	0x8f, 0x7c, 0x4d, 0x83, 0x8e, 0x45, 0x3f, 0xd8, 0x9e, 0xba, 0xc1, 0x07, 0xf8, 0x7b, 0x1d, 0xea,
+	0x2f, 0xc7, 0x47, 0xe1, 0x2d, 0x4c, 0xce, 0x75, 0x56, 0xc9, 0xf0, 0x01, 0xcd, 0x54, 0xc6, 0x9e,
+	0x2d, 0xfa, 0x1e, 0xf4, 0x13, 0x9d, 0xa4, 0x19, 0x53, 0x77, 0xec, 0xf9, 0xd6, 0x1b, 0xc2, 0xfa,
+	0x1d, 0x25, 0x23, 0x02, 0xa0, 0x8f, 0xa1, 0xdc, 0x23, 0xb6, 0x35, 0xb4, 0x02, 0xe2, 0xb1, 0x9a,
+	0xa8, 0x2e, 0xb2, 0xec, 0x0d, 0x09, 0x35, 0x22, 0x04, 0xf4, 0x31, 0x20, 0x5e, 0x52, 0xf0, 0x6e,
+	0x40, 0xcf, 0x0c, 0xc6, 0x43, 0x9f, 0x15, 0x8a, 0xba, 0xd1, 0xe4, 0x33, 0x94, 0xc3, 0x0d, 0x06,
+	0x47, 0x57, 0xe0, 0x98, 0x8a, 0xcd, 0x25, 0x54, 0x66, 0xc8, 0x8d, 0x08, 0x99, 0x8b, 0xf1, 0x2e,
+	0x34, 0x5c, 0x29, 0xa7, 0x0e, 0x97, 0x0f, 0x2f, 0x91, 0x16, 0x78, 0xd8, 0x8e, 0xc9, 0xd0, 0xa8,
+	0xbb, 0x71, 0x99, 0xde, 0x53, 0x2a, 0xb3, 0x0a, 0xb3, 0xcc, 0x73, 0x6c, 0x59, 0x5c, 0xa2, 0x93,
+	0xea, 0xb3, 0xff, 0xa8, 0x14, 0xfa, 0x2c, 0x5f, 0xca, 0x35, 0x75, 0xfc, 0x0b, 0x0d, 0x6a, 0xe1,
+	0x6e, 0x5d, 0xd7, 0xeb, 0x65, 0x74, 0x67, 0x54, 0xc3, 0xa0, 0x4e, 0x89, 0xd7, 0x22, 0x1d, 0x56,
+	0x8e, 0x0a, 0xa7, 0xc4, 0x41, 0x4f, 0x68, 0x51, 0x9a, 0x21, 0x11, 0x7d, 0x66, 0x89, 0xe0, 0x6f,
+	0x73, 0x50, 0x8f, 0xf1, 0xe3, 0xd3, 0x23, 0xf8, 0x23, 0x5b, 0x78, 0x94, 0x92, 0xc1, 0x07, 0xe8,
+	0x63, 0x28, 0x7a, 0x1c, 0x21, 0xe6, 0x41, 0x62, 0x6b, 0x0d, 0x89, 0x42, 0x8d, 0x29, 0x70, 0x87,
+	0xbb, 0x7e, 0xe0, 0x3a, 0x44, 0xa4, 0xc3, 0x11, 0x20, 0xa6, 0x86, 0x7c, 0x96, 0x1a, 0x18, 0x91,
+	0x89, 0x65, 0x72, 0xba, 0x70, 0x9d, 0x7f, 0xef, 0x85, 0xeb, 0x33, 0x58, 0xe4, 0xd9, 0xc4, 0x36,
+	0xa7, 0x39, 0xe3, 0x65, 0x5b, 0x82, 0x82, 0x60, 0x49, 0xb8, 0x26, 0x3e, 0xc2, 0x16, 0x34, 0xd6,
+	0xdd, 0xd1, 0x81, 0x7a, 0x6d, 0x97, 0x41, 0xf7, 0xbd, 0x6e, 0x9a, 0x10, 0x85, 0xd2, 0xc9, 0x9e,
+	0x2f, 0xbb, 0x4c, 0xea, 0x64, 0xcf, 0x0f, 0xa8, 0x70, 0x43, 0x2d, 0x4a, 0xe1, 0x86, 0x00, 0xfc,
+	0x39, 0x34, 0x9e, 0xb9, 0x6f, 0xc8, 0x7b, 0xd9, 0x4a, 0xa9, 0x1c, 0x67, 0xf7, 0x38, 0x78, 0x83,
+	0x57, 0x73, 0xb3, 0xaf, 0xa0, 0x81, 0xb0, 0x3f, 0xb6, 0x6d, 0x51, 0x4c, 0xb1, 0x6f, 0xfc, 0x67,
+	0x0d, 0x1a, 0x8f, 0x6d, 0x77, 0x57, 0x25, 0x33, 0x53, 0xb4, 0x6b, 0x41, 0x71, 0x64, 0x06, 0x01,
+	0xf1, 0x64, 0x08, 0x95, 0x43, 0x74, 0x5f, 0xb1, 0x3b, 0x9e, 0xc3, 0x61, 0x46, 0x20, 0xb1, 0xcd,
+	0x0f, 0x72, 0xff, 0x69, 0x2b, 0x44, 0x36, 0x80, 0xfc, 0xb0, 0x89, 0x95, 0x2a, 0x4f, 0x25, 0x0a,
+	0x6f, 0x62, 0xd1, 0x2f, 0xbc, 0x0f, 0x8d, 0x0d, 0xab, 0xdf, 0x57, 0xe5, 0x70, 0x1e, 0x4a, 0x0e,
+	0xd9, 0xef, 0x64, 0x8b, 0xb4, 0xe8, 0x90, 0x7d, 0xfa, 0x41, 0xb1, 0x5c, 0xbb, 0xc7, 0xb1, 0x52,
+	0xea, 0x2d, 0xba, 0x76, 0x8f, 0x61, 0xb5, 0xa0, 0xe8, 0xef, 0x99, 0xb6, 0xed, 0xee, 0x0b, 0x5b,
+	0x92, 0x43, 0xfc, 0x15, 0x34, 0xa3, 0x8d, 0xa3, 0xba, 0x5a, 0xee, 0xec, 0x4f, 0x60, 0x5c, 0x6c,
+	0xcf, 0x0e, 0x29, 0xf7, 0x97, 0x4e, 0x23, 0x89, 0x2b, 0x98, 0xf0, 0xf1, 0x0b, 0x58, 0x92, 0x36,
+	0xf3, 0xc4, 0xf2, 0x03, 0xd7, 0x3b, 0x98, 0xfd, 0xc6, 0x89, 0xaa, 0x26, 0x17, 0xab, 0x6a, 0xfe,
+	0xa0, 0x41, 0x45, 0xa1, 0x36, 0x9b, 0xe9, 0xa8, 0xed, 0xd7, 0xdc, 0x11, 0xda, 0xaf, 0x31, 0x75,
+	0xea, 0x4a, 0x9b, 0x28, 0xad, 0xce, 0xd0, 0xdf, 0xf7, 0x88, 0xcd, 0xdc, 0x5f, 0xe8, 0xef, 0x37,
+	0x28, 0x80, 0x26, 0x89, 0x11, 0xdb, 0x16, 0x93, 0x62, 0x71, 0x8f, 0x9f, 0x41, 0xc8, 0xbb, 0x19,
+	0x52, 0x96, 0x92, 0x92, 0x08, 0xb4, 0x12, 0xe3, 0x39, 0xfc, 0x11, 0x6e, 0xeb, 0x05, 0xa8, 0xec,
+	0x78, 0xa6, 0xe3, 0x9b, 0x5d, 0xd1, 0x43, 0xa2, 0x5d, 0x73, 0x2d, 0xd5, 0x35, 0xff, 0xa3, 0x0e,
+	0x48, 0xc1, 0x93, 0xc4, 0x6f, 0x42, 0x85, 0x3f, 0xce, 0x74, 0x94, 0x8c, 0x6d, 0x89, 0xcb, 0x36,
+	0xd9, 0x0b, 0x33, 0xa0, 0x1b, 0x82, 0xd0, 0x6d, 0xa8, 0xb2, 0xa6, 0x77, 0xbc, 0xa1, 0x7e, 0x82,
+	0xad, 0x4c, 0x37, 0x4c, 0x8c, 0x8a, 0x1f, 0xc1, 0x14, 0x5d, 0xea, 0x93, 0x75, 0x79, 0x0f, 0x6a,
+	0x5c, 0x3f, 0x72, 0x07, 0xde, 0x4f, 0x6f, 0x89, 0xf3, 0xa7, 0xba, 0x09, 0x46, 0xb5, 0xaf, 0x00,
+	0xd1, 0x0d, 0x00, 0x96, 0xcf, 0xf1, 0x44, 0x93, 0xbf, 0xe5, 0x1d, 0xe7, 0xdc, 0x25, 0xea, 0x56,
+	0xa3, 0xec, 0x4b, 0x08, 0x15, 0x47, 0x8f, 0x29, 0x80, 0xdf, 0xba, 0x82, 0x22, 0x8e, 0x94, 0x62,
+	0x0c, 0xe8, 0x85, 0x20, 0x7a, 0x57, 0x47, 0x63, 0x9e, 0x00, 0xb5, 0x8a, 0x49, 0x45, 0x15, 0x47,
+	0x3c, 0x30, 0xa2, 0x7b, 0xd0, 0x94, 0x58, 0x1d, 0x19, 0x8d, 0x4b, 0x4a, 0xb0, 0x8f, 0x07, 0x50,
+	0xa3, 0x3e, 0x8a, 0x8d, 0xf1, 0xdf, 0x34, 0x68, 0x28, 0x3a, 0x64, 0xe6, 0xb8, 0x0a, 0x95, 0x20,
+	0x02, 0x09, 0x05, 0x72, 0x13, 0x53, 0xd5, 0xad, 0x22, 0xa1, 0xeb, 0x50, 0xf2, 0xf8, 0x19, 0xe4,
+	0xbd, 0x3e, 0x91, 0x5a, 0x20, 0xce, 0x18, 0x22, 0xbe, 0xe3, 0x83, 0xc8, 0x39, 0xa8, 0x3a, 0xe3,
+	0x61, 0x27, 0xdc, 0x8e, 0xdf, 0x97, 0x8a, 0x33, 0x1e, 0x8a, 0x1d, 0x7c, 0xfc, 0x01, 0x9c, 0x60,
+	0x06, 0x93, 0xde, 0x1d, 0x3f, 0x87, 0x16, 0xd7, 0x74, 0x7a, 0xee, 0x5d, 0x0e, 0x4e, 0xe9, 0x71,
+	0x35, 0xbe, 0x27, 0x7a, 0x8f, 0xa0, 0xf9, 0x72, 0x1c, 0x88, 0x5e, 0x93, 0xa0, 0x13, 0x46, 0x10,
+	0x4d, 0xcd, 0xd7, 0x4f, 0x42, 0x3e, 0x30, 0x07, 0x52, 0xdc, 0x25, 0x4e, 0xd6, 0x1c, 0x18, 0x0c,
+	0x8a, 0x7f, 0xa3, 0xc1, 0xb1, 0xc7, 0x44, 0x10, 0xf2, 0x95, 0x72, 0x4d, 0x3e, 0x57, 0x68, 0x53,
+	0x9e, 0x2b, 0xb2, 0x8a, 0x97, 0xfc, 0x61, 0xc5, 0x4b, 0xf2, 0x05, 0x31, 0x70, 0x03, 0xd3, 0x66,
+	0x6f, 0x84, 0xa2, 0xbb, 0x54, 0x66, 0x10, 0xfa, 0x3c, 0x88, 0xff, 0x17, 0x9a, 0x3b, 0xe6, 0x20,
+	0x7e, 0xca, 0x99, 0xba, 0xef, 0xd3, 0x0f, 0xbd, 0x08, 0x88, 0x86, 0x8c, 0xf8, 0xa1, 0xf1, 0x0b,
+	0x9e, 0x7c, 0xec, 0x98, 0x83, 0x50, 0x0e, 0x4b, 0x50, 0x18, 0x79, 0xa4, 0x6f, 0x7d, 0x2d, 0x02,
+	0xb5, 0x18, 0xa1, 0xf3, 0x50, 0xb3, 0x9c, 0xae, 0x3d, 0xee, 0x11, 0x4e, 0x43, 0xa4, 0x1f, 0x71,
+	0x20, 0xde, 0x82, 0x66, 0x44, 0x50, 0x44, 0xc1, 0x26, 0xe8, 0x81, 0x39, 0x90, 0x71, 0x3f, 0x30,
+	0x07, 0xca, 0x79, 0x72, 0x13, 0xcf, 0x83, 0xef, 0xc1, 0x22, 0x37, 0x9f, 0x77, 0x52, 0x14, 0x3e,
+	0x01, 0xc7, 0x13, 0xcb, 0x39, 0x3b, 0xf8, 0xbf, 0xa4, 0xdb, 0x57, 0x4f, 0x8d, 0x84, 0xf0, 0x34,
+	0xf6, 0xb0, 0x15, 0x8a, 0x4c, 0x45, 0x14, 0xcb, 0x6f, 0x01, 0x5a, 0xdf, 0x23, 0xdd, 0x57, 0x47,
+	0xd7, 0x10, 0xfe, 0x6f, 0x58, 0x88, 0x2d, 0x15, 0xf2, 0x59, 0x82, 0x02, 0xf9, 0xda, 0xf2, 0x03,
+	0x5f, 0xd4, 0x10, 0x62, 0x84, 0xaf, 0x41, 0x51, 0xf0, 0x3e, 0xeb, 0x99, 0x7f, 0x9a, 0x83, 0x8a,
+	0x7c, 0xb4, 0xa1, 0x15, 0xdc, 0xcd, 0xe4, 0xb2, 0x53, 0xca, 0x32, 0x86, 0x22, 0xbe, 0x7d, 0x9e,
+	0xbc, 0x85, 0x56, 0xbe, 0x12, 0xb3, 0xa5, 0x76, 0x6a, 0x15, 0x95, 0x08, 0x5f, 0xc2, 0xf0, 0xda,
+	0x5b, 0x50, 0x55, 0x09, 0x65, 0xa4, 0x7a, 0x1f, 0xaa, 0xa9, 0x5e, 0xea, 0x5d, 0x28, 0xca, 0xfc,
+	0xda, 0x1b, 0x50, 0x0e, 0xa9, 0x67, 0xd0, 0x39, 0x17, 0xa7, 0x13, 0x93, 0x43, 0x44, 0xe5, 0xca,
+	0xa7, 0xfc, 0x89, 0x94, 0xbd, 0x6b, 0x56, 0xa1, 0x64, 0x6c, 0x6e, 0x6f, 0x1a, 0x5f, 0x6e, 0x6e,
+	0x34, 0xe7, 0x50, 0x09, 0xf2, 0x8f, 0xb6, 0x9e, 0x6e, 0x36, 0x35, 0x54, 0x04, 0x7d, 0x63, 0xcb,
+	0x68, 0xe6, 0x50, 0x05, 0x8a, 0xdb, 0xff, 0xff, 0xec, 0xe9, 0xd6, 0xf3, 0xcf, 0x9b, 0xfa, 0x95,
+	0x4b, 0x50, 0x51, 0x9a, 0x1c, 0x74, 0xf1, 0xa3, 0xad, 0xe7, 0x5b, 0xdb, 0x4f, 0xd8, 0x62, 0x8a,
+	0xb9, 0xf3, 0xd0, 0xd8, 0xd9, 0xdc, 0x68, 0x6a, 0x57, 0x2e, 0x43, 0x39, 0xac, 0xe7, 0x29, 0xd9,
+	0xe7, 0x2f, 0x9e, 0x6f, 0xf2, 0x0d, 0x3e, 0xdb, 0x7e, 0xf1, 0xbc, 0xa9, 0xd1, 0xaf, 0xa7, 0x5b,
+	0xcf, 0x37, 0x9b, 0xb9, 0xd5, 0x5f, 0x2f, 0x80, 0xfe, 0xf0, 0xe5, 0x16, 0xba, 0x0f, 0x10, 0x05,
+	0x7a, 0x34, 0x21, 0xf2, 0xb7, 0x97, 0x52, 0xbe, 0x7e, 0x93, 0xf5, 0xd0, 0xe7, 0x68, 0xc4, 0x54,
+	0xde, 0xa6, 0x10, 0x0f, 0x24, 0xe9, 0xd7, 0xaa, 0x76, 0xfc, 0xf5, 0x06, 0xcf, 0xa1, 0x5b, 0x50,
+	0x92, 0xaf, 0x3e, 0x68, 0x91, 0x4d, 0x26, 0x9e, 0x90, 0xda, 0xc7, 0x13, 0x50, 0x61, 0xee, 0x73,
+	0x94, 0xe7, 0xe8, 0xc1, 0x07, 0xa9, 0xe1, 0x79, 0x36, 0x9e, 0x6f, 0x43, 0x49, 0x3e, 0x8d, 0x89,
+	0xad, 0x13, 0x2f, 0x65, 0x53, 0xd6, 0x7e, 0x02, 0x15, 0x25, 0xbd, 0x41, 0x93, 0x12, 0x9e, 0xb6,
+	0x9a, 0xd3, 0xe0, 0x39, 0xb4, 0x06, 0x55, 0x35, 0x67, 0x41, 0x13, 0xd3, 0x98, 0x29, 0x5b, 0xdf,
+	0x83, 0x5a, 0xec, 0xc1, 0x02, 0x7d, 0xa0, 0x0a, 0x3b, 0x4e, 0x25, 0xd9, 0xf0, 0xc7, 0x73, 0xe8,
+	0x53, 0x80, 0xe8, 0xc5, 0x42, 0x48, 0x2d, 0xf5, 0x84, 0xd1, 0x6e, 0x26, 0x16, 0xfa, 0x78, 0x0e,
+	0x3d, 0xe0, 0x2e, 0x54, 0x1a, 0xa1, 0x47, 0xcc, 0xe1, 0xc4, 0xf5, 0xe9, 0x8d, 0xaf, 0x69, 0xf4,
+	0xf4, 0x6a, 0x6f, 0x5a, 0x9c, 0x3e, 0xa3, 0x5d, 0x3d, 0xe5, 0xf4, 0x6b, 0x50, 0x55, 0x7b, 0xd4,
+	0x82, 0x46, 0x46, 0xdb, 0x7a, 0xaa, 0xb1, 0x56, 0xd5, 0x5e, 0xb5, 0xa0, 0x91, 0xd1, 0xbe, 0x4e,
+	0xab, 0xaf, 0xa2, 0x74, 0x6f, 0x85, 0xd6, 0xd3, 0x1d, 0xea, 0x76, 0x2b, 0x3d, 0x11, 0x5a, 0xed,
+	0x1d, 0xa8, 0x28, 0x3d, 0x59, 0x41, 0x23, 0xdd, 0xa5, 0xcd, 0x96, 0xe0, 0x3a, 0x34, 0x12, 0xcd,
+	0x56, 0xc4, 0x7f, 0x94, 0x90, 0xdd, 0x82, 0xcd, 0x26, 0xf2, 0x09, 0x54, 0x94, 0x87, 0x42, 0xc1,
+	0x41, 0xfa, 0xe9, 0x30, 0x79, 0x78, 0x61, 0x38, 0xe2, 0xec, 0x91, 0xe2, 0xe3, 0x47, 0x6f, 0x26,
+	0x7e, 0xf1, 0x46, 0x0d, 0xe7, 0x2e, 0x94, 0xc3, 0x6c, 0x1b, 0x65, 0x67, 0xdf, 0xd3, 0x35, 0xae,
+	0xbe, 0x08, 0x09, 0x6d, 0x65, 0x3c, 0x12, 0xcd, 0x74, 0x67, 0x04, 0x91, 0xd8, 0x9d, 0x89, 0x53,
+	0x49, 0xfe, 0x66, 0x8f, 0xb3, 0xa0, 0x3e, 0xd1, 0xc4, 0x0c, 0x77, 0x56, 0x16, 0x6e, 0x43, 0x51,
+	0xe4, 0xf5, 0x68, 0x21, 0xa3, 0x5b, 0x39, 0x79, 0xe5, 0x25, 0x8d, 0x7a, 0x2a, 0xd9, 0x77, 0x12,
+	0x9e, 0x2a, 0xd1, 0x86, 0x9a, 0xb2, 0xef, 0x06, 0xd4, 0x62, 0x2d, 0x30, 0x71, 0xf4, 0xac, 0xb6,
+	0xd8, 0x74, 0x5f, 0x29, 0xdb, 0x51, 0x82, 0x83, 0x44, 0x77, 0x6a, 0xca, 0xda, 0x07, 0x50, 0x7c,
+	0x4c, 0xd4, 0x93, 0xc7, 0xbb, 0xf2, 0xed, 0xe5, 0xd4, 0x4a, 0x96, 0x8f, 0x7e, 0x49, 0x63, 0x26,
+	0x33, 0xd8, 0x28, 0xb8, 0x30, 0x22, 0xb1, 0xe0, 0xa2, 0x12, 0x8a, 0x17, 0xeb, 0x78, 0x0e, 0xad,
+	0xf2, 0xe0, 0xa2, 0x70, 0x9d, 0xe8, 0x68, 0xb5, 0xeb, 0xb1, 0x25, 0x3e, 0x0b, 0x48, 0x75, 0x89,
+	0x24, 0x7c, 0x5c, 0xf6, 0xca, 0xe4, 0x66, 0xd7, 0x34, 0xba, 0x9d, 0xec, 0x41, 0x89, 0x45, 0x89,
+	0x96, 0x54, 0xf6, 0x76, 0x12, 0x29, 0xb6, 0x5d, 0x72, 0x65, 0xc6, 0x76, 0xb7, 0xa0, 0x24, 0x1b,
+	0x3b, 0x62, 0x51, 0xa2, 0xc1, 0xd4, 0x3e, 0x9e, 0x80, 0x86, 0x4e, 0x48, 0xe9, 0xed, 0xc9, 0xce,
+	0xca, 0x72, 0xec, 0x94, 0xf1, 0xee, 0x4d, 0x1b, 0x25, 0x9a, 0x15, 0x16, 0xf1, 0xd5, 0x00, 0xcc,
+	0x58, 0x98, 0x50, 0x1f, 0x4f, 0xbd, 0x95, 0x65, 0x8e, 0xfe, 0xd0, 0xb6, 0xd1, 0x04, 0xb4, 0xa9,
+	0x96, 0xdd, 0x4c, 0x56, 0x8c, 0xe8, 0x64, 0x14, 0x88, 0xd3, 0xc5, 0x5d, 0x3b, 0x55, 0xc7, 0xe1,
+	0x39, 0xf4, 0x19, 0x1c, 0x4b, 0x15, 0x97, 0xe8, 0x94, 0x12, 0x97, 0x33, 0xe8, 0x2c, 0x26, 0xe9,
+	0x08, 0x7b, 0x7b, 0x1a, 0x66, 0xf0, 0x29, 0x5a, 0x93, 0x0a, 0xce, 0xc9, 0xe7, 0x5b, 0xfd, 0x4b,
+	0x01, 0xca, 0x3c, 0x81, 0xa4, 0x19, 0xda, 0x75, 0x28, 0x87, 0x45, 0xa6, 0x70, 0xa2, 0xc9, 0xa2,
+	0xb3, 0xad, 0x26, 0x9d, 0xcc, 0x71, 0xdc, 0x62, 0xcf, 0x02, 0x1c, 0xb0, 0xcd, 0x1e, 0x00, 0x26,
+	0xac, 0xac, 0x2a, 0x2b, 0x7d, 0xb1, 0xb4, 0x1c, 0xd6, 0xa2, 0x48, 0x25, 0x7c, 0xf8, 0x7d, 0xdd,
+	0x04, 0x08, 0x97, 0xfa, 0xc2, 0x2e, 0x52, 0x75, 0xed, 0xe1, 0x64, 0xee, 0xb2, 0x84, 0x3b, 0x76,
+	0xe2, 0x64, 0x01, 0x3a, 0xc5, 0x3a, 0xae, 0x86, 0x2e, 0x3f, 0xeb, 0x0c, 0x8d, 0x58, 0xe5, 0x20,
+	0x9c, 0x7c, 0x45, 0x29, 0x82, 0x84, 0x97, 0x49, 0x57, 0x54, 0xed, 0x56, 0x7a, 0x22, 0xbc, 0x57,
+	0x37, 0xa1, 0xa2, 0x14, 0xb3, 0x82, 0x46, 0xba, 0xbc, 0x4d, 0x28, 0xea, 0x9a, 0x86, 0x9e, 0x40,
+	0x2d, 0x56, 0x14, 0x0a, 0x2f, 0x9d, 0x55, 0x67, 0xb6, 0xdb, 0x59, 0x53, 0x21, 0x0b, 0xd7, 0xa1,
+	0xf0, 0x98, 0xd0, 0x3a, 0x17, 0x85, 0x95, 0xf6, 0xe1, 0xa2, 0xbe, 0x0c, 0x20, 0x84, 0x15, 0x5f,
+	0x98, 0x21, 0xa6, 0x3b, 0xdc, 0xa7, 0xd2, 0x52, 0x48, 0xf1, 0x8c, 0x4a, 0xc9, 0xda, 0x3e, 0x9e,
+	0x80, 0x4a, 0xd6, 0xae, 0x69, 0xe8, 0x81, 0xf4, 0x18, 0x6c, 0xb9, 0xea, 0x31, 0x54, 0x02, 0x27,
+	0x52, 0x70, 0x25, 0x7b, 0x2a, 0xae, 0xbb, 0xc3, 0x91, 0xd9, 0x0d, 0x8e, 0xee, 0x30, 0xd6, 0x9a,
+	0x7f, 0x7a, 0x7b, 0x5a, 0xfb, 0xeb, 0xdb, 0xd3, 0xda, 0x3f, 0xdf, 0x9e, 0xd6, 0xbe, 0xfb, 0xd7,
+	0xe9, 0xb9, 0xdd, 0x02, 0xc3, 0xb9, 0xfe, 0xef, 0x01, 0x00, 0xc3, 0x9d, 0x65, 0x29, 0x8c, 0x31,
+	0x00, 0x00,
 }
@@ -1,19 +1,39 @@
 package grpcutil
 
 import (
-	"errors"
-
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-// ScrubGRPC removes GRPC error code information from 'err' if it came from
-// GRPC (and returns it unchanged otherwise)
+// ScrubGRPC removes GRPC error code information from the message of 'err' if
+// it came from GRPC (and returns it unchanged otherwise). The code itself can
+// still be read with Code.
 func ScrubGRPC(err error) error {
 	if err == nil {
 		return nil
 	}
 	if s, ok := status.FromError(err); ok {
-		return errors.New(s.Message())
+		return &scrubbedError{code: s.Code(), message: s.Message()}
 	}
 	return err
 }
+
+// scrubbedError is a GRPC error whose message has been scrubbed by ScrubGRPC
+type scrubbedError struct {
+	code    codes.Code
+	message string
+}
+
+func (e *scrubbedError) Error() string {
+	return e.message
+}
+
+// Code returns the GRPC status code of 'err', which may have been scrubbed by
+// ScrubGRPC. It returns codes.Unknown if 'err' didn't come from GRPC.
+func Code(err error) codes.Code {
+	if e, ok := err.(*scrubbedError); ok {
+		return e.code
+	}
+	return grpc.Code(err)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
@@ -23,6 +24,11 @@ var (
 type ServeOptions struct {
 	Version    *versionpb.Version
 	MaxMsgSize int
+	// UnaryInterceptors and StreamInterceptors are installed on the server,
+	// e.g. to collect metrics about every RPC. They're called in order, so the
+	// first interceptor wraps all the others.
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
 }
 
 // ServeEnv are environment variables for serving.
@@ -43,7 +49,7 @@ func Serve(
 	if serveEnv.GRPCPort == 0 {
 		serveEnv.GRPCPort = 7070
 	}
-	grpcServer := grpc.NewServer(
+	serverOptions := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(math.MaxUint32),
 		grpc.MaxRecvMsgSize(options.MaxMsgSize),
 		grpc.MaxSendMsgSize(options.MaxMsgSize),
@@ -51,7 +57,14 @@ func Serve(
 			MinTime:             5 * time.Second,
 			PermitWithoutStream: true,
 		}),
-	)
+	}
+	if len(options.UnaryInterceptors) > 0 {
+		serverOptions = append(serverOptions, grpc.UnaryInterceptor(chainUnaryInterceptors(options.UnaryInterceptors)))
+	}
+	if len(options.StreamInterceptors) > 0 {
+		serverOptions = append(serverOptions, grpc.StreamInterceptor(chainStreamInterceptors(options.StreamInterceptors)))
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
 	registerFunc(grpcServer)
 	if options.Version != nil {
 		versionpb.RegisterAPIServer(grpcServer, version.NewAPIServer(options.Version, version.APIServerOptions{}))
@@ -62,3 +75,31 @@ func Serve(
 	}
 	return grpcServer.Serve(listener)
 }
+
+// chainUnaryInterceptors combines interceptors into one, since a grpc.Server
+// only accepts a single unary interceptor.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// chainStreamInterceptors combines interceptors into one, since a grpc.Server
+// only accepts a single stream interceptor.
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	opentracing "github.com/opentracing/opentracing-go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// The interceptors below look up the global tracer on each call, so that they
+// can be created before InstallJaegerTracerFromEnv runs, and do nothing while
+// tracing is disabled.
+
+// onlyWithParent is passed to the client interceptors, so that RPCs only start
+// new traces on the server side. A client span is only recorded if the RPC is
+// part of an existing trace.
+func onlyWithParent(parent opentracing.SpanContext, method string, req, resp interface{}) bool {
+	return parent != nil
+}
+
+// UnaryServerInterceptor records a span for each unary RPC, as a child of the
+// caller's span if it's in the RPC's metadata, and makes it available to the
+// handler via opentracing.SpanFromContext.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !enabled() {
+			return handler(ctx, req)
+		}
+		return otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())(ctx, req, info, handler)
+	}
+}
+
+// StreamServerInterceptor records a span for each streaming RPC, and makes it
+// available to the handler via the stream's context.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !enabled() {
+			return handler(srv, ss)
+		}
+		return otgrpc.OpenTracingStreamServerInterceptor(opentracing.GlobalTracer())(srv, ss, info, handler)
+	}
+}
+
+// UnaryClientInterceptor propagates the span carried by the context of each
+// unary RPC (if any) to the server in the RPC's metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !enabled() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return otgrpc.OpenTracingClientInterceptor(opentracing.GlobalTracer(),
+			otgrpc.IncludingSpans(onlyWithParent))(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+// StreamClientInterceptor propagates the span carried by the context of each
+// streaming RPC (if any) to the server in the RPC's metadata.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !enabled() {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		return otgrpc.OpenTracingStreamClientInterceptor(opentracing.GlobalTracer(),
+			otgrpc.IncludingSpans(onlyWithParent))(ctx, desc, cc, method, streamer, opts...)
+	}
+}
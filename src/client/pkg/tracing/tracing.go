@@ -0,0 +1,107 @@
+// Package tracing records spans describing the work done to serve a request,
+// using the OpenTracing API. Spans are propagated between Pachyderm processes
+// in gRPC metadata by the interceptors in this package, and exported to a
+// Jaeger collector.
+//
+// Tracing is disabled unless a tracer is installed, e.g. by
+// InstallJaegerTracerFromEnv. While it's disabled, StartSpanFromContext
+// returns a no-op span.
+package tracing
+
+import (
+	"io"
+	"os"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	log "github.com/sirupsen/logrus"
+	jaeger "github.com/uber/jaeger-client-go"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
+	"golang.org/x/net/context"
+)
+
+const (
+	// JaegerEndpointEnvVar is the environment variable that holds the URL of
+	// the Jaeger collector that spans are sent to, e.g.
+	// "http://jaeger-collector:14268/api/traces". If it's unset, tracing is
+	// disabled.
+	JaegerEndpointEnvVar = "JAEGER_ENDPOINT"
+	// SamplerTypeEnvVar and SamplerParamEnvVar configure the sampling of new
+	// traces, as described in the Jaeger client's documentation. By default,
+	// traces are sampled with probability defaultSamplingRate. Spans that
+	// continue a trace started elsewhere follow that trace's sampling
+	// decision.
+	SamplerTypeEnvVar  = "JAEGER_SAMPLER_TYPE"
+	SamplerParamEnvVar = "JAEGER_SAMPLER_PARAM"
+
+	defaultSamplingRate = 0.01
+)
+
+// EnvVars are the environment variables that configure tracing. pachd passes
+// them on to the pods it creates, so that their spans join pachd's traces.
+var EnvVars = []string{JaegerEndpointEnvVar, SamplerTypeEnvVar, SamplerParamEnvVar}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// InstallJaegerTracerFromEnv installs a Jaeger tracer that sends spans,
+// labelled with serviceName, to the collector named by JaegerEndpointEnvVar.
+// It does nothing if the variable is unset. Closing the result flushes any
+// buffered spans and stops the tracer's reporter.
+func InstallJaegerTracerFromEnv(serviceName string) io.Closer {
+	if os.Getenv(JaegerEndpointEnvVar) == "" {
+		return nopCloser{}
+	}
+	cfg, err := jaegercfg.FromEnv()
+	if err != nil {
+		log.Errorf("error reading tracing configuration, tracing is disabled: %v", err)
+		return nopCloser{}
+	}
+	cfg.ServiceName = serviceName
+	if os.Getenv(SamplerTypeEnvVar) == "" {
+		cfg.Sampler.Type = jaeger.SamplerTypeProbabilistic
+		if os.Getenv(SamplerParamEnvVar) == "" {
+			cfg.Sampler.Param = defaultSamplingRate
+		}
+	}
+	tracer, closer, err := cfg.NewTracer()
+	if err != nil {
+		log.Errorf("error creating tracer, tracing is disabled: %v", err)
+		return nopCloser{}
+	}
+	opentracing.SetGlobalTracer(tracer)
+	return closer
+}
+
+// enabled returns true if a tracer has been installed.
+func enabled() bool {
+	return opentracing.IsGlobalTracerRegistered()
+}
+
+// StartSpanFromContext starts a span named operation, as a child of the span
+// carried by ctx if there is one, and tags it with kvs (alternating keys and
+// values). It returns the span and a copy of ctx that carries it. If tracing
+// is disabled, it returns a no-op span and ctx.
+//
+// Callers should always call Finish on the span, typically with defer.
+func StartSpanFromContext(ctx context.Context, operation string, kvs ...string) (opentracing.Span, context.Context) {
+	if !enabled() {
+		return opentracing.NoopTracer{}.StartSpan(operation), ctx
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, operation)
+	for i := 0; i+1 < len(kvs); i += 2 {
+		span.SetTag(kvs[i], kvs[i+1])
+	}
+	return span, ctx
+}
+
+// SetError marks span as failed if err is non-nil.
+func SetError(span opentracing.Span, err error) {
+	if err == nil {
+		return
+	}
+	ext.Error.Set(span, true)
+	span.LogFields(otlog.Error(err))
+}
@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"errors"
+	"testing"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	jaeger "github.com/uber/jaeger-client-go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestDisabled must run first, as a tracer can't be uninstalled
+func TestDisabled(t *testing.T) {
+	ctx := context.Background()
+	span, ctx2 := StartSpanFromContext(ctx, "op", "key", "value")
+	require.Equal(t, ctx, ctx2)
+	require.Nil(t, opentracing.SpanFromContext(ctx2))
+	// These should all be no-ops
+	SetError(span, errors.New("error"))
+	span.Finish()
+}
+
+// installTestTracer installs a tracer that samples new traces if 'sample' is
+// true, and returns the reporter that receives its sampled spans.
+func installTestTracer(sample bool) *jaeger.InMemoryReporter {
+	reporter := jaeger.NewInMemoryReporter()
+	tracer, _ := jaeger.NewTracer("test", jaeger.NewConstSampler(sample), reporter)
+	opentracing.SetGlobalTracer(tracer)
+	return reporter
+}
+
+// call sends an RPC from ctx through the client and server interceptors, and
+// runs 'handler' on the server side.
+func call(t *testing.T, ctx context.Context, handler grpc.UnaryHandler) {
+	server := UnaryServerInterceptor()
+	require.NoError(t, UnaryClientInterceptor()(ctx, "/pfs.API/PutFile", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			_, err := server(metadata.NewIncomingContext(context.Background(), md), req,
+				&grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return err
+		}))
+}
+
+func handler(ctx context.Context, req interface{}) (interface{}, error) {
+	span, _ := StartSpanFromContext(ctx, "handler")
+	span.Finish()
+	return nil, nil
+}
+
+func spanContext(span opentracing.Span) jaeger.SpanContext {
+	return span.Context().(jaeger.SpanContext)
+}
+
+func TestPropagation(t *testing.T) {
+	reporter := installTestTracer(true)
+	clientSpan, ctx := StartSpanFromContext(context.Background(), "client", "key", "value")
+	call(t, ctx, handler)
+	clientSpan.Finish()
+
+	// Spans are reported as they finish, so each span is the child of the
+	// next one: the handler's span, the RPC's server and client spans, and
+	// the client's span.
+	spans := reporter.GetSpans()
+	require.Equal(t, 4, len(spans))
+	require.Equal(t, "handler", spans[0].(*jaeger.Span).OperationName())
+	require.Equal(t, "/pfs.API/PutFile", spans[1].(*jaeger.Span).OperationName())
+	require.Equal(t, "/pfs.API/PutFile", spans[2].(*jaeger.Span).OperationName())
+	require.Equal(t, "client", spans[3].(*jaeger.Span).OperationName())
+	for i := 0; i < 3; i++ {
+		require.Equal(t, spanContext(spans[3]).TraceID(), spanContext(spans[i]).TraceID())
+		require.Equal(t, spanContext(spans[i+1]).SpanID(), spanContext(spans[i]).ParentID())
+	}
+	require.Equal(t, jaeger.SpanID(0), spanContext(spans[3]).ParentID())
+}
+
+func TestSamplingDecision(t *testing.T) {
+	// The server follows the caller's sampling decision rather than its own
+	for _, sampled := range []bool{true, false} {
+		reporter := installTestTracer(!sampled)
+		callerTracer, _ := jaeger.NewTracer("caller", jaeger.NewConstSampler(sampled), jaeger.NewNullReporter())
+		callerSpan := callerTracer.StartSpan("caller")
+		call(t, opentracing.ContextWithSpan(context.Background(), callerSpan), handler)
+		callerSpan.Finish()
+		if sampled {
+			require.Equal(t, 3, len(reporter.GetSpans()))
+		} else {
+			require.Equal(t, 0, len(reporter.GetSpans()))
+		}
+	}
+
+	// Without a caller, the server's sampler decides
+	reporter := installTestTracer(false)
+	call(t, context.Background(), handler)
+	require.Equal(t, 0, len(reporter.GetSpans()))
+}
@@ -115,6 +115,52 @@ func NewUnionInput(input ...*pps.Input) *pps.Input {
 	}
 }
 
+// NewJoinInput returns an input which is the join of other inputs. Datums
+// from the inputs are only seen together by the job / pipeline if their
+// JoinOn expressions match. The inputs should be created with
+// NewJoinAtomInput.
+func NewJoinInput(input ...*pps.Input) *pps.Input {
+	return &pps.Input{
+		Join: input,
+	}
+}
+
+// NewJoinAtomInput returns a new atom input for use in a join input. joinOn
+// is an expression, such as "$1", over the capture groups of glob, such as
+// "/(*).png".
+func NewJoinAtomInput(repo string, glob string, joinOn string, outerJoin bool) *pps.Input {
+	return &pps.Input{
+		Atom: &pps.AtomInput{
+			Repo:      repo,
+			Glob:      glob,
+			JoinOn:    joinOn,
+			OuterJoin: outerJoin,
+		},
+	}
+}
+
+// NewGroupInput returns an input which groups the files of other inputs by
+// key. Each group of files is seen by the job / pipeline as one datum. The
+// inputs should be created with NewGroupAtomInput.
+func NewGroupInput(input ...*pps.Input) *pps.Input {
+	return &pps.Input{
+		Group: input,
+	}
+}
+
+// NewGroupAtomInput returns a new atom input for use in a group input.
+// groupBy is an expression, such as "$1", over the capture groups of glob,
+// such as "/(*)/*".
+func NewGroupAtomInput(repo string, glob string, groupBy string) *pps.Input {
+	return &pps.Input{
+		Atom: &pps.AtomInput{
+			Repo:    repo,
+			Glob:    glob,
+			GroupBy: groupBy,
+		},
+	}
+}
+
 // NewCronInput returns an input which will trigger based on a timed schedule.
 // It uses cron syntax to specify the schedule. The input will be exposed to
 // jobs as `/pfs/<name>/time` which will contain a timestamp.
@@ -285,14 +331,41 @@ func (c APIClient) RestartDatum(jobID string, datumFilter []string) error {
 
 // ListDatum returns info about all datums in a Job
 func (c APIClient) ListDatum(jobID string, pageSize int64, page int64) (*pps.ListDatumResponse, error) {
-	client, err := c.PpsAPIClient.ListDatumStream(
-		c.Ctx(),
-		&pps.ListDatumRequest{
-			Job:      &pps.Job{jobID},
-			PageSize: pageSize,
-			Page:     page,
-		},
-	)
+	return c.listDatum(&pps.ListDatumRequest{
+		Job:      &pps.Job{jobID},
+		PageSize: pageSize,
+		Page:     page,
+	})
+}
+
+// ListDatumInput returns the datums that a pipeline with the given input
+// would process, evaluated against the current heads of the input's branches.
+// No job is run, which makes it useful for checking globs before creating a
+// pipeline. Pagination works as in ListDatum. The datums' IDs are left empty,
+// as a datum's ID depends on the pipeline that processes it.
+func (c APIClient) ListDatumInput(input *pps.Input, pageSize int64, page int64) (*pps.ListDatumResponse, error) {
+	return c.listDatum(&pps.ListDatumRequest{
+		Input:    input,
+		PageSize: pageSize,
+		Page:     page,
+	})
+}
+
+// ListDatumInputForPipeline is like ListDatumInput, but lists the datums that
+// the existing pipeline 'pipelineName' would process with the given input.
+// Unlike those returned by ListDatumInput, the datums have the IDs that the
+// pipeline's workers would give them.
+func (c APIClient) ListDatumInputForPipeline(pipelineName string, input *pps.Input, pageSize int64, page int64) (*pps.ListDatumResponse, error) {
+	return c.listDatum(&pps.ListDatumRequest{
+		Pipeline: NewPipeline(pipelineName),
+		Input:    input,
+		PageSize: pageSize,
+		Page:     page,
+	})
+}
+
+func (c APIClient) listDatum(request *pps.ListDatumRequest) (*pps.ListDatumResponse, error) {
+	client, err := c.PpsAPIClient.ListDatumStream(c.Ctx(), request)
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
 	}
@@ -463,6 +536,64 @@ func (c APIClient) InspectPipeline(pipelineName string) (*pps.PipelineInfo, erro
 	return pipelineInfo, grpcutil.ScrubGRPC(err)
 }
 
+// InspectPipelineVersion returns info about a specific version of a
+// pipeline's spec.
+func (c APIClient) InspectPipelineVersion(pipelineName string, version uint64) (*pps.PipelineInfo, error) {
+	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
+		c.Ctx(),
+		&pps.InspectPipelineRequest{
+			Pipeline: NewPipeline(pipelineName),
+			Version:  version,
+		},
+	)
+	return pipelineInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListPipelineVersions returns every version of a pipeline's spec, newest
+// first.
+func (c APIClient) ListPipelineVersions(pipelineName string) ([]*pps.PipelineInfo, error) {
+	pipelineInfos, err := c.PpsAPIClient.ListPipelineVersions(
+		c.Ctx(),
+		&pps.ListPipelineVersionsRequest{
+			Pipeline: NewPipeline(pipelineName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return pipelineInfos.PipelineInfo, nil
+}
+
+// RollbackPipeline re-applies a previous version of a pipeline's spec. The
+// rollback creates a new version of the pipeline. If reprocess is true, the
+// pipeline reprocesses all of its datums.
+func (c APIClient) RollbackPipeline(pipelineName string, version uint64, reprocess bool) error {
+	_, err := c.PpsAPIClient.RollbackPipeline(
+		c.Ctx(),
+		&pps.RollbackPipelineRequest{
+			Pipeline:  NewPipeline(pipelineName),
+			Version:   version,
+			Reprocess: reprocess,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectDAG returns the graph of repos and pipelines, sorted so that each
+// repo comes after the repos it's derived from. If repoName is set, only
+// that repo and its ancestors and descendants are returned.
+func (c APIClient) InspectDAG(repoName string) ([]*pps.DAGNode, error) {
+	request := &pps.InspectDAGRequest{}
+	if repoName != "" {
+		request.Repo = NewRepo(repoName)
+	}
+	dagInfo, err := c.PpsAPIClient.InspectDAG(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return dagInfo.Nodes, nil
+}
+
 // ListPipeline returns info about all pipelines.
 func (c APIClient) ListPipeline() ([]*pps.PipelineInfo, error) {
 	pipelineInfos, err := c.PpsAPIClient.ListPipeline(
@@ -13,6 +13,8 @@
 		Egress
 		Job
 		Service
+		Spout
+		PipelineTemplate
 		AtomInput
 		CronInput
 		GitInput
@@ -49,7 +51,12 @@
 		ChunkSpec
 		CreatePipelineRequest
 		InspectPipelineRequest
+		ListPipelineVersionsRequest
+		RollbackPipelineRequest
 		ListPipelineRequest
+		InspectDAGRequest
+		DAGNode
+		DAGInfo
 		DeletePipelineRequest
 		StartPipelineRequest
 		StopPipelineRequest
@@ -259,6 +266,10 @@ type Transform struct {
 	Stdin            []string          `protobuf:"bytes,5,rep,name=stdin" json:"stdin,omitempty"`
 	AcceptReturnCode []int64           `protobuf:"varint,6,rep,packed,name=accept_return_code,json=acceptReturnCode" json:"accept_return_code,omitempty"`
 	Debug            bool              `protobuf:"varint,7,opt,name=debug,proto3" json:"debug,omitempty"`
+	// err_cmd is run when cmd exits with a code that isn't accepted. If it
+	// exits successfully, the datum is considered recovered.
+	ErrCmd   []string `protobuf:"bytes,10,rep,name=err_cmd,json=errCmd" json:"err_cmd,omitempty"`
+	ErrStdin []string `protobuf:"bytes,11,rep,name=err_stdin,json=errStdin" json:"err_stdin,omitempty"`
 }
 
 func (m *Transform) Reset()                    { *m = Transform{} }
@@ -322,6 +333,20 @@ func (m *Transform) GetDebug() bool {
 	return false
 }
 
+func (m *Transform) GetErrCmd() []string {
+	if m != nil {
+		return m.ErrCmd
+	}
+	return nil
+}
+
+func (m *Transform) GetErrStdin() []string {
+	if m != nil {
+		return m.ErrStdin
+	}
+	return nil
+}
+
 type Egress struct {
 	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
 }
@@ -378,6 +403,53 @@ func (m *Service) GetExternalPort() int32 {
 	return 0
 }
 
+// Spout pipelines have no input, their user code runs continuously and
+// writes tar streams to /pfs/out, each of which becomes a commit in the
+// output repo.
+type Spout struct {
+	// overwrite, if true, replaces files that already exist in the output
+	// branch instead of appending to them.
+	Overwrite bool `protobuf:"varint,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+}
+
+func (m *Spout) Reset()                    { *m = Spout{} }
+func (m *Spout) String() string            { return proto.CompactTextString(m) }
+func (*Spout) ProtoMessage()               {}
+func (*Spout) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{5} }
+
+func (m *Spout) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+// PipelineTemplate records the template that a pipeline spec was rendered
+// from by pachctl, and the arguments it was rendered with.
+type PipelineTemplate struct {
+	Template string            `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Args     map[string]string `protobuf:"bytes,2,rep,name=args" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *PipelineTemplate) Reset()                    { *m = PipelineTemplate{} }
+func (m *PipelineTemplate) String() string            { return proto.CompactTextString(m) }
+func (*PipelineTemplate) ProtoMessage()               {}
+func (*PipelineTemplate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{6} }
+
+func (m *PipelineTemplate) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *PipelineTemplate) GetArgs() map[string]string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
 type AtomInput struct {
 	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Repo       string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -390,12 +462,24 @@ type AtomInput struct {
 	// empty files. This is useful in shuffle pipelines where you want to read
 	// the names of files and reorganize them using symlinks.
 	EmptyFiles bool `protobuf:"varint,8,opt,name=empty_files,json=emptyFiles,proto3" json:"empty_files,omitempty"`
+	// JoinOn is only used by the children of join inputs. It's an expression,
+	// such as "$1", over the capture groups of glob, such as "/(*).png". Files
+	// from different children of a join are put in the same datum if their
+	// JoinOn expressions evaluate to the same value.
+	JoinOn string `protobuf:"bytes,9,opt,name=join_on,json=joinOn,proto3" json:"join_on,omitempty"`
+	// OuterJoin, if true, will cause files from this atom that match no files
+	// in one of the other children of a join to still be put in a datum.
+	OuterJoin bool `protobuf:"varint,10,opt,name=outer_join,json=outerJoin,proto3" json:"outer_join,omitempty"`
+	// GroupBy is only used by the children of group inputs. Like JoinOn, it's
+	// an expression over the capture groups of glob. All of the files whose
+	// GroupBy expressions evaluate to the same value are put in one datum.
+	GroupBy string `protobuf:"bytes,11,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
 }
 
 func (m *AtomInput) Reset()                    { *m = AtomInput{} }
 func (m *AtomInput) String() string            { return proto.CompactTextString(m) }
 func (*AtomInput) ProtoMessage()               {}
-func (*AtomInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{5} }
+func (*AtomInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{7} }
 
 func (m *AtomInput) GetName() string {
 	if m != nil {
@@ -453,6 +537,27 @@ func (m *AtomInput) GetEmptyFiles() bool {
 	return false
 }
 
+func (m *AtomInput) GetJoinOn() string {
+	if m != nil {
+		return m.JoinOn
+	}
+	return ""
+}
+
+func (m *AtomInput) GetOuterJoin() bool {
+	if m != nil {
+		return m.OuterJoin
+	}
+	return false
+}
+
+func (m *AtomInput) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
 type CronInput struct {
 	Name   string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Repo   string                      `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -464,7 +569,7 @@ type CronInput struct {
 func (m *CronInput) Reset()                    { *m = CronInput{} }
 func (m *CronInput) String() string            { return proto.CompactTextString(m) }
 func (*CronInput) ProtoMessage()               {}
-func (*CronInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{6} }
+func (*CronInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{8} }
 
 func (m *CronInput) GetName() string {
 	if m != nil {
@@ -511,7 +616,7 @@ type GitInput struct {
 func (m *GitInput) Reset()                    { *m = GitInput{} }
 func (m *GitInput) String() string            { return proto.CompactTextString(m) }
 func (*GitInput) ProtoMessage()               {}
-func (*GitInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{7} }
+func (*GitInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{9} }
 
 func (m *GitInput) GetName() string {
 	if m != nil {
@@ -547,12 +652,14 @@ type Input struct {
 	Union []*Input   `protobuf:"bytes,3,rep,name=union" json:"union,omitempty"`
 	Cron  *CronInput `protobuf:"bytes,4,opt,name=cron" json:"cron,omitempty"`
 	Git   *GitInput  `protobuf:"bytes,5,opt,name=git" json:"git,omitempty"`
+	Join  []*Input   `protobuf:"bytes,6,rep,name=join" json:"join,omitempty"`
+	Group []*Input   `protobuf:"bytes,7,rep,name=group" json:"group,omitempty"`
 }
 
 func (m *Input) Reset()                    { *m = Input{} }
 func (m *Input) String() string            { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()               {}
-func (*Input) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{8} }
+func (*Input) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{10} }
 
 func (m *Input) GetAtom() *AtomInput {
 	if m != nil {
@@ -589,6 +696,20 @@ func (m *Input) GetGit() *GitInput {
 	return nil
 }
 
+func (m *Input) GetJoin() []*Input {
+	if m != nil {
+		return m.Join
+	}
+	return nil
+}
+
+func (m *Input) GetGroup() []*Input {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
 type JobInput struct {
 	Name   string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Commit *pfs.Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
@@ -599,7 +720,7 @@ type JobInput struct {
 func (m *JobInput) Reset()                    { *m = JobInput{} }
 func (m *JobInput) String() string            { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()               {}
-func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{9} }
+func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
 
 func (m *JobInput) GetName() string {
 	if m != nil {
@@ -649,7 +770,7 @@ type ParallelismSpec struct {
 func (m *ParallelismSpec) Reset()                    { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string            { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()               {}
-func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{10} }
+func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
 
 func (m *ParallelismSpec) GetConstant() uint64 {
 	if m != nil {
@@ -675,7 +796,7 @@ type InputFile struct {
 func (m *InputFile) Reset()                    { *m = InputFile{} }
 func (m *InputFile) String() string            { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()               {}
-func (*InputFile) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
+func (*InputFile) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
 
 func (m *InputFile) GetPath() string {
 	if m != nil {
@@ -700,7 +821,7 @@ type Datum struct {
 func (m *Datum) Reset()                    { *m = Datum{} }
 func (m *Datum) String() string            { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()               {}
-func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
+func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
 
 func (m *Datum) GetID() string {
 	if m != nil {
@@ -727,7 +848,7 @@ type DatumInfo struct {
 func (m *DatumInfo) Reset()                    { *m = DatumInfo{} }
 func (m *DatumInfo) String() string            { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()               {}
-func (*DatumInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
+func (*DatumInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
 
 func (m *DatumInfo) GetDatum() *Datum {
 	if m != nil {
@@ -775,7 +896,7 @@ type Aggregate struct {
 func (m *Aggregate) Reset()                    { *m = Aggregate{} }
 func (m *Aggregate) String() string            { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()               {}
-func (*Aggregate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
+func (*Aggregate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
 
 func (m *Aggregate) GetCount() int64 {
 	if m != nil {
@@ -823,7 +944,7 @@ type ProcessStats struct {
 func (m *ProcessStats) Reset()                    { *m = ProcessStats{} }
 func (m *ProcessStats) String() string            { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()               {}
-func (*ProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
+func (*ProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
 
 func (m *ProcessStats) GetDownloadTime() *google_protobuf2.Duration {
 	if m != nil {
@@ -871,7 +992,7 @@ type AggregateProcessStats struct {
 func (m *AggregateProcessStats) Reset()                    { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string            { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()               {}
-func (*AggregateProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
+func (*AggregateProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
 
 func (m *AggregateProcessStats) GetDownloadTime() *Aggregate {
 	if m != nil {
@@ -921,7 +1042,7 @@ type WorkerStatus struct {
 func (m *WorkerStatus) Reset()                    { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string            { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()               {}
-func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
+func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
 
 func (m *WorkerStatus) GetWorkerID() string {
 	if m != nil {
@@ -981,7 +1102,7 @@ type ResourceSpec struct {
 func (m *ResourceSpec) Reset()                    { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string            { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()               {}
-func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
+func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
 
 func (m *ResourceSpec) GetCpu() float32 {
 	if m != nil {
@@ -1039,12 +1160,14 @@ type JobInfo struct {
 	ChunkSpec        *ChunkSpec                  `protobuf:"bytes,37,opt,name=chunk_spec,json=chunkSpec" json:"chunk_spec,omitempty"`
 	DatumTimeout     *google_protobuf2.Duration  `protobuf:"bytes,38,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
 	JobTimeout       *google_protobuf2.Duration  `protobuf:"bytes,39,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
+	DatumTries       int64                       `protobuf:"varint,41,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SkipFailedDatums bool                        `protobuf:"varint,42,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
 }
 
 func (m *JobInfo) Reset()                    { *m = JobInfo{} }
 func (m *JobInfo) String() string            { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()               {}
-func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
+func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
 
 func (m *JobInfo) GetJob() *Job {
 	if m != nil {
@@ -1284,6 +1407,20 @@ func (m *JobInfo) GetJobTimeout() *google_protobuf2.Duration {
 	return nil
 }
 
+func (m *JobInfo) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *JobInfo) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
 type Worker struct {
 	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
@@ -1292,7 +1429,7 @@ type Worker struct {
 func (m *Worker) Reset()                    { *m = Worker{} }
 func (m *Worker) String() string            { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()               {}
-func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
+func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
 
 func (m *Worker) GetName() string {
 	if m != nil {
@@ -1315,7 +1452,7 @@ type JobInfos struct {
 func (m *JobInfos) Reset()                    { *m = JobInfos{} }
 func (m *JobInfos) String() string            { return proto.CompactTextString(m) }
 func (*JobInfos) ProtoMessage()               {}
-func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
+func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
 
 func (m *JobInfos) GetJobInfo() []*JobInfo {
 	if m != nil {
@@ -1331,7 +1468,7 @@ type Pipeline struct {
 func (m *Pipeline) Reset()                    { *m = Pipeline{} }
 func (m *Pipeline) String() string            { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()               {}
-func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
+func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
 
 func (m *Pipeline) GetName() string {
 	if m != nil {
@@ -1352,7 +1489,7 @@ type PipelineInput struct {
 func (m *PipelineInput) Reset()                    { *m = PipelineInput{} }
 func (m *PipelineInput) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInput) ProtoMessage()               {}
-func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
+func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
 
 func (m *PipelineInput) GetName() string {
 	if m != nil {
@@ -1426,12 +1563,16 @@ type PipelineInfo struct {
 	DatumTimeout       *google_protobuf2.Duration  `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
 	JobTimeout         *google_protobuf2.Duration  `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
 	GithookURL         string                      `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
+	DatumTries         int64                       `protobuf:"varint,36,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SkipFailedDatums   bool                        `protobuf:"varint,37,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	Spout              *Spout                      `protobuf:"bytes,38,opt,name=spout" json:"spout,omitempty"`
+	Template           *PipelineTemplate           `protobuf:"bytes,39,opt,name=template" json:"template,omitempty"`
 }
 
 func (m *PipelineInfo) Reset()                    { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()               {}
-func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
+func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
 
 func (m *PipelineInfo) GetID() string {
 	if m != nil {
@@ -1636,6 +1777,34 @@ func (m *PipelineInfo) GetGithookURL() string {
 	return ""
 }
 
+func (m *PipelineInfo) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
+func (m *PipelineInfo) GetSpout() *Spout {
+	if m != nil {
+		return m.Spout
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetTemplate() *PipelineTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
 type PipelineInfos struct {
 	PipelineInfo []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo" json:"pipeline_info,omitempty"`
 }
@@ -1643,7 +1812,7 @@ type PipelineInfos struct {
 func (m *PipelineInfos) Reset()                    { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()               {}
-func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
+func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
 
 func (m *PipelineInfos) GetPipelineInfo() []*PipelineInfo {
 	if m != nil {
@@ -1675,12 +1844,16 @@ type CreateJobRequest struct {
 	ChunkSpec        *ChunkSpec                 `protobuf:"bytes,22,opt,name=chunk_spec,json=chunkSpec" json:"chunk_spec,omitempty"`
 	DatumTimeout     *google_protobuf2.Duration `protobuf:"bytes,23,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
 	JobTimeout       *google_protobuf2.Duration `protobuf:"bytes,24,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
+	DatumTries       int64                      `protobuf:"varint,25,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SkipFailedDatums bool                       `protobuf:"varint,26,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	// output_commit is the commit that the job's output is written to
+	OutputCommit *pfs.Commit `protobuf:"bytes,27,opt,name=output_commit,json=outputCommit" json:"output_commit,omitempty"`
 }
 
 func (m *CreateJobRequest) Reset()                    { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()               {}
-func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
+func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
 
 func (m *CreateJobRequest) GetTransform() *Transform {
 	if m != nil {
@@ -1822,6 +1995,27 @@ func (m *CreateJobRequest) GetJobTimeout() *google_protobuf2.Duration {
 	return nil
 }
 
+func (m *CreateJobRequest) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *CreateJobRequest) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
+func (m *CreateJobRequest) GetOutputCommit() *pfs.Commit {
+	if m != nil {
+		return m.OutputCommit
+	}
+	return nil
+}
+
 type InspectJobRequest struct {
 	Job        *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
 	BlockState bool `protobuf:"varint,2,opt,name=block_state,json=blockState,proto3" json:"block_state,omitempty"`
@@ -1830,7 +2024,7 @@ type InspectJobRequest struct {
 func (m *InspectJobRequest) Reset()                    { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()               {}
-func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
+func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
 
 func (m *InspectJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1855,7 +2049,7 @@ type ListJobRequest struct {
 func (m *ListJobRequest) Reset()                    { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()               {}
-func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
+func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
 
 func (m *ListJobRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1885,7 +2079,7 @@ type DeleteJobRequest struct {
 func (m *DeleteJobRequest) Reset()                    { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()               {}
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
 
 func (m *DeleteJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1901,7 +2095,7 @@ type StopJobRequest struct {
 func (m *StopJobRequest) Reset()                    { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()               {}
-func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
+func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
 
 func (m *StopJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1936,7 +2130,7 @@ type GetLogsRequest struct {
 func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()               {}
-func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
 
 func (m *GetLogsRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2009,7 +2203,7 @@ type LogMessage struct {
 func (m *LogMessage) Reset()                    { *m = LogMessage{} }
 func (m *LogMessage) String() string            { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()               {}
-func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
+func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
 
 func (m *LogMessage) GetPipelineName() string {
 	if m != nil {
@@ -2082,7 +2276,7 @@ type RestartDatumRequest struct {
 func (m *RestartDatumRequest) Reset()                    { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()               {}
-func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
+func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
 
 func (m *RestartDatumRequest) GetJob() *Job {
 	if m != nil {
@@ -2105,7 +2299,7 @@ type InspectDatumRequest struct {
 func (m *InspectDatumRequest) Reset()                    { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()               {}
-func (*InspectDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
+func (*InspectDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{36} }
 
 func (m *InspectDatumRequest) GetDatum() *Datum {
 	if m != nil {
@@ -2118,12 +2312,22 @@ type ListDatumRequest struct {
 	Job      *Job  `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
 	PageSize int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	Page     int64 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// input, if set instead of job, is evaluated against the current heads of
+	// its branches, listing the datums a pipeline with this input would process
+	// without running a job.
+	Input *Input `protobuf:"bytes,4,opt,name=input" json:"input,omitempty"`
+	// pipeline may be set along with input, to list the datums that would be
+	// processed by the existing pipeline 'pipeline' (e.g. once it's updated to
+	// 'input'). The datums' IDs are then those that the pipeline's workers
+	// would give them. A datum's ID depends on the pipeline that processes it,
+	// so the IDs are left empty if 'pipeline' isn't set or doesn't exist.
+	Pipeline *Pipeline `protobuf:"bytes,5,opt,name=pipeline" json:"pipeline,omitempty"`
 }
 
 func (m *ListDatumRequest) Reset()                    { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()               {}
-func (*ListDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
+func (*ListDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{37} }
 
 func (m *ListDatumRequest) GetJob() *Job {
 	if m != nil {
@@ -2146,6 +2350,20 @@ func (m *ListDatumRequest) GetPage() int64 {
 	return 0
 }
 
+func (m *ListDatumRequest) GetInput() *Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *ListDatumRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
 type ListDatumResponse struct {
 	DatumInfos []*DatumInfo `protobuf:"bytes,1,rep,name=datum_infos,json=datumInfos" json:"datum_infos,omitempty"`
 	TotalPages int64        `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
@@ -2155,7 +2373,7 @@ type ListDatumResponse struct {
 func (m *ListDatumResponse) Reset()                    { *m = ListDatumResponse{} }
 func (m *ListDatumResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumResponse) ProtoMessage()               {}
-func (*ListDatumResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{36} }
+func (*ListDatumResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{38} }
 
 func (m *ListDatumResponse) GetDatumInfos() []*DatumInfo {
 	if m != nil {
@@ -2193,7 +2411,7 @@ type ListDatumStreamResponse struct {
 func (m *ListDatumStreamResponse) Reset()                    { *m = ListDatumStreamResponse{} }
 func (m *ListDatumStreamResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumStreamResponse) ProtoMessage()               {}
-func (*ListDatumStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{37} }
+func (*ListDatumStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{39} }
 
 func (m *ListDatumStreamResponse) GetDatumInfo() *DatumInfo {
 	if m != nil {
@@ -2231,7 +2449,7 @@ type ChunkSpec struct {
 func (m *ChunkSpec) Reset()                    { *m = ChunkSpec{} }
 func (m *ChunkSpec) String() string            { return proto.CompactTextString(m) }
 func (*ChunkSpec) ProtoMessage()               {}
-func (*ChunkSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{38} }
+func (*ChunkSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{40} }
 
 func (m *ChunkSpec) GetNumber() int64 {
 	if m != nil {
@@ -2271,12 +2489,21 @@ type CreatePipelineRequest struct {
 	ChunkSpec    *ChunkSpec                 `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec" json:"chunk_spec,omitempty"`
 	DatumTimeout *google_protobuf2.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
 	JobTimeout   *google_protobuf2.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
+	// DatumTries is the number of times a datum is tried before it's
+	// considered failed. Tries are separated by an exponential backoff.
+	DatumTries int64 `protobuf:"varint,26,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	// SkipFailedDatums, if true, lets jobs succeed when some of their datums
+	// fail. The failed datums are counted in the job's data_failed and are
+	// retried by the next job.
+	SkipFailedDatums bool              `protobuf:"varint,27,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	Spout            *Spout            `protobuf:"bytes,28,opt,name=spout" json:"spout,omitempty"`
+	Template         *PipelineTemplate `protobuf:"bytes,29,opt,name=template" json:"template,omitempty"`
 }
 
 func (m *CreatePipelineRequest) Reset()                    { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()               {}
-func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{39} }
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{41} }
 
 func (m *CreatePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2425,14 +2652,44 @@ func (m *CreatePipelineRequest) GetJobTimeout() *google_protobuf2.Duration {
 	return nil
 }
 
+func (m *CreatePipelineRequest) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetSpout() *Spout {
+	if m != nil {
+		return m.Spout
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetTemplate() *PipelineTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
 type InspectPipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	// version, if set, selects a previous version of the pipeline's spec
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *InspectPipelineRequest) Reset()                    { *m = InspectPipelineRequest{} }
 func (m *InspectPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()               {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{40} }
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{42} }
 
 func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2441,13 +2698,173 @@ func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *InspectPipelineRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ListPipelineVersionsRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+}
+
+func (m *ListPipelineVersionsRequest) Reset()                    { *m = ListPipelineVersionsRequest{} }
+func (m *ListPipelineVersionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPipelineVersionsRequest) ProtoMessage()               {}
+func (*ListPipelineVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{43} }
+
+func (m *ListPipelineVersionsRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+type RollbackPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	// version is the version of the pipeline's spec to re-apply
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Reprocess forces the pipeline to reprocess all datums.
+	Reprocess bool `protobuf:"varint,3,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+}
+
+func (m *RollbackPipelineRequest) Reset()                    { *m = RollbackPipelineRequest{} }
+func (m *RollbackPipelineRequest) String() string            { return proto.CompactTextString(m) }
+func (*RollbackPipelineRequest) ProtoMessage()               {}
+func (*RollbackPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
+
+func (m *RollbackPipelineRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *RollbackPipelineRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RollbackPipelineRequest) GetReprocess() bool {
+	if m != nil {
+		return m.Reprocess
+	}
+	return false
+}
+
 type ListPipelineRequest struct {
 }
 
 func (m *ListPipelineRequest) Reset()                    { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()               {}
-func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{41} }
+func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
+
+type InspectDAGRequest struct {
+	// If repo is set, only repo and its ancestors and descendants are
+	// included in the DAG.
+	Repo *pfs.Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+}
+
+func (m *InspectDAGRequest) Reset()                    { *m = InspectDAGRequest{} }
+func (m *InspectDAGRequest) String() string            { return proto.CompactTextString(m) }
+func (*InspectDAGRequest) ProtoMessage()               {}
+func (*InspectDAGRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{46} }
+
+func (m *InspectDAGRequest) GetRepo() *pfs.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+// DAGNode is a repo in the DAG formed by repo provenance and pipeline
+// inputs. If the repo is a pipeline's output repo, pipeline is set and the
+// node is annotated with the pipeline's state and jobs.
+type DAGNode struct {
+	Repo     *pfs.Repo     `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Parents  []*pfs.Repo   `protobuf:"bytes,2,rep,name=parents" json:"parents,omitempty"`
+	Pipeline *Pipeline     `protobuf:"bytes,3,opt,name=pipeline" json:"pipeline,omitempty"`
+	State    PipelineState `protobuf:"varint,4,opt,name=state,proto3,enum=pps.PipelineState" json:"state,omitempty"`
+	// last_job is the pipeline's most recently started job, if any.
+	LastJob      *Job            `protobuf:"bytes,5,opt,name=last_job,json=lastJob" json:"last_job,omitempty"`
+	LastJobState JobState        `protobuf:"varint,6,opt,name=last_job_state,json=lastJobState,proto3,enum=pps.JobState" json:"last_job_state,omitempty"`
+	JobCounts    map[int32]int32 `protobuf:"bytes,7,rep,name=job_counts,json=jobCounts" json:"job_counts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *DAGNode) Reset()                    { *m = DAGNode{} }
+func (m *DAGNode) String() string            { return proto.CompactTextString(m) }
+func (*DAGNode) ProtoMessage()               {}
+func (*DAGNode) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{47} }
+
+func (m *DAGNode) GetRepo() *pfs.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *DAGNode) GetParents() []*pfs.Repo {
+	if m != nil {
+		return m.Parents
+	}
+	return nil
+}
+
+func (m *DAGNode) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *DAGNode) GetState() PipelineState {
+	if m != nil {
+		return m.State
+	}
+	return PipelineState_PIPELINE_STARTING
+}
+
+func (m *DAGNode) GetLastJob() *Job {
+	if m != nil {
+		return m.LastJob
+	}
+	return nil
+}
+
+func (m *DAGNode) GetLastJobState() JobState {
+	if m != nil {
+		return m.LastJobState
+	}
+	return JobState_JOB_STARTING
+}
+
+func (m *DAGNode) GetJobCounts() map[int32]int32 {
+	if m != nil {
+		return m.JobCounts
+	}
+	return nil
+}
+
+type DAGInfo struct {
+	// nodes are sorted topologically, a node always comes after its parents.
+	Nodes []*DAGNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *DAGInfo) Reset()                    { *m = DAGInfo{} }
+func (m *DAGInfo) String() string            { return proto.CompactTextString(m) }
+func (*DAGInfo) ProtoMessage()               {}
+func (*DAGInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{48} }
+
+func (m *DAGInfo) GetNodes() []*DAGNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
 
 type DeletePipelineRequest struct {
 	Pipeline   *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
@@ -2459,7 +2876,7 @@ type DeletePipelineRequest struct {
 func (m *DeletePipelineRequest) Reset()                    { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()               {}
-func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{42} }
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{49} }
 
 func (m *DeletePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2496,7 +2913,7 @@ type StartPipelineRequest struct {
 func (m *StartPipelineRequest) Reset()                    { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()               {}
-func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{43} }
+func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{50} }
 
 func (m *StartPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2512,7 +2929,7 @@ type StopPipelineRequest struct {
 func (m *StopPipelineRequest) Reset()                    { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()               {}
-func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
+func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{51} }
 
 func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2530,7 +2947,7 @@ type RerunPipelineRequest struct {
 func (m *RerunPipelineRequest) Reset()                    { *m = RerunPipelineRequest{} }
 func (m *RerunPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*RerunPipelineRequest) ProtoMessage()               {}
-func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
+func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{52} }
 
 func (m *RerunPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2559,7 +2976,7 @@ type GarbageCollectRequest struct {
 func (m *GarbageCollectRequest) Reset()                    { *m = GarbageCollectRequest{} }
 func (m *GarbageCollectRequest) String() string            { return proto.CompactTextString(m) }
 func (*GarbageCollectRequest) ProtoMessage()               {}
-func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{46} }
+func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{53} }
 
 type GarbageCollectResponse struct {
 }
@@ -2567,7 +2984,7 @@ type GarbageCollectResponse struct {
 func (m *GarbageCollectResponse) Reset()                    { *m = GarbageCollectResponse{} }
 func (m *GarbageCollectResponse) String() string            { return proto.CompactTextString(m) }
 func (*GarbageCollectResponse) ProtoMessage()               {}
-func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{47} }
+func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{54} }
 
 func init() {
 	proto.RegisterType((*Secret)(nil), "pps.Secret")
@@ -2575,6 +2992,8 @@ func init() {
 	proto.RegisterType((*Egress)(nil), "pps.Egress")
 	proto.RegisterType((*Job)(nil), "pps.Job")
 	proto.RegisterType((*Service)(nil), "pps.Service")
+	proto.RegisterType((*Spout)(nil), "pps.Spout")
+	proto.RegisterType((*PipelineTemplate)(nil), "pps.PipelineTemplate")
 	proto.RegisterType((*AtomInput)(nil), "pps.AtomInput")
 	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
 	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
@@ -2611,7 +3030,12 @@ func init() {
 	proto.RegisterType((*ChunkSpec)(nil), "pps.ChunkSpec")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
 	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
+	proto.RegisterType((*ListPipelineVersionsRequest)(nil), "pps.ListPipelineVersionsRequest")
+	proto.RegisterType((*RollbackPipelineRequest)(nil), "pps.RollbackPipelineRequest")
 	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
+	proto.RegisterType((*InspectDAGRequest)(nil), "pps.InspectDAGRequest")
+	proto.RegisterType((*DAGNode)(nil), "pps.DAGNode")
+	proto.RegisterType((*DAGInfo)(nil), "pps.DAGInfo")
 	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
 	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
 	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
@@ -2654,10 +3078,14 @@ type APIClient interface {
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
+	ListPipelineVersions(ctx context.Context, in *ListPipelineVersionsRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// InspectDAG returns the graph of repos and pipelines.
+	InspectDAG(ctx context.Context, in *InspectDAGRequest, opts ...grpc.CallOption) (*DAGInfo, error)
 	// DeleteAll deletes everything
 	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
@@ -2836,6 +3264,15 @@ func (c *aPIClient) ListPipeline(ctx context.Context, in *ListPipelineRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) ListPipelineVersions(ctx context.Context, in *ListPipelineVersionsRequest, opts ...grpc.CallOption) (*PipelineInfos, error) {
+	out := new(PipelineInfos)
+	err := grpc.Invoke(ctx, "/pps.API/ListPipelineVersions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/DeletePipeline", in, out, c.cc, opts...)
@@ -2872,6 +3309,24 @@ func (c *aPIClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest,
 	return out, nil
 }
 
+func (c *aPIClient) RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pps.API/RollbackPipeline", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectDAG(ctx context.Context, in *InspectDAGRequest, opts ...grpc.CallOption) (*DAGInfo, error) {
+	out := new(DAGInfo)
+	err := grpc.Invoke(ctx, "/pps.API/InspectDAG", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/DeleteAll", in, out, c.cc, opts...)
@@ -2944,10 +3399,14 @@ type APIServer interface {
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*google_protobuf.Empty, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
+	ListPipelineVersions(context.Context, *ListPipelineVersionsRequest) (*PipelineInfos, error)
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*google_protobuf.Empty, error)
 	StartPipeline(context.Context, *StartPipelineRequest) (*google_protobuf.Empty, error)
 	StopPipeline(context.Context, *StopPipelineRequest) (*google_protobuf.Empty, error)
 	RerunPipeline(context.Context, *RerunPipelineRequest) (*google_protobuf.Empty, error)
+	RollbackPipeline(context.Context, *RollbackPipelineRequest) (*google_protobuf.Empty, error)
+	// InspectDAG returns the graph of repos and pipelines.
+	InspectDAG(context.Context, *InspectDAGRequest) (*DAGInfo, error)
 	// DeleteAll deletes everything
 	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
@@ -3199,17 +3658,35 @@ func _API_ListPipeline_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePipelineRequest)
+func _API_ListPipelineVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelineVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).DeletePipeline(ctx, in)
+		return srv.(APIServer).ListPipelineVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pps.API/DeletePipeline",
+		FullMethod: "/pps.API/ListPipelineVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListPipelineVersions(ctx, req.(*ListPipelineVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/DeletePipeline",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(APIServer).DeletePipeline(ctx, req.(*DeletePipelineRequest))
@@ -3271,6 +3748,42 @@ func _API_RerunPipeline_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_RollbackPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RollbackPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/RollbackPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RollbackPipeline(ctx, req.(*RollbackPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectDAG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectDAGRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectDAG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/InspectDAG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectDAG(ctx, req.(*InspectDAGRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(google_protobuf.Empty)
 	if err := dec(in); err != nil {
@@ -3376,6 +3889,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPipeline",
 			Handler:    _API_ListPipeline_Handler,
 		},
+		{
+			MethodName: "ListPipelineVersions",
+			Handler:    _API_ListPipelineVersions_Handler,
+		},
 		{
 			MethodName: "DeletePipeline",
 			Handler:    _API_DeletePipeline_Handler,
@@ -3392,6 +3909,14 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RerunPipeline",
 			Handler:    _API_RerunPipeline_Handler,
 		},
+		{
+			MethodName: "RollbackPipeline",
+			Handler:    _API_RollbackPipeline_Handler,
+		},
+		{
+			MethodName: "InspectDAG",
+			Handler:    _API_InspectDAG_Handler,
+		},
 		{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
@@ -3586,6 +4111,36 @@ func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.ErrCmd) > 0 {
+		for _, s := range m.ErrCmd {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ErrStdin) > 0 {
+		for _, s := range m.ErrStdin {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -3665,6 +4220,75 @@ func (m *Service) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Spout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Spout) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Overwrite {
+		dAtA[i] = 0x8
+		i++
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PipelineTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineTemplate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Template) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Template)))
+		i += copy(dAtA[i:], m.Template)
+	}
+	if len(m.Args) > 0 {
+		for k, _ := range m.Args {
+			dAtA[i] = 0x12
+			i++
+			v := m.Args[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
 func (m *AtomInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3736,6 +4360,28 @@ func (m *AtomInput) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.JoinOn) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JoinOn)))
+		i += copy(dAtA[i:], m.JoinOn)
+	}
+	if m.OuterJoin {
+		dAtA[i] = 0x50
+		i++
+		if m.OuterJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.GroupBy) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.GroupBy)))
+		i += copy(dAtA[i:], m.GroupBy)
+	}
 	return i, nil
 }
 
@@ -3902,6 +4548,30 @@ func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n6
 	}
+	if len(m.Join) > 0 {
+		for _, msg := range m.Join {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Group) > 0 {
+		for _, msg := range m.Group {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -4742,6 +5412,25 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
 	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -5193,6 +5882,49 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.GithookURL)))
 		i += copy(dAtA[i:], m.GithookURL)
 	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Spout != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
+		n104, err := m.Spout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n104
+	}
+	if m.Template != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Template.Size()))
+		n110, err := m.Template.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n110
+	}
 	return i, nil
 }
 
@@ -5446,6 +6178,37 @@ func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n70
 	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.OutputCommit != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
+		n112, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n112
+	}
 	return i, nil
 }
 
@@ -5877,6 +6640,26 @@ func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
+	if m.Input != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
+		n83, err := m.Input.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n113, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n113
+	}
 	return i, nil
 }
 
@@ -5939,11 +6722,11 @@ func (m *ListDatumStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumInfo.Size()))
-		n83, err := m.DatumInfo.MarshalTo(dAtA[i:])
+		n84, err := m.DatumInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n83
+		i += n84
 	}
 	if m.TotalPages != 0 {
 		dAtA[i] = 0x10
@@ -6005,21 +6788,21 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n84, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n85, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n85
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n85, err := m.Transform.MarshalTo(dAtA[i:])
+		n86, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n86
 	}
 	if m.Update {
 		dAtA[i] = 0x28
@@ -6035,21 +6818,21 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n86, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n87, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n87
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n87, err := m.Egress.MarshalTo(dAtA[i:])
+		n88, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n87
+		i += n88
 	}
 	if len(m.OutputBranch) > 0 {
 		dAtA[i] = 0x52
@@ -6061,31 +6844,31 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n88, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		n89, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n88
+		i += n89
 	}
 	if m.ResourceRequests != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
-		n89, err := m.ResourceRequests.MarshalTo(dAtA[i:])
+		n90, err := m.ResourceRequests.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n89
+		i += n90
 	}
 	if m.Input != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n90, err := m.Input.MarshalTo(dAtA[i:])
+		n91, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n90
+		i += n91
 	}
 	if len(m.Description) > 0 {
 		dAtA[i] = 0x72
@@ -6160,11 +6943,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n91, err := m.Service.MarshalTo(dAtA[i:])
+		n92, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n91
+		i += n92
 	}
 	if m.ResourceLimits != nil {
 		dAtA[i] = 0xb2
@@ -6172,11 +6955,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
-		n92, err := m.ResourceLimits.MarshalTo(dAtA[i:])
+		n93, err := m.ResourceLimits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n92
+		i += n93
 	}
 	if m.ChunkSpec != nil {
 		dAtA[i] = 0xba
@@ -6184,11 +6967,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
-		n93, err := m.ChunkSpec.MarshalTo(dAtA[i:])
+		n94, err := m.ChunkSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n93
+		i += n94
 	}
 	if m.DatumTimeout != nil {
 		dAtA[i] = 0xc2
@@ -6196,11 +6979,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
-		n94, err := m.DatumTimeout.MarshalTo(dAtA[i:])
+		n95, err := m.DatumTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n94
+		i += n95
 	}
 	if m.JobTimeout != nil {
 		dAtA[i] = 0xca
@@ -6208,11 +6991,54 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
-		n95, err := m.JobTimeout.MarshalTo(dAtA[i:])
+		n96, err := m.JobTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n95
+		i += n96
+	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Spout != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
+		n105, err := m.Spout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n105
+	}
+	if m.Template != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Template.Size()))
+		n111, err := m.Template.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n111
 	}
 	return i, nil
 }
@@ -6236,11 +7062,87 @@ func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n96, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n97, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n96
+		i += n97
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *ListPipelineVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPipelineVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n98, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n98
+	}
+	return i, nil
+}
+
+func (m *RollbackPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n99, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n99
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+	}
+	if m.Reprocess {
+		dAtA[i] = 0x18
+		i++
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
@@ -6263,6 +7165,149 @@ func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *InspectDAGRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectDAGRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
+		n106, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n106
+	}
+	return i, nil
+}
+
+func (m *DAGNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAGNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
+		n107, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n107
+	}
+	if len(m.Parents) > 0 {
+		for _, msg := range m.Parents {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n108, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n108
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	}
+	if m.LastJob != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LastJob.Size()))
+		n109, err := m.LastJob.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n109
+	}
+	if m.LastJobState != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LastJobState))
+	}
+	if len(m.JobCounts) > 0 {
+		for k, _ := range m.JobCounts {
+			dAtA[i] = 0x3a
+			i++
+			v := m.JobCounts[k]
+			mapSize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(k))
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *DAGInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAGInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6282,11 +7327,11 @@ func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n97, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n100, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n97
+		i += n100
 	}
 	if m.DeleteJobs {
 		dAtA[i] = 0x10
@@ -6340,11 +7385,11 @@ func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n98, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n101, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n98
+		i += n101
 	}
 	return i, nil
 }
@@ -6368,11 +7413,11 @@ func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n99, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n102, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n99
+		i += n102
 	}
 	return i, nil
 }
@@ -6396,11 +7441,11 @@ func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n100, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n103, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n100
+		i += n103
 	}
 	if len(m.Exclude) > 0 {
 		for _, msg := range m.Exclude {
@@ -6545,12 +7590,24 @@ func (m *Transform) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *Egress) Size() (n int) {
-	var l int
-	_ = l
+	if len(m.ErrCmd) > 0 {
+		for _, s := range m.ErrCmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ErrStdin) > 0 {
+		for _, s := range m.ErrStdin {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Egress) Size() (n int) {
+	var l int
+	_ = l
 	l = len(m.URL)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
@@ -6580,6 +7637,33 @@ func (m *Service) Size() (n int) {
 	return n
 }
 
+func (m *Spout) Size() (n int) {
+	var l int
+	_ = l
+	if m.Overwrite {
+		n += 2
+	}
+	return n
+}
+
+func (m *PipelineTemplate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *AtomInput) Size() (n int) {
 	var l int
 	_ = l
@@ -6613,6 +7697,17 @@ func (m *AtomInput) Size() (n int) {
 	if m.EmptyFiles {
 		n += 2
 	}
+	l = len(m.JoinOn)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.OuterJoin {
+		n += 2
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -6691,6 +7786,18 @@ func (m *Input) Size() (n int) {
 		l = m.Git.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.Join) > 0 {
+		for _, e := range m.Join {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Group) > 0 {
+		for _, e := range m.Group {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7031,6 +8138,12 @@ func (m *JobInfo) Size() (n int) {
 	if m.DataFailed != 0 {
 		n += 2 + sovPps(uint64(m.DataFailed))
 	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
 	return n
 }
 
@@ -7215,6 +8328,20 @@ func (m *PipelineInfo) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -7309,6 +8436,16 @@ func (m *CreateJobRequest) Size() (n int) {
 		l = m.JobTimeout.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -7479,6 +8616,14 @@ func (m *ListDatumRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovPps(uint64(m.Page))
 	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -7609,6 +8754,20 @@ func (m *CreatePipelineRequest) Size() (n int) {
 		l = m.JobTimeout.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -7619,6 +8778,35 @@ func (m *InspectPipelineRequest) Size() (n int) {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *ListPipelineVersionsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *RollbackPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.Reprocess {
+		n += 2
+	}
 	return n
 }
 
@@ -7628,6 +8816,66 @@ func (m *ListPipelineRequest) Size() (n int) {
 	return n
 }
 
+func (m *InspectDAGRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DAGNode) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Parents) > 0 {
+		for _, e := range m.Parents {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.LastJob != nil {
+		l = m.LastJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.LastJobState != 0 {
+		n += 1 + sovPps(uint64(m.LastJobState))
+	}
+	if len(m.JobCounts) > 0 {
+		for k, v := range m.JobCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DAGInfo) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DeletePipelineRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -8256,6 +9504,64 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			}
 			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrCmd = append(m.ErrCmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrStdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrStdin = append(m.ErrStdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -8523,7 +9829,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AtomInput) Unmarshal(dAtA []byte) error {
+func (m *Spout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8546,17 +9852,17 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AtomInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AtomInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8566,82 +9872,65 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8666,13 +9955,13 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8682,90 +9971,110 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Lazy = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCommit", wireType)
+			if m.Args == nil {
+				m.Args = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromCommit = string(dAtA[iNdEx:postIndex])
+			m.Args[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EmptyFiles = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -8787,7 +10096,7 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronInput) Unmarshal(dAtA []byte) error {
+func (m *AtomInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8810,10 +10119,10 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: AtomInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AtomInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8876,7 +10185,7 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8901,11 +10210,11 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8930,13 +10239,13 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8946,78 +10255,44 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &google_protobuf1.Timestamp{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Lazy = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCommit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9042,13 +10317,13 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.FromCommit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9058,24 +10333,15 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.EmptyFiles = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9100,11 +10366,31 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.JoinOn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterJoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OuterJoin = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9129,7 +10415,7 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9152,7 +10438,7 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *CronInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9175,17 +10461,17 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9195,30 +10481,26 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Atom == nil {
-				m.Atom = &AtomInput{}
-			}
-			if err := m.Atom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9228,28 +10510,26 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9259,28 +10539,26 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9290,28 +10568,24 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9335,10 +10609,10 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitInput{}
+			if m.Start == nil {
+				m.Start = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9363,7 +10637,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInput) Unmarshal(dAtA []byte) error {
+func (m *GitInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9386,17 +10660,17 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9406,28 +10680,24 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9452,13 +10722,13 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9468,15 +10738,24 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9501,7 +10780,7 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9524,7 +10803,7 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9547,17 +10826,17 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
 			}
-			m.Constant = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9567,77 +10846,61 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Coefficient = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if m.Atom == nil {
+				m.Atom = &AtomInput{}
+			}
+			if err := m.Atom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9647,26 +10910,28 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9676,78 +10941,63 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Git == nil {
+				m.Git = &GitInput{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9757,24 +11007,26 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9798,10 +11050,8 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Group = append(m.Group, &Input{})
+			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9826,7 +11076,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *JobInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9849,15 +11099,15 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9881,18 +11131,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9902,49 +11152,26 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (DatumState(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9954,30 +11181,17 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
-			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+			m.Lazy = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9987,22 +11201,20 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10025,7 +11237,7 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10048,17 +11260,17 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
 			}
-			m.Count = 0
+			m.Constant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10068,47 +11280,14 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (int64(b) & 0x7F) << shift
+				m.Constant |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
 		case 3:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -10116,7 +11295,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+			m.Coefficient = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10138,7 +11317,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+func (m *InputFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10161,50 +11340,17 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &google_protobuf2.Duration{}
-			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10214,30 +11360,26 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &google_protobuf2.Duration{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10247,63 +11389,23 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &google_protobuf2.Duration{}
-			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
-			}
-			m.DownloadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownloadBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
-			}
-			m.UploadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10325,7 +11427,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *Datum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10348,17 +11450,17 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10368,127 +11470,24 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
-			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
-			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
-			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10512,10 +11511,10 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10540,7 +11539,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10563,17 +11562,17 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10583,26 +11582,30 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10612,24 +11615,14 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.State |= (DatumState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10653,14 +11646,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10684,16 +11679,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10717,32 +11712,11 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
-			}
-			m.QueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueueSize |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10764,7 +11738,7 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10787,28 +11761,17 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10818,40 +11781,55 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Count |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
 			}
-			m.Gpu = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gpu |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
 			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10873,7 +11851,7 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10896,15 +11874,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10928,16 +11906,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &google_protobuf2.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10961,16 +11939,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &google_protobuf2.Duration{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10994,18 +11972,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.UploadTime == nil {
+				m.UploadTime = &google_protobuf2.Duration{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			var msglen int
+			m.DownloadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11015,30 +11993,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DownloadBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
-			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
-			var msglen int
+			m.UploadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11048,28 +12012,64 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.UploadBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11093,16 +12093,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &google_protobuf1.Timestamp{}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11126,35 +12126,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= (JobState(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11178,35 +12159,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
-			}
-			m.PipelineVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11230,16 +12192,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11263,47 +12225,68 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11313,49 +12296,26 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
-			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			m.DataProcessed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11365,33 +12325,24 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTotal |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 24:
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11415,14 +12366,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11446,16 +12397,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11479,18 +12430,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
 			}
-			var msglen int
+			m.QueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11500,30 +12451,77 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.QueueSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.BranchInfo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var v int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11533,17 +12531,26 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+			}
+			m.Gpu = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11553,30 +12560,66 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Gpu |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 30:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.DataSkipped = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11586,14 +12629,28 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11617,18 +12674,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11638,17 +12695,30 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 33:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11658,26 +12728,30 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 34:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11687,17 +12761,30 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Batch = bool(v != 0)
-		case 35:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11707,24 +12794,28 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 36:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11748,16 +12839,35 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (JobState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11781,16 +12891,35 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+			}
+			m.PipelineVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11814,16 +12943,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &google_protobuf2.Duration{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11847,18 +12976,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &google_protobuf2.Duration{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			m.DataFailed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11868,66 +12997,26 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Worker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11937,26 +13026,30 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
+			}
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			m.State = 0
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11966,64 +13059,52 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (WorkerState(b) & 0x7F) << shift
+				m.Restart |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12047,66 +13128,49 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobInfo = append(m.JobInfo, &JobInfo{})
-			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12116,74 +13180,81 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.BranchInfo{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12207,18 +13278,37 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &pfs.Repo{}
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			}
+			m.DataSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkipped |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12228,24 +13318,48 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableStats = bool(v != 0)
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12270,11 +13384,11 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 34:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12291,10 +13405,10 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 5:
+			m.Batch = bool(v != 0)
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12319,11 +13433,11 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12347,66 +13461,16 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &pfs.Commit{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12430,16 +13494,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12463,16 +13527,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &google_protobuf2.Duration{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12496,18 +13560,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &google_protobuf1.Timestamp{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &google_protobuf2.Duration{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 40:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			m.State = 0
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12517,16 +13581,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (PipelineState(b) & 0x7F) << shift
+				m.DataFailed |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var stringLen uint64
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12536,26 +13600,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DatumTries |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12565,93 +13619,67 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.SkipFailedDatums = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobCounts == nil {
-				m.JobCounts = make(map[int32]int32)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			var mapkey int32
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.JobCounts[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12661,30 +13689,26 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Version = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12694,14 +13718,64 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= (uint64(b) & 0x7F) << shift
+				m.State |= (WorkerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12725,45 +13799,64 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JobInfo = append(m.JobInfo, &JobInfo{})
+			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12788,77 +13881,61 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScaleDownThreshold == nil {
-				m.ScaleDownThreshold = &google_protobuf2.Duration{}
-			}
-			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
-			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 20:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12882,16 +13959,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12916,31 +13993,11 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Incremental = bool(v != 0)
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12965,11 +14022,11 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12986,10 +14043,10 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 25:
+			m.Lazy = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13014,13 +14071,13 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13030,46 +14087,80 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Capability = string(dAtA[iNdEx:postIndex])
+			if m.From == nil {
+				m.From = &pfs.Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Batch = bool(v != 0)
-		case 28:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13079,43 +14170,28 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			m.MaxQueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 30:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13139,16 +14215,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13172,18 +14248,37 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &google_protobuf1.Timestamp{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (PipelineState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13193,28 +14288,24 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
-			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecentError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 33:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13238,16 +14329,79 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &google_protobuf2.Duration{}
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.JobCounts[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 34:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13271,18 +14425,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &google_protobuf2.Duration{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithookURL", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13292,74 +14446,14 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GithookURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13383,66 +14477,47 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13452,28 +14527,24 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13497,16 +14568,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.ScaleDownThreshold == nil {
+				m.ScaleDownThreshold = &google_protobuf2.Duration{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13530,16 +14601,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13563,18 +14634,18 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13584,30 +14655,26 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
-			m.PipelineVersion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13617,14 +14684,15 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.Incremental = bool(v != 0)
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13649,13 +14717,13 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13665,30 +14733,17 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
-			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+			m.EnableStats = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13698,30 +14753,26 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
-			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13731,30 +14782,26 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
-			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Capability = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13764,30 +14811,17 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+			m.Batch = bool(v != 0)
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13797,30 +14831,26 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.BranchInfo{}
-			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
 			}
-			var v int
+			m.MaxQueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13830,17 +14860,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13850,17 +14879,30 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 19:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13870,26 +14912,30 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13899,15 +14945,28 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Batch = bool(v != 0)
-		case 21:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
+			}
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13931,16 +14990,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &google_protobuf2.Duration{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13964,16 +15023,84 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &google_protobuf2.Duration{}
 			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithookURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GithookURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipFailedDatums = bool(v != 0)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13997,16 +15124,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &google_protobuf2.Duration{}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14030,10 +15157,10 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &google_protobuf2.Duration{}
+			if m.Template == nil {
+				m.Template = &PipelineTemplate{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14058,7 +15185,7 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14081,15 +15208,15 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14113,33 +15240,11 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BlockState = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14161,7 +15266,7 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14184,15 +15289,15 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14216,16 +15321,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14249,14 +15354,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14280,66 +15387,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14363,66 +15420,16 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14446,68 +15453,18 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
 			}
-			var msglen int
+			m.PipelineVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14517,30 +15474,16 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14550,30 +15493,26 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14583,26 +15522,30 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
+			}
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14612,15 +15555,28 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14644,18 +15600,18 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14665,17 +15621,30 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Follow = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Tail = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14685,66 +15654,30 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tail |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.BranchInfo{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14754,24 +15687,35 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			m.Incremental = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.EnableStats = bool(v != 0)
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14796,11 +15740,31 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14824,14 +15788,16 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14855,18 +15821,18 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &google_protobuf1.Timestamp{}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
 			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14876,26 +15842,30 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
-			}
-			var stringLen uint64
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &google_protobuf2.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14905,26 +15875,30 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.JobTimeout == nil {
+				m.JobTimeout = &google_protobuf2.Duration{}
+			}
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var v int
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14934,17 +15908,16 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DatumTries |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.User = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14954,26 +15927,17 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DatumID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			m.SkipFailedDatums = bool(v != 0)
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14983,12 +15947,25 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15010,7 +15987,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15033,10 +16010,10 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15073,10 +16050,10 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15086,21 +16063,12 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.BlockState = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15122,7 +16090,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15145,15 +16113,15 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15177,10 +16145,74 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15205,7 +16237,7 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15228,10 +16260,10 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15267,44 +16299,6 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15326,7 +16320,7 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15349,15 +16343,15 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15381,54 +16375,18 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
-			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
-			}
-			m.TotalPages = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPages |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthPps
@@ -15445,7 +16403,7 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15468,15 +16426,15 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15500,18 +16458,18 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumInfo == nil {
-				m.DatumInfo = &DatumInfo{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.DatumInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			m.TotalPages = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15521,16 +16479,30 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPages |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15540,66 +16512,79 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			if iNdEx >= l {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			m.Number = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15609,16 +16594,17 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Follow = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
 			}
-			m.SizeBytes = 0
+			m.Tail = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15628,7 +16614,7 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				m.Tail |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15654,7 +16640,7 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15677,17 +16663,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15697,30 +16683,26 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15730,48 +16712,24 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15795,16 +16753,14 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15828,16 +16784,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.Ts == nil {
+				m.Ts = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15862,13 +16818,13 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15878,30 +16834,26 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScaleDownThreshold == nil {
-				m.ScaleDownThreshold = &google_protobuf2.Duration{}
-			}
-			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15911,30 +16863,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
-			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+			m.User = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15944,30 +16883,26 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15977,26 +16912,67 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Master = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			var v int
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16006,15 +16982,28 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16039,13 +17028,63 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16055,17 +17094,1792 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
+			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumInfo == nil {
+				m.DatumInfo = &DatumInfo{}
+			}
+			if err := m.DatumInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScaleDownThreshold == nil {
+				m.ScaleDownThreshold = &google_protobuf2.Duration{}
+			}
+			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
+			}
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableStats = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reprocess = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+			}
+			m.MaxQueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
+			}
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &google_protobuf2.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &google_protobuf2.Duration{}
+			}
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipFailedDatums = bool(v != 0)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
+			}
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &PipelineTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPipelineVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPipelineVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPipelineVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reprocess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDAGRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDAGRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDAGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16075,54 +18889,28 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reprocess = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Batch = bool(v != 0)
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
 			}
-			m.MaxQueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxQueueSize |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 21:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16146,16 +18934,14 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parents = append(m.Parents, &pfs.Repo{})
+			if err := m.Parents[len(m.Parents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16179,18 +18965,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16200,28 +18986,14 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.State |= (PipelineState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
-			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16245,16 +19017,35 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &google_protobuf2.Duration{}
+			if m.LastJob == nil {
+				m.LastJob = &Job{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+			}
+			m.LastJobState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastJobState |= (JobState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16278,12 +19069,75 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &google_protobuf2.Duration{}
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.JobCounts[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16306,7 +19160,7 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *DAGInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16329,15 +19183,15 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16361,10 +19215,8 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &DAGNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16389,56 +19241,6 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17101,234 +19903,265 @@ var (
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptorPps) }
 
 var fileDescriptorPps = []byte{
-	// 3661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xdd, 0x6e, 0x1b, 0x49,
-	0x76, 0x16, 0xff, 0xd9, 0x87, 0x14, 0x45, 0x95, 0xfe, 0xda, 0xf4, 0x58, 0x94, 0x7b, 0xd6, 0x1e,
-	0xdb, 0x98, 0x95, 0x67, 0xe5, 0x8d, 0xb3, 0x99, 0x4c, 0x66, 0xd6, 0xfa, 0xb1, 0x21, 0x8d, 0xe2,
-	0x65, 0x5a, 0xd6, 0xe6, 0xb2, 0xd1, 0x6c, 0x16, 0xa9, 0xb6, 0x9b, 0xdd, 0xbd, 0xdd, 0x4d, 0x79,
-	0x34, 0x40, 0x80, 0xbc, 0x41, 0x90, 0xbd, 0x08, 0x82, 0x00, 0xb9, 0xca, 0x0b, 0xe4, 0x62, 0x81,
-	0xdc, 0x24, 0x97, 0x01, 0xf6, 0x26, 0x40, 0x9e, 0xc0, 0x08, 0x14, 0x20, 0x4f, 0x91, 0x00, 0x41,
-	0x9d, 0xaa, 0x6a, 0x76, 0x93, 0x2d, 0x4a, 0x94, 0xf6, 0x82, 0x40, 0xd5, 0xa9, 0x53, 0x7f, 0xa7,
-	0xea, 0x7c, 0xe7, 0x3b, 0xd5, 0x84, 0x55, 0xcb, 0xb1, 0xa9, 0x1b, 0x3d, 0xf7, 0xfd, 0x90, 0xfd,
-	0xb6, 0xfd, 0xc0, 0x8b, 0x3c, 0x52, 0xf0, 0xfd, 0xb0, 0x75, 0x7f, 0xe0, 0x79, 0x03, 0x87, 0x3e,
-	0x47, 0x51, 0x77, 0xd4, 0x7f, 0x4e, 0x87, 0x7e, 0x74, 0xc1, 0x35, 0x5a, 0xed, 0xc9, 0xc6, 0xc8,
-	0x1e, 0xd2, 0x30, 0x32, 0x87, 0xbe, 0x50, 0xd8, 0x9c, 0x54, 0xe8, 0x8d, 0x02, 0x33, 0xb2, 0x3d,
-	0x57, 0xb4, 0xaf, 0x0e, 0xbc, 0x81, 0x87, 0xc5, 0xe7, 0xac, 0x24, 0xa5, 0x72, 0x39, 0xfd, 0x90,
-	0xfd, 0xb8, 0x54, 0xeb, 0x43, 0xf9, 0x84, 0x5a, 0x01, 0x8d, 0x08, 0x81, 0xa2, 0x6b, 0x0e, 0xa9,
-	0x9a, 0xdb, 0xca, 0x3d, 0x51, 0x74, 0x2c, 0x93, 0x07, 0x00, 0x43, 0x6f, 0xe4, 0x46, 0x86, 0x6f,
-	0x46, 0x67, 0x6a, 0x1e, 0x5b, 0x14, 0x94, 0x74, 0xcc, 0xe8, 0x8c, 0x6c, 0x40, 0x85, 0xba, 0xe7,
-	0xc6, 0xb9, 0x19, 0xa8, 0x05, 0x6c, 0x2b, 0x53, 0xf7, 0xfc, 0xd7, 0x66, 0x40, 0x9a, 0x50, 0xf8,
-	0x40, 0x2f, 0xd4, 0x22, 0x0a, 0x59, 0x51, 0xfb, 0xf7, 0x3c, 0x28, 0xef, 0x02, 0xd3, 0x0d, 0xfb,
-	0x5e, 0x30, 0x24, 0xab, 0x50, 0xb2, 0x87, 0xe6, 0x40, 0x4e, 0xc6, 0x2b, 0xac, 0x97, 0x35, 0xec,
-	0xa9, 0xf9, 0xad, 0x02, 0xeb, 0x65, 0x0d, 0x7b, 0xe4, 0x29, 0x14, 0xa8, 0x7b, 0xae, 0x16, 0xb6,
-	0x0a, 0x4f, 0x6a, 0x3b, 0x1b, 0xdb, 0xcc, 0x8a, 0xf1, 0x20, 0xdb, 0x07, 0xee, 0xf9, 0x81, 0x1b,
-	0x05, 0x17, 0x3a, 0xd3, 0x21, 0x8f, 0xa0, 0x12, 0xe2, 0x46, 0x42, 0xb5, 0x88, 0xea, 0x35, 0x54,
-	0xe7, 0x9b, 0xd3, 0x65, 0x1b, 0x9b, 0x39, 0x8c, 0x7a, 0xb6, 0xab, 0x96, 0x70, 0x16, 0x5e, 0x21,
-	0x5f, 0x02, 0x31, 0x2d, 0x8b, 0xfa, 0x91, 0x11, 0xd0, 0x68, 0x14, 0xb8, 0x86, 0xe5, 0xf5, 0xa8,
-	0x5a, 0xde, 0x2a, 0x3c, 0x29, 0xe8, 0x4d, 0xde, 0xa2, 0x63, 0xc3, 0x9e, 0xd7, 0xa3, 0x6c, 0x8c,
-	0x1e, 0xed, 0x8e, 0x06, 0x6a, 0x65, 0x2b, 0xf7, 0xa4, 0xaa, 0xf3, 0x0a, 0x1b, 0x03, 0xb7, 0x61,
-	0xf8, 0x23, 0xc7, 0x31, 0xe4, 0x5a, 0x14, 0x9c, 0xa6, 0x89, 0x2d, 0x9d, 0x91, 0xe3, 0xf0, 0xf5,
-	0x84, 0xad, 0x97, 0x50, 0x95, 0xeb, 0x97, 0xd6, 0xca, 0xc5, 0xd6, 0x62, 0x33, 0x9c, 0x9b, 0xce,
-	0x88, 0x0a, 0x93, 0xf3, 0xca, 0xd7, 0xf9, 0x5f, 0xe4, 0xb4, 0x16, 0x94, 0x0f, 0x06, 0x01, 0x0d,
-	0x43, 0xd6, 0xeb, 0x54, 0x3f, 0x96, 0xbd, 0x4e, 0xf5, 0x63, 0xed, 0x01, 0x14, 0x8e, 0xbc, 0x2e,
-	0x59, 0x87, 0xbc, 0xdd, 0xe3, 0xf2, 0xdd, 0xf2, 0xe5, 0xa7, 0x76, 0xfe, 0x70, 0x5f, 0xcf, 0xdb,
-	0x3d, 0xed, 0x04, 0x2a, 0x27, 0x34, 0x38, 0xb7, 0x2d, 0x4a, 0x3e, 0x87, 0x45, 0xdb, 0x8d, 0x68,
-	0xe0, 0x9a, 0x8e, 0xe1, 0x7b, 0x41, 0x84, 0xda, 0x25, 0xbd, 0x2e, 0x85, 0x1d, 0x2f, 0x88, 0x98,
-	0x12, 0xfd, 0x21, 0xa9, 0x94, 0xe7, 0x4a, 0x52, 0xc8, 0x94, 0xb4, 0xff, 0xc8, 0x81, 0xf2, 0x2a,
-	0xf2, 0x86, 0x87, 0xae, 0x3f, 0xca, 0xbe, 0x43, 0x04, 0x8a, 0x01, 0xf5, 0x3d, 0xb1, 0x15, 0x2c,
-	0x93, 0x75, 0x28, 0x77, 0x03, 0xd3, 0xb5, 0xce, 0xe4, 0xbd, 0xe1, 0x35, 0x26, 0xb7, 0xbc, 0xe1,
-	0xd0, 0x8e, 0xc4, 0xd5, 0x11, 0x35, 0x36, 0xc6, 0xc0, 0xf1, 0xba, 0x6a, 0x89, 0x8f, 0xc1, 0xca,
-	0x4c, 0xe6, 0x98, 0x3f, 0x5e, 0xa8, 0x65, 0x3c, 0x04, 0x2c, 0x93, 0x36, 0xd4, 0xfa, 0x81, 0x37,
-	0x34, 0xc4, 0x20, 0x15, 0x54, 0x07, 0x26, 0xda, 0xe3, 0x03, 0xb5, 0xa1, 0x86, 0xae, 0x66, 0xf4,
-	0x6d, 0x87, 0x86, 0x6a, 0x15, 0xfb, 0x02, 0x8a, 0x5e, 0x33, 0x89, 0xf6, 0xb7, 0x39, 0x50, 0xf6,
-	0x02, 0xcf, 0x9d, 0x7b, 0x3f, 0x62, 0xca, 0xc2, 0xe4, 0xba, 0x43, 0x9f, 0x5a, 0x62, 0x37, 0x58,
-	0x26, 0x5f, 0xb1, 0x1b, 0x68, 0x06, 0x11, 0x6e, 0xa6, 0xb6, 0xd3, 0xda, 0xe6, 0xde, 0xbc, 0x2d,
-	0xbd, 0x79, 0xfb, 0x9d, 0x74, 0x77, 0x9d, 0x2b, 0x6a, 0x36, 0x54, 0xdf, 0xd8, 0xd1, 0xd5, 0x2b,
-	0xba, 0x07, 0x85, 0x51, 0xe0, 0xf0, 0x05, 0xed, 0x56, 0x2e, 0x3f, 0xb5, 0xd9, 0x6d, 0xd0, 0x99,
-	0x6c, 0x5e, 0x43, 0x6b, 0xbf, 0xcb, 0x41, 0x89, 0x4f, 0xa4, 0x41, 0xd1, 0x8c, 0xbc, 0x21, 0x4e,
-	0x54, 0xdb, 0x69, 0xa0, 0x33, 0xc5, 0x07, 0xad, 0x63, 0x1b, 0xd9, 0x82, 0x92, 0x15, 0x78, 0x61,
-	0x88, 0x2e, 0x5b, 0xdb, 0x01, 0x54, 0xe2, 0x0a, 0xbc, 0x81, 0x69, 0x8c, 0x5c, 0xdb, 0x73, 0x85,
-	0x0b, 0xa7, 0x34, 0xb0, 0x81, 0xcd, 0x63, 0x05, 0x9e, 0x8b, 0xeb, 0x90, 0xf3, 0xc4, 0x07, 0xa0,
-	0x63, 0x1b, 0x69, 0x43, 0x61, 0x60, 0x4b, 0x83, 0x2d, 0xa2, 0x8a, 0x34, 0x88, 0xce, 0x5a, 0xb4,
-	0x0f, 0x50, 0x3d, 0xf2, 0xba, 0x7c, 0xe1, 0x9f, 0xc7, 0x5b, 0xe3, 0x4b, 0xaf, 0x6d, 0x33, 0xb4,
-	0xe3, 0xe7, 0x3f, 0x75, 0xa1, 0xf2, 0x19, 0x17, 0xaa, 0x90, 0xb8, 0x50, 0xd2, 0xdc, 0xc5, 0xb1,
-	0xb9, 0xb5, 0x53, 0x58, 0xea, 0x98, 0x81, 0xe9, 0x38, 0xd4, 0xb1, 0xc3, 0xe1, 0x09, 0x3b, 0xd3,
-	0x16, 0x54, 0x2d, 0xcf, 0x0d, 0x23, 0xd3, 0xe5, 0x5e, 0x52, 0xd4, 0xe3, 0x3a, 0xd9, 0x82, 0x9a,
-	0xe5, 0xd1, 0x7e, 0xdf, 0xb6, 0x18, 0xfc, 0xe2, 0xe8, 0x39, 0x3d, 0x29, 0x3a, 0x2a, 0x56, 0x73,
-	0xcd, 0xbc, 0xf6, 0x02, 0x14, 0xdc, 0x00, 0xbb, 0x87, 0x6c, 0x5e, 0x84, 0x5c, 0x31, 0x2f, 0x2b,
-	0x33, 0xd9, 0x99, 0x19, 0x9e, 0xa1, 0x19, 0xea, 0x3a, 0x96, 0xb5, 0x3f, 0x85, 0xd2, 0xbe, 0x19,
-	0x8d, 0x86, 0x57, 0x39, 0x3d, 0x69, 0x41, 0xe1, 0xbd, 0xd8, 0x67, 0x6d, 0xa7, 0x8a, 0xa6, 0x3b,
-	0xf2, 0xba, 0x3a, 0x13, 0x6a, 0xbf, 0xcf, 0x81, 0x82, 0xbd, 0x0f, 0xdd, 0xbe, 0xc7, 0x8e, 0xaa,
-	0xc7, 0x2a, 0xc2, 0x6c, 0xfc, 0xa8, 0xb0, 0x59, 0xe7, 0x0d, 0xe4, 0x11, 0xde, 0xdc, 0x88, 0xa3,
-	0x52, 0x63, 0x67, 0x69, 0xac, 0x71, 0xc2, 0xc4, 0x3a, 0x6f, 0x25, 0x5f, 0x70, 0xb5, 0x10, 0xb7,
-	0x5a, 0xdb, 0x59, 0x46, 0xb5, 0x4e, 0xe0, 0x59, 0x34, 0x0c, 0x99, 0x62, 0xc8, 0x15, 0x43, 0xf2,
-	0x18, 0x14, 0xbf, 0x1f, 0x1a, 0x7c, 0x4c, 0x7e, 0xfe, 0x0a, 0x1e, 0x16, 0x33, 0x81, 0x5e, 0xf5,
-	0xfb, 0xa8, 0x4e, 0xc9, 0x43, 0x28, 0xf6, 0xcc, 0xc8, 0x44, 0xc8, 0xc6, 0xf3, 0x17, 0x2a, 0x6c,
-	0xd9, 0x3a, 0x36, 0x69, 0xff, 0xcc, 0x60, 0x68, 0x30, 0x08, 0xe8, 0x80, 0x75, 0x58, 0x85, 0x92,
-	0xc5, 0x82, 0x14, 0x6e, 0xa5, 0xa0, 0xf3, 0x0a, 0xb3, 0xdf, 0x90, 0x9a, 0x2e, 0xae, 0x3e, 0xa7,
-	0x63, 0x99, 0xf9, 0x41, 0x18, 0xf5, 0x7a, 0xf4, 0x5c, 0x9c, 0x8b, 0xa8, 0x91, 0xa7, 0xd0, 0xec,
-	0xdb, 0xfd, 0xe8, 0xcc, 0xf0, 0x69, 0x60, 0x51, 0x37, 0xb2, 0x1d, 0xbe, 0xc2, 0x9c, 0xbe, 0x84,
-	0xf2, 0x4e, 0x2c, 0x26, 0x2f, 0x61, 0xc3, 0xb5, 0x5d, 0x8a, 0x98, 0x32, 0xd1, 0xa3, 0x84, 0x3d,
-	0xd6, 0x78, 0xf3, 0xeb, 0x74, 0x3f, 0xed, 0xb7, 0x79, 0xa8, 0x27, 0xad, 0x42, 0xbe, 0x85, 0xc5,
-	0x9e, 0xf7, 0xd1, 0x75, 0x3c, 0xb3, 0x67, 0xb0, 0x90, 0x2f, 0x0e, 0xe2, 0xde, 0x14, 0x40, 0xec,
-	0x8b, 0x70, 0xaf, 0xd7, 0xa5, 0x3e, 0x83, 0x0c, 0xf2, 0x0d, 0xd4, 0x7d, 0x3e, 0x1e, 0xef, 0x9e,
-	0xbf, 0xae, 0x7b, 0x4d, 0xa8, 0x63, 0xef, 0xaf, 0xa1, 0x36, 0xf2, 0xc7, 0x73, 0x17, 0xae, 0xeb,
-	0x0c, 0x5c, 0x1b, 0xfb, 0x3e, 0x82, 0x46, 0xbc, 0xf2, 0xee, 0x45, 0x44, 0x43, 0xb4, 0x55, 0x51,
-	0x8f, 0xf7, 0xb3, 0xcb, 0x84, 0xe4, 0x21, 0xd4, 0xc5, 0x14, 0x5c, 0xa9, 0x84, 0x4a, 0x62, 0x5a,
-	0x54, 0xd1, 0xfe, 0x21, 0x0f, 0x6b, 0xf1, 0x39, 0xa6, 0xac, 0xf3, 0x22, 0xdb, 0x3a, 0x02, 0x98,
-	0x64, 0x97, 0x09, 0x93, 0xfc, 0x2c, 0xd3, 0x24, 0x93, 0x7d, 0x52, 0x76, 0x78, 0x9e, 0x65, 0x87,
-	0xc9, 0x1e, 0xc9, 0xcd, 0xff, 0x51, 0xe6, 0xe6, 0xa7, 0xfb, 0x4c, 0x18, 0xe3, 0x67, 0x19, 0xc6,
-	0xc8, 0x58, 0x5a, 0xd2, 0x38, 0xff, 0x97, 0x83, 0xfa, 0x5f, 0x7a, 0xc1, 0x07, 0x1a, 0x30, 0x93,
-	0x8c, 0x42, 0xf2, 0x14, 0x94, 0x8f, 0x58, 0x37, 0x62, 0xdf, 0xaf, 0x5f, 0x7e, 0x6a, 0x57, 0xb9,
-	0xd2, 0xe1, 0xbe, 0x5e, 0xe5, 0xcd, 0x87, 0x3d, 0xb2, 0x05, 0xe5, 0xf7, 0x5e, 0x97, 0xe9, 0xf1,
-	0x30, 0xa1, 0x5c, 0x7e, 0x6a, 0x97, 0x18, 0x66, 0xee, 0xeb, 0xa5, 0xf7, 0x5e, 0xf7, 0xb0, 0xc7,
-	0x80, 0x18, 0xbd, 0x8c, 0x23, 0x75, 0x63, 0x8c, 0xd4, 0xe8, 0x8d, 0xd8, 0x46, 0x7e, 0x0e, 0x15,
-	0x0c, 0x49, 0xb4, 0x27, 0x36, 0x39, 0x2b, 0x7a, 0x49, 0xd5, 0x31, 0x20, 0x94, 0xae, 0x01, 0x84,
-	0x07, 0x00, 0xbf, 0x19, 0xd1, 0x11, 0x35, 0x42, 0xfb, 0x47, 0x8a, 0x81, 0xbd, 0xa0, 0x2b, 0x28,
-	0x39, 0xb1, 0x7f, 0xa4, 0xda, 0x11, 0xd4, 0x75, 0x1a, 0x7a, 0xa3, 0xc0, 0xa2, 0x88, 0xba, 0x8c,
-	0x2f, 0xfa, 0x23, 0xdc, 0x78, 0x5e, 0x67, 0x45, 0xe6, 0xce, 0x43, 0x3a, 0xf4, 0x82, 0x0b, 0x01,
-	0xec, 0xa2, 0xc6, 0x34, 0x07, 0xfe, 0x08, 0x0f, 0xb3, 0xa0, 0xb3, 0xa2, 0xf6, 0x2f, 0x35, 0xa8,
-	0x60, 0xc8, 0xe8, 0x7b, 0x12, 0x23, 0x73, 0x19, 0x18, 0x49, 0xbe, 0x04, 0x25, 0x92, 0x8c, 0x33,
-	0x75, 0x7d, 0x62, 0x1e, 0xaa, 0x8f, 0x15, 0xc8, 0x53, 0xa8, 0xfa, 0xb6, 0x4f, 0x1d, 0xdb, 0x95,
-	0x37, 0x87, 0x47, 0xab, 0x8e, 0x10, 0xea, 0x71, 0x33, 0xf9, 0x02, 0xc0, 0x37, 0x03, 0xea, 0x46,
-	0x06, 0x9b, 0xbb, 0x3c, 0x31, 0xb7, 0xc2, 0xdb, 0x18, 0x9d, 0x4b, 0xd8, 0xbc, 0x72, 0x73, 0x9b,
-	0xbf, 0x84, 0x6a, 0xdf, 0x76, 0xed, 0xf0, 0x8c, 0xf6, 0x90, 0xe5, 0xcc, 0xee, 0x16, 0xeb, 0x92,
-	0xaf, 0x60, 0xd1, 0x1b, 0x45, 0xfe, 0x28, 0x92, 0x1c, 0x4a, 0x99, 0x0e, 0xa2, 0x75, 0xae, 0x21,
-	0x28, 0xd5, 0xe7, 0x32, 0x2a, 0x00, 0x46, 0x85, 0x45, 0xb9, 0x87, 0x54, 0x4c, 0xf8, 0x0e, 0x9a,
-	0xfe, 0x38, 0x66, 0x1a, 0x48, 0x8a, 0xea, 0x38, 0xf2, 0x2a, 0x37, 0x50, 0x3a, 0xa0, 0xea, 0x4b,
-	0xfe, 0x44, 0x84, 0x7d, 0x0a, 0x4d, 0x69, 0x3a, 0xe3, 0x9c, 0x06, 0x21, 0xe3, 0x14, 0x8b, 0x88,
-	0x1f, 0x4b, 0x52, 0xfe, 0x6b, 0x2e, 0x26, 0x8f, 0x59, 0x26, 0x80, 0x3c, 0x57, 0x6d, 0xe0, 0x14,
-	0x75, 0x91, 0x09, 0xa0, 0x4c, 0x97, 0x8d, 0x8c, 0x28, 0x50, 0xa4, 0xd2, 0xea, 0x92, 0xdc, 0xa3,
-	0x1f, 0x6e, 0x73, 0x76, 0xad, 0x8b, 0x26, 0x46, 0x82, 0x85, 0x3d, 0x04, 0x8f, 0x5a, 0xc6, 0x8b,
-	0x25, 0x4c, 0xb0, 0xcb, 0xd9, 0xd4, 0x33, 0xa8, 0x09, 0x25, 0x64, 0x86, 0x24, 0x11, 0xca, 0x74,
-	0xea, 0x7b, 0x3a, 0xf0, 0x56, 0x56, 0x26, 0x2a, 0x54, 0x02, 0xca, 0x09, 0xe0, 0x2a, 0xae, 0x5f,
-	0x56, 0x11, 0x45, 0xcd, 0xc8, 0x34, 0x04, 0x1a, 0xd1, 0x9e, 0xba, 0x8e, 0xf7, 0x75, 0x91, 0x49,
-	0x3b, 0x52, 0xc8, 0x9c, 0x04, 0xd5, 0x22, 0x2f, 0x32, 0x1d, 0x75, 0x83, 0x3b, 0x09, 0x93, 0xbc,
-	0x63, 0x02, 0xf2, 0x12, 0x16, 0x05, 0x26, 0x84, 0x08, 0x12, 0xaa, 0x8a, 0xfe, 0xcc, 0x9d, 0x2e,
-	0x89, 0x1e, 0x7a, 0xfd, 0x63, 0x12, 0x4b, 0xbe, 0x85, 0xe5, 0x40, 0x38, 0x97, 0x11, 0xd0, 0xdf,
-	0x8c, 0x68, 0x18, 0x85, 0xea, 0xbd, 0x84, 0xc3, 0x26, 0x5d, 0x4f, 0x6f, 0x4a, 0x5d, 0x5d, 0xa8,
-	0x32, 0xfa, 0x60, 0x33, 0xb4, 0x50, 0x5b, 0x09, 0xfa, 0x20, 0x98, 0x1e, 0x36, 0x90, 0x6d, 0x00,
-	0x97, 0x7e, 0x94, 0x76, 0xbc, 0x8f, 0x6a, 0x4b, 0x68, 0x24, 0x6e, 0x46, 0x0c, 0xe7, 0x8a, 0x4b,
-	0x3f, 0x0a, 0xab, 0x6e, 0x41, 0xcd, 0x76, 0xad, 0x80, 0x0e, 0xa9, 0xcb, 0x76, 0xfa, 0x19, 0xd2,
-	0xb2, 0xa4, 0x88, 0x6c, 0x43, 0x1d, 0x81, 0x43, 0xde, 0xd5, 0x07, 0xd3, 0x77, 0xb5, 0x86, 0x0a,
-	0xe2, 0xaa, 0x3e, 0x84, 0x3a, 0x9a, 0x2e, 0xfc, 0x60, 0xfb, 0x3e, 0xed, 0xa9, 0x9b, 0x68, 0xbc,
-	0x1a, 0x93, 0x9d, 0x70, 0xd1, 0x18, 0xab, 0xda, 0xd7, 0x60, 0xd5, 0x43, 0xa8, 0x53, 0xd7, 0xec,
-	0x3a, 0xd4, 0xe0, 0xfa, 0x5b, 0x7c, 0x79, 0x5c, 0xc6, 0x43, 0x16, 0x63, 0xff, 0xa6, 0x13, 0xa9,
-	0x0f, 0x05, 0xfb, 0x37, 0x9d, 0x88, 0x51, 0x93, 0xae, 0x19, 0x59, 0x67, 0xaa, 0xc6, 0x73, 0x47,
-	0xac, 0x30, 0xdc, 0x0a, 0xa8, 0x19, 0x7a, 0xae, 0xfa, 0x39, 0xc7, 0x2d, 0x5e, 0x23, 0x5f, 0xc3,
-	0x52, 0x7c, 0x28, 0x8e, 0x3d, 0xb4, 0xa3, 0x50, 0xfd, 0xc9, 0x55, 0x47, 0xd2, 0x90, 0x9a, 0xc7,
-	0xa8, 0x48, 0x7e, 0x0a, 0x60, 0x9d, 0x8d, 0xdc, 0x0f, 0xdc, 0xd9, 0x1e, 0x25, 0xe9, 0x35, 0x13,
-	0x63, 0x1f, 0xc5, 0x92, 0x45, 0x64, 0x1f, 0x8c, 0xca, 0x61, 0xd8, 0xf3, 0x46, 0x91, 0xfa, 0xf8,
-	0x7a, 0xf6, 0xc1, 0xf4, 0xdf, 0x71, 0x75, 0xc6, 0x1f, 0x58, 0x80, 0x91, 0xbd, 0xbf, 0xb8, 0x96,
-	0x3f, 0xbc, 0xf7, 0xba, 0xb2, 0x6f, 0x1b, 0xf0, 0x0c, 0x8c, 0xbe, 0x69, 0x3b, 0xb4, 0xa7, 0x3e,
-	0xc1, 0x63, 0xc1, 0x5b, 0xfe, 0x1a, 0x25, 0x47, 0xc5, 0x6a, 0xb1, 0x59, 0x3a, 0x2a, 0x56, 0x4b,
-	0xcd, 0xb2, 0xb6, 0x0f, 0x65, 0x7e, 0x8d, 0x33, 0x73, 0xa1, 0xc7, 0x69, 0x8e, 0xda, 0x9c, 0xb8,
-	0xf6, 0x12, 0x90, 0xb4, 0x17, 0x22, 0x63, 0xe8, 0x7b, 0x21, 0xf9, 0x02, 0xaa, 0x18, 0x1b, 0xdd,
-	0xbe, 0xa7, 0xe6, 0xd0, 0x5b, 0xea, 0x12, 0xc4, 0xf0, 0x4e, 0x56, 0xde, 0xf3, 0x82, 0xb6, 0x09,
-	0x55, 0x89, 0xe4, 0x59, 0x93, 0x6b, 0xff, 0x94, 0x83, 0x45, 0xa9, 0xc0, 0x93, 0x91, 0x07, 0x22,
-	0x59, 0xcc, 0x4d, 0x42, 0xc2, 0x64, 0x1e, 0x9c, 0x4f, 0xa5, 0x67, 0x32, 0x3d, 0x29, 0x64, 0xa4,
-	0x27, 0xc5, 0x8c, 0xf4, 0xa4, 0x94, 0xb0, 0x40, 0x1b, 0x8a, 0x2c, 0xe1, 0x15, 0x21, 0x25, 0xe5,
-	0x0c, 0xd8, 0xa0, 0xfd, 0x0e, 0xa0, 0x3e, 0x5e, 0x65, 0xdf, 0x4b, 0x45, 0xad, 0xdc, 0xec, 0xa8,
-	0x35, 0x5f, 0x38, 0xfc, 0x13, 0x00, 0x2b, 0xa0, 0x66, 0x44, 0x7b, 0x86, 0x19, 0x89, 0x05, 0xcd,
-	0x0a, 0x43, 0x8a, 0xd0, 0x7e, 0x15, 0x91, 0x27, 0xf2, 0x1c, 0x2b, 0x78, 0x8e, 0x24, 0xb5, 0xa0,
-	0x54, 0x68, 0x79, 0x08, 0xf5, 0x80, 0x32, 0x52, 0x6d, 0xd0, 0x20, 0xf0, 0x02, 0x8c, 0x76, 0x8a,
-	0x5e, 0xe3, 0xb2, 0x03, 0x26, 0x22, 0xdf, 0x01, 0xbb, 0x6d, 0x06, 0xa6, 0x01, 0xfc, 0x49, 0xa6,
-	0xb6, 0xb3, 0x95, 0x1a, 0x91, 0xd9, 0x81, 0x9d, 0xf7, 0x1e, 0xaa, 0xf0, 0x67, 0x25, 0xe5, 0xbd,
-	0xac, 0x67, 0x86, 0x2f, 0x98, 0x27, 0x7c, 0xa9, 0x50, 0x91, 0x51, 0xab, 0xc6, 0x51, 0x5f, 0x54,
-	0x6f, 0x19, 0x85, 0x9a, 0x19, 0x51, 0x88, 0xa7, 0x80, 0xcb, 0x53, 0x29, 0xe0, 0xf7, 0xb0, 0x1a,
-	0x5a, 0xa6, 0x43, 0x0d, 0x46, 0x40, 0x8d, 0xe8, 0x2c, 0xa0, 0xe1, 0x99, 0xe7, 0xf4, 0x44, 0x98,
-	0x9a, 0xe1, 0xa2, 0x04, 0xbb, 0xed, 0x7b, 0x1f, 0xdd, 0x77, 0xb2, 0x53, 0x76, 0x98, 0x58, 0xb9,
-	0x45, 0x98, 0x58, 0xbd, 0x2a, 0x4c, 0x6c, 0x41, 0xad, 0x47, 0x43, 0x2b, 0xb0, 0x7d, 0xb6, 0x08,
-	0x75, 0x8d, 0x1f, 0x67, 0x42, 0x34, 0x19, 0x18, 0xd6, 0xa7, 0x03, 0xc3, 0x03, 0x00, 0xcb, 0xb4,
-	0xce, 0x04, 0x91, 0xdc, 0xe0, 0xef, 0x96, 0x28, 0x61, 0x44, 0x72, 0x0a, 0xbb, 0xd5, 0xab, 0xb1,
-	0xfb, 0x5e, 0x02, 0xbb, 0x37, 0xd9, 0xa8, 0xbe, 0xd9, 0xb5, 0x1d, 0x3b, 0xba, 0xc0, 0x38, 0xa7,
-	0xe8, 0x09, 0xc9, 0x18, 0xdb, 0xef, 0x67, 0x63, 0xfb, 0x67, 0x29, 0x6c, 0xff, 0x09, 0x34, 0x86,
-	0xe6, 0x0f, 0x46, 0x82, 0xf0, 0x3e, 0x40, 0xdc, 0xab, 0x0f, 0xcd, 0x1f, 0xfe, 0x42, 0x72, 0xde,
-	0x24, 0x99, 0xd9, 0x9c, 0x45, 0x66, 0x32, 0x22, 0x45, 0xfb, 0x76, 0x91, 0x62, 0x6b, 0xee, 0x48,
-	0xf1, 0xf0, 0x4e, 0x91, 0x42, 0x9b, 0x27, 0x52, 0x3c, 0x87, 0xda, 0xc0, 0x8e, 0xce, 0x3c, 0xef,
-	0x83, 0x31, 0x0a, 0x1c, 0x1e, 0x2d, 0x77, 0x1b, 0x97, 0x9f, 0xda, 0xf0, 0x86, 0x8b, 0x4f, 0xf5,
-	0x63, 0x1d, 0x84, 0xca, 0x69, 0xe0, 0xb4, 0xbe, 0x81, 0x46, 0xda, 0xad, 0x93, 0xaf, 0xad, 0xa5,
-	0x8c, 0xd7, 0xd6, 0x52, 0xe2, 0xb5, 0xf5, 0xa8, 0x58, 0x2d, 0x34, 0x8b, 0x3c, 0xfa, 0x68, 0x6f,
-	0x92, 0xd8, 0xce, 0xc2, 0xc6, 0x4b, 0x58, 0x8c, 0x29, 0x69, 0x22, 0x76, 0x2c, 0x4f, 0x01, 0x8b,
-	0x5e, 0xf7, 0x13, 0x35, 0xed, 0xb7, 0x15, 0x68, 0xee, 0x21, 0xd0, 0x31, 0xa6, 0xcf, 0x1d, 0x23,
-	0x0d, 0xac, 0xb9, 0x79, 0xf2, 0x8c, 0xfc, 0x6c, 0xc4, 0xce, 0x82, 0xae, 0xca, 0x3c, 0xd0, 0x95,
-	0xb8, 0x81, 0xd5, 0x9b, 0xd1, 0x69, 0xe5, 0x6a, 0x20, 0xcb, 0xa2, 0xf1, 0x90, 0x4d, 0xe3, 0xa7,
-	0x30, 0xaf, 0x76, 0x3d, 0xf3, 0xae, 0xcf, 0x62, 0xde, 0xe9, 0x8c, 0x6b, 0xf1, 0xea, 0x8c, 0x2b,
-	0x13, 0xe3, 0x1a, 0xb7, 0xc0, 0xb8, 0xa5, 0x9b, 0x51, 0xe1, 0xe6, 0xbc, 0x54, 0x78, 0x79, 0x1a,
-	0xf1, 0x26, 0x21, 0x8d, 0x5c, 0x0d, 0x69, 0x2b, 0x59, 0x74, 0x74, 0x35, 0x09, 0x59, 0x19, 0x60,
-	0xb2, 0x76, 0x3b, 0x30, 0x59, 0x9f, 0x1b, 0x4c, 0x36, 0xee, 0x04, 0x26, 0xea, 0x1c, 0x60, 0x92,
-	0xf2, 0xee, 0x0e, 0x2c, 0x1f, 0xba, 0x6c, 0xc9, 0x51, 0xc2, 0x29, 0x67, 0x3d, 0x0c, 0xb4, 0xa1,
-	0xd6, 0x75, 0x3c, 0xeb, 0x83, 0x31, 0xa6, 0x9b, 0x55, 0x1d, 0x50, 0x84, 0xf4, 0x44, 0xfb, 0xc7,
-	0x1c, 0x34, 0x8e, 0xed, 0x30, 0x39, 0xde, 0x1c, 0x44, 0x6b, 0x1b, 0xea, 0x78, 0x55, 0x64, 0x6a,
-	0x93, 0x97, 0xdf, 0xb4, 0x12, 0xa9, 0x0d, 0x2a, 0x88, 0xd4, 0x66, 0x2a, 0x6f, 0x2f, 0x5c, 0x93,
-	0xb7, 0x6b, 0xdb, 0xd0, 0xdc, 0xa7, 0x0e, 0x4d, 0xa1, 0xd0, 0x8c, 0x0d, 0x6b, 0x5f, 0x42, 0xe3,
-	0x24, 0xf2, 0xfc, 0x1b, 0x6a, 0xff, 0x4f, 0x0e, 0x1a, 0x6f, 0x68, 0x74, 0xec, 0x0d, 0xc2, 0x9b,
-	0x58, 0x73, 0x0e, 0x40, 0x93, 0x49, 0x5c, 0xdf, 0x76, 0x22, 0x1a, 0x84, 0xf8, 0x5e, 0xa5, 0xf0,
-	0x24, 0xee, 0x35, 0x17, 0xe1, 0x33, 0x90, 0x19, 0x46, 0x34, 0x40, 0x62, 0x5c, 0xd5, 0x45, 0x6d,
-	0xfc, 0xc4, 0x5d, 0xbe, 0xea, 0x89, 0x7b, 0x1d, 0xca, 0x7d, 0xcf, 0x71, 0xbc, 0x8f, 0xe2, 0xdb,
-	0x9e, 0xa8, 0x31, 0xdf, 0x89, 0x4c, 0xdb, 0x41, 0x04, 0x2c, 0xe8, 0x58, 0x16, 0x17, 0xe7, 0x5f,
-	0xf3, 0x00, 0xc7, 0xde, 0xe0, 0xcf, 0x69, 0x18, 0x9a, 0x03, 0xfc, 0xb2, 0x16, 0x03, 0x5c, 0x22,
-	0x3f, 0x88, 0x23, 0xc0, 0x5b, 0x46, 0xd1, 0xc7, 0x8f, 0x71, 0x85, 0x6b, 0x1e, 0xe3, 0x8a, 0x33,
-	0x1e, 0xe3, 0x9e, 0x41, 0x3e, 0x7e, 0x53, 0x9b, 0xc5, 0xaa, 0xf3, 0x51, 0xc8, 0xf8, 0xe7, 0x90,
-	0xaf, 0x10, 0xf7, 0xae, 0xe8, 0xb2, 0x9a, 0x7e, 0x43, 0xac, 0xcc, 0x7c, 0x43, 0x24, 0x50, 0x1c,
-	0x85, 0x34, 0x10, 0x5f, 0xcd, 0xb0, 0x4c, 0x1e, 0x43, 0x95, 0xfb, 0xaf, 0xdd, 0x43, 0xdc, 0x57,
-	0x76, 0x6b, 0x97, 0x9f, 0xda, 0x15, 0xfe, 0x59, 0x61, 0x5f, 0xaf, 0x60, 0xe3, 0x61, 0x2f, 0x71,
-	0x24, 0x90, 0x3c, 0x12, 0xed, 0x1d, 0xac, 0xe8, 0xfc, 0xfd, 0x83, 0x9f, 0xc3, 0x0d, 0xee, 0xca,
-	0xe4, 0x05, 0xc8, 0x4f, 0x5d, 0x00, 0xed, 0x8f, 0x61, 0x45, 0x78, 0x73, 0x6a, 0xd4, 0x6b, 0x3f,
-	0x71, 0x68, 0x06, 0x34, 0x99, 0xcf, 0xde, 0x78, 0x2d, 0xf7, 0x41, 0xf1, 0xcd, 0x81, 0xe0, 0x6f,
-	0x79, 0xbc, 0x1c, 0x55, 0x26, 0x40, 0xee, 0x86, 0x1f, 0x71, 0x06, 0x54, 0x3c, 0x3b, 0x62, 0x59,
-	0xbb, 0x80, 0xe5, 0xc4, 0x04, 0xa1, 0xef, 0xb9, 0x21, 0xbe, 0x39, 0x0b, 0x23, 0x32, 0x62, 0x21,
-	0x78, 0x44, 0x63, 0xbc, 0x3a, 0x0c, 0x07, 0xd0, 0x93, 0xc5, 0x90, 0x81, 0x0f, 0x3e, 0xff, 0x18,
-	0x6c, 0xcc, 0x50, 0x4c, 0x0c, 0x28, 0xea, 0x30, 0x49, 0xe6, 0xd4, 0x7f, 0x05, 0x1b, 0xf1, 0xd4,
-	0x27, 0x51, 0x40, 0xcd, 0xf1, 0x02, 0x7e, 0x0a, 0x30, 0x5e, 0x40, 0x8a, 0x7e, 0x8c, 0xe7, 0x57,
-	0xe2, 0xf9, 0x6f, 0x37, 0xfd, 0x2e, 0x28, 0x71, 0x04, 0x60, 0xd7, 0xc1, 0x1d, 0x0d, 0xbb, 0x34,
-	0x10, 0x9f, 0x68, 0x44, 0x8d, 0x11, 0x77, 0x66, 0x4a, 0xf1, 0x26, 0xce, 0x07, 0x56, 0x98, 0x84,
-	0xbf, 0x80, 0xff, 0x5b, 0x05, 0xd6, 0x38, 0x75, 0x8a, 0x81, 0x61, 0x7e, 0x68, 0x9d, 0x2f, 0x87,
-	0x5d, 0x87, 0xf2, 0xc8, 0xef, 0x31, 0x88, 0x17, 0x58, 0xc2, 0x6b, 0x77, 0xe7, 0x55, 0x37, 0xe2,
-	0x4b, 0x53, 0x24, 0x08, 0x32, 0x48, 0xd0, 0x55, 0x09, 0x5e, 0xed, 0x0f, 0x96, 0xe0, 0xd5, 0x6f,
-	0x41, 0x7e, 0x16, 0x6f, 0x98, 0xe0, 0x35, 0xae, 0x4d, 0xf0, 0x96, 0xae, 0x4b, 0xf0, 0x9a, 0xd7,
-	0x25, 0x78, 0xcb, 0xd3, 0x6c, 0xe8, 0x33, 0x50, 0x02, 0x2a, 0x9e, 0x5a, 0x05, 0x5b, 0x1a, 0x0b,
-	0xc6, 0xbc, 0x68, 0x25, 0xc9, 0x8b, 0xa6, 0x53, 0xb6, 0xd5, 0xd9, 0x29, 0xdb, 0xda, 0x9c, 0x29,
-	0xdb, 0xfa, 0xed, 0x58, 0xd6, 0xc6, 0xdc, 0x2c, 0x4b, 0xbd, 0x13, 0xcb, 0xba, 0x77, 0x5b, 0x96,
-	0xb5, 0x07, 0xeb, 0x02, 0x97, 0x6f, 0xef, 0xbf, 0xda, 0x1a, 0xac, 0x30, 0x1c, 0x9b, 0x18, 0x41,
-	0xfb, 0xbb, 0x1c, 0xac, 0x71, 0x42, 0x73, 0x07, 0x6c, 0x68, 0xb3, 0xbb, 0xc9, 0xc6, 0x60, 0x39,
-	0x42, 0x28, 0x59, 0x5d, 0x4f, 0xf2, 0xa4, 0x30, 0xa1, 0x80, 0x09, 0x47, 0x21, 0xa9, 0x80, 0x59,
-	0x46, 0x13, 0x0a, 0xa6, 0xe3, 0x88, 0x57, 0x3a, 0x56, 0xd4, 0x5e, 0xc1, 0xea, 0x09, 0x0b, 0x70,
-	0x77, 0xd8, 0xf2, 0x2f, 0x61, 0x85, 0x71, 0xaf, 0x3b, 0x8c, 0xf0, 0x37, 0x39, 0x58, 0xd5, 0x69,
-	0x30, 0x72, 0xef, 0x60, 0x9c, 0x47, 0x50, 0xa1, 0x3f, 0x58, 0xce, 0xa8, 0x47, 0xb3, 0xe8, 0xa8,
-	0x6c, 0x63, 0x6a, 0xb6, 0xcb, 0xd5, 0x0a, 0x19, 0x6a, 0xa2, 0x4d, 0xdb, 0x80, 0xb5, 0x37, 0x66,
-	0xd0, 0x35, 0x07, 0x74, 0xcf, 0x73, 0x1c, 0x6a, 0x45, 0xf2, 0x20, 0x55, 0x58, 0x9f, 0x6c, 0xe0,
-	0x61, 0xea, 0x99, 0x81, 0x8f, 0xb6, 0xfc, 0x4f, 0x01, 0x4d, 0xa8, 0x1f, 0xfd, 0x6a, 0xd7, 0x38,
-	0x79, 0xf7, 0x4a, 0x7f, 0x77, 0xf8, 0xf6, 0x4d, 0x73, 0x81, 0x2c, 0x41, 0x8d, 0x49, 0xf4, 0xd3,
-	0xb7, 0x6f, 0x99, 0x20, 0x27, 0x05, 0xaf, 0x5f, 0x1d, 0x1e, 0x9f, 0xea, 0x07, 0xcd, 0xbc, 0x14,
-	0x9c, 0x9c, 0xee, 0xed, 0x1d, 0x9c, 0x9c, 0x34, 0x0b, 0xa4, 0x01, 0xc0, 0x04, 0xdf, 0x1f, 0x1e,
-	0x1f, 0x1f, 0xec, 0x37, 0x8b, 0xcf, 0x7e, 0x09, 0x30, 0xfe, 0x3f, 0x03, 0x01, 0x28, 0xb3, 0xbe,
-	0x07, 0xfb, 0xcd, 0x05, 0x52, 0x83, 0x8a, 0xec, 0x96, 0xc3, 0xca, 0xf7, 0x87, 0x9d, 0xce, 0xc1,
-	0x7e, 0x33, 0x4f, 0xea, 0x50, 0x8d, 0x17, 0x51, 0x78, 0xf6, 0x1d, 0xd4, 0x12, 0xaf, 0xcd, 0x6c,
-	0xc6, 0xce, 0xaf, 0xf6, 0xe3, 0x35, 0x2d, 0x48, 0xc1, 0x78, 0xac, 0x06, 0x00, 0x13, 0x88, 0x89,
-	0xf2, 0xcf, 0xfe, 0x3a, 0xf1, 0x86, 0xcc, 0xc7, 0x58, 0x83, 0xe5, 0xce, 0x61, 0xe7, 0xe0, 0xf8,
-	0xf0, 0xed, 0x41, 0x72, 0xbb, 0xab, 0xd0, 0x8c, 0xc5, 0xe3, 0x3d, 0x6f, 0xc0, 0xca, 0x58, 0x7a,
-	0x10, 0xab, 0xe7, 0x53, 0xea, 0xd2, 0x22, 0x05, 0xb2, 0x02, 0x4b, 0xb1, 0xb4, 0xf3, 0xea, 0xf4,
-	0x84, 0x59, 0x61, 0xe7, 0x7f, 0x15, 0x28, 0xbc, 0xea, 0x1c, 0x92, 0x6d, 0x50, 0xe2, 0x77, 0x0a,
-	0xb2, 0x26, 0xfe, 0x99, 0x93, 0x7e, 0xb7, 0x68, 0xc5, 0x7c, 0x48, 0x5b, 0x20, 0x3f, 0x07, 0x18,
-	0xe7, 0x50, 0x64, 0x5d, 0x20, 0xff, 0x44, 0x52, 0xd5, 0x4a, 0xbd, 0xad, 0x6b, 0x0b, 0xe4, 0x39,
-	0x54, 0x44, 0x9a, 0x44, 0x56, 0xb0, 0x29, 0x9d, 0x34, 0xb5, 0x16, 0x93, 0xfa, 0xa1, 0xb6, 0x40,
-	0x5e, 0xc2, 0xa2, 0x50, 0xe1, 0x2c, 0x26, 0xbb, 0xdb, 0xc4, 0x34, 0x5f, 0xe5, 0xc8, 0x37, 0xa0,
-	0xc4, 0x09, 0x8f, 0xd8, 0xce, 0x64, 0x02, 0xd4, 0x5a, 0x9f, 0xc2, 0xb4, 0x83, 0xa1, 0x1f, 0x5d,
-	0x68, 0x0b, 0xe4, 0x17, 0x50, 0x11, 0xe9, 0x8f, 0x98, 0x2f, 0x9d, 0x0c, 0xcd, 0xe8, 0xf9, 0x35,
-	0xd4, 0x93, 0x64, 0x94, 0xa8, 0x49, 0xc3, 0x24, 0x99, 0x66, 0x6b, 0x82, 0x72, 0x69, 0x0b, 0x6c,
-	0xcd, 0x31, 0x67, 0x13, 0x6b, 0x9e, 0xe4, 0xa7, 0xad, 0xf5, 0x49, 0x31, 0xf7, 0x16, 0x6d, 0x81,
-	0x1c, 0xc1, 0xd2, 0x04, 0xe3, 0xbb, 0x6a, 0x8c, 0xcf, 0xd2, 0xe2, 0x34, 0x3d, 0x44, 0xeb, 0xed,
-	0xe2, 0xc7, 0xf7, 0x98, 0xa8, 0x8b, 0x5d, 0x64, 0x70, 0xf7, 0x19, 0x96, 0x78, 0x0d, 0x8d, 0x34,
-	0x7b, 0x23, 0xad, 0xc4, 0xad, 0x9a, 0x40, 0xa6, 0x19, 0xe3, 0xec, 0xc1, 0xd2, 0x44, 0x18, 0x21,
-	0xf7, 0x93, 0x46, 0x9d, 0x1c, 0x69, 0xfa, 0x49, 0x4e, 0x5b, 0x20, 0xdf, 0x42, 0x3d, 0x19, 0x46,
-	0xc4, 0x86, 0x32, 0x22, 0x4b, 0x8b, 0x4c, 0x75, 0x0f, 0xf9, 0x66, 0xd2, 0xe1, 0x46, 0x6c, 0x26,
-	0x33, 0x06, 0xcd, 0xd8, 0xcc, 0x3e, 0x2c, 0xa6, 0xc2, 0x03, 0xb9, 0x27, 0xae, 0xd7, 0x74, 0xc8,
-	0x98, 0x31, 0xca, 0x2e, 0xd4, 0x93, 0x11, 0x42, 0xec, 0x26, 0x23, 0x68, 0xcc, 0x5e, 0x49, 0x2a,
-	0x44, 0x88, 0x95, 0x64, 0x85, 0x8d, 0x19, 0xa3, 0xfc, 0x99, 0x74, 0xb3, 0x57, 0x8e, 0x43, 0xae,
-	0x50, 0x9b, 0xd1, 0xfd, 0x05, 0x54, 0xc4, 0xbb, 0x81, 0xf0, 0xb3, 0xf4, 0x2b, 0x42, 0x8b, 0xff,
-	0xeb, 0x6c, 0x9c, 0x71, 0xe3, 0xe5, 0xfc, 0x1e, 0x1a, 0xe9, 0x90, 0x21, 0xce, 0x22, 0x33, 0xc0,
-	0xb4, 0xee, 0x67, 0xb6, 0xc9, 0xbb, 0xbe, 0xdb, 0xfc, 0xfd, 0xe5, 0x66, 0xee, 0x3f, 0x2f, 0x37,
-	0x73, 0xff, 0x75, 0xb9, 0x99, 0xfb, 0xfb, 0xff, 0xde, 0x5c, 0xe8, 0x96, 0x71, 0x95, 0x2f, 0xfe,
-	0x3f, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xb1, 0xbc, 0xd4, 0xd2, 0x2d, 0x00, 0x00,
+	// 4151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1b, 0x59,
+	0x72, 0x17, 0xbf, 0xc9, 0x22, 0x45, 0x51, 0x4f, 0x5f, 0x6d, 0xca, 0x96, 0xe4, 0xf6, 0xd8, 0x63,
+	0x1b, 0x33, 0xf2, 0x8c, 0xbc, 0xf1, 0x6e, 0x9c, 0xc9, 0xcc, 0xea, 0xcb, 0x8e, 0x34, 0x8a, 0x47,
+	0x69, 0xca, 0x9b, 0x53, 0x40, 0x34, 0xc9, 0x47, 0xaa, 0xed, 0x66, 0x77, 0x6f, 0x77, 0x53, 0xb6,
+	0x06, 0x09, 0x90, 0x53, 0xae, 0x41, 0x12, 0x20, 0x08, 0x82, 0x04, 0x08, 0x90, 0x6b, 0x0e, 0xf9,
+	0x03, 0xf2, 0x07, 0xec, 0x29, 0xc8, 0x31, 0x27, 0x23, 0x71, 0x80, 0xfc, 0x09, 0x7b, 0x09, 0x02,
+	0x04, 0x55, 0xef, 0x75, 0xb3, 0x9b, 0x6c, 0x91, 0xa2, 0x95, 0x3d, 0x10, 0xe8, 0x57, 0x55, 0xef,
+	0xab, 0x5e, 0xbd, 0xaa, 0x5f, 0xd5, 0x23, 0x2c, 0xb7, 0x4d, 0x83, 0x5b, 0xfe, 0x13, 0xc7, 0xf1,
+	0xf0, 0xb7, 0xed, 0xb8, 0xb6, 0x6f, 0xb3, 0x8c, 0xe3, 0x78, 0xf5, 0xf5, 0x9e, 0x6d, 0xf7, 0x4c,
+	0xfe, 0x84, 0x48, 0xad, 0x41, 0xf7, 0x09, 0xef, 0x3b, 0xfe, 0xa5, 0x90, 0xa8, 0x6f, 0x8e, 0x32,
+	0x7d, 0xa3, 0xcf, 0x3d, 0x5f, 0xef, 0x3b, 0x52, 0x60, 0x63, 0x54, 0xa0, 0x33, 0x70, 0x75, 0xdf,
+	0xb0, 0x2d, 0xc9, 0x5f, 0xee, 0xd9, 0x3d, 0x9b, 0x3e, 0x9f, 0xe0, 0x57, 0x40, 0x0d, 0x96, 0xd3,
+	0xf5, 0xf0, 0x27, 0xa8, 0x6a, 0x17, 0xf2, 0x0d, 0xde, 0x76, 0xb9, 0xcf, 0x18, 0x64, 0x2d, 0xbd,
+	0xcf, 0x95, 0xd4, 0x56, 0xea, 0x61, 0x49, 0xa3, 0x6f, 0x76, 0x07, 0xa0, 0x6f, 0x0f, 0x2c, 0xbf,
+	0xe9, 0xe8, 0xfe, 0xb9, 0x92, 0x26, 0x4e, 0x89, 0x28, 0xa7, 0xba, 0x7f, 0xce, 0xd6, 0xa0, 0xc0,
+	0xad, 0x8b, 0xe6, 0x85, 0xee, 0x2a, 0x19, 0xe2, 0xe5, 0xb9, 0x75, 0xf1, 0x0b, 0xdd, 0x65, 0x35,
+	0xc8, 0xbc, 0xe5, 0x97, 0x4a, 0x96, 0x88, 0xf8, 0xa9, 0xfe, 0x4f, 0x1a, 0x4a, 0x67, 0xae, 0x6e,
+	0x79, 0x5d, 0xdb, 0xed, 0xb3, 0x65, 0xc8, 0x19, 0x7d, 0xbd, 0x17, 0x4c, 0x26, 0x1a, 0xd8, 0xab,
+	0xdd, 0xef, 0x28, 0xe9, 0xad, 0x0c, 0xf6, 0x6a, 0xf7, 0x3b, 0xec, 0x11, 0x64, 0xb8, 0x75, 0xa1,
+	0x64, 0xb6, 0x32, 0x0f, 0xcb, 0x3b, 0x6b, 0xdb, 0xa8, 0xc5, 0x70, 0x90, 0xed, 0x43, 0xeb, 0xe2,
+	0xd0, 0xf2, 0xdd, 0x4b, 0x0d, 0x65, 0xd8, 0x7d, 0x28, 0x78, 0xb4, 0x11, 0x4f, 0xc9, 0x92, 0x78,
+	0x99, 0xc4, 0xc5, 0xe6, 0xb4, 0x80, 0x87, 0x33, 0x7b, 0x7e, 0xc7, 0xb0, 0x94, 0x1c, 0xcd, 0x22,
+	0x1a, 0xec, 0x0b, 0x60, 0x7a, 0xbb, 0xcd, 0x1d, 0xbf, 0xe9, 0x72, 0x7f, 0xe0, 0x5a, 0xcd, 0xb6,
+	0xdd, 0xe1, 0x4a, 0x7e, 0x2b, 0xf3, 0x30, 0xa3, 0xd5, 0x04, 0x47, 0x23, 0xc6, 0xbe, 0xdd, 0xe1,
+	0x38, 0x46, 0x87, 0xb7, 0x06, 0x3d, 0xa5, 0xb0, 0x95, 0x7a, 0x58, 0xd4, 0x44, 0x03, 0xc7, 0xa0,
+	0x6d, 0x34, 0x9d, 0x81, 0x69, 0x36, 0x83, 0xb5, 0x94, 0x68, 0x9a, 0x1a, 0x71, 0x4e, 0x07, 0xa6,
+	0xd9, 0x90, 0xeb, 0x40, 0xd5, 0xb9, 0x6e, 0x13, 0xf7, 0x0b, 0x24, 0x92, 0xe7, 0xae, 0xbb, 0xdf,
+	0xef, 0xb0, 0x75, 0x28, 0x21, 0x43, 0x2c, 0xb2, 0x4c, 0xac, 0x22, 0x77, 0xdd, 0x06, 0xb6, 0xeb,
+	0xcf, 0xa0, 0x18, 0xec, 0x3a, 0xd0, 0x71, 0x2a, 0xd4, 0x31, 0xae, 0xeb, 0x42, 0x37, 0x07, 0x5c,
+	0x1e, 0x94, 0x68, 0x3c, 0x4f, 0xff, 0x2c, 0xa5, 0xd6, 0x21, 0x7f, 0xd8, 0x73, 0xb9, 0xe7, 0x61,
+	0xaf, 0xd7, 0xda, 0x49, 0xd0, 0xeb, 0xb5, 0x76, 0xa2, 0xde, 0x81, 0xcc, 0xb1, 0xdd, 0x62, 0xab,
+	0x90, 0x36, 0x3a, 0x82, 0xbe, 0x97, 0xff, 0xf8, 0x61, 0x33, 0x7d, 0x74, 0xa0, 0xa5, 0x8d, 0x8e,
+	0xda, 0x80, 0x42, 0x83, 0xbb, 0x17, 0x46, 0x9b, 0xb3, 0x7b, 0x30, 0x6f, 0x58, 0x3e, 0x77, 0x2d,
+	0xdd, 0x6c, 0x3a, 0xb6, 0xeb, 0x93, 0x74, 0x4e, 0xab, 0x04, 0xc4, 0x53, 0xdb, 0xf5, 0x51, 0x88,
+	0xbf, 0x8f, 0x0a, 0xa5, 0x85, 0x10, 0x7f, 0x3f, 0x14, 0x52, 0xef, 0x43, 0xae, 0xe1, 0xd8, 0x03,
+	0x9f, 0xdd, 0x86, 0x92, 0x7d, 0xc1, 0xdd, 0x77, 0xae, 0xe1, 0x0b, 0x63, 0x28, 0x6a, 0x43, 0x82,
+	0xfa, 0x77, 0x29, 0xa8, 0x9d, 0x1a, 0x0e, 0x37, 0x0d, 0x8b, 0x9f, 0xf1, 0xbe, 0x63, 0xea, 0x3e,
+	0x67, 0x75, 0x28, 0xfa, 0xf2, 0x5b, 0x6e, 0x23, 0x6c, 0xb3, 0xa7, 0x90, 0xd5, 0xdd, 0x9e, 0x47,
+	0x26, 0x54, 0xde, 0xd9, 0x24, 0x0b, 0x18, 0x1d, 0x60, 0x7b, 0xd7, 0xed, 0x79, 0xc2, 0x70, 0x48,
+	0xb8, 0xfe, 0x53, 0x28, 0x85, 0xa4, 0x99, 0xb4, 0xfa, 0x0f, 0x69, 0x28, 0xed, 0xfa, 0x76, 0xff,
+	0xc8, 0x72, 0x06, 0xc9, 0xf7, 0x87, 0x41, 0xd6, 0xe5, 0x8e, 0x2d, 0xbb, 0xd2, 0x37, 0x5b, 0x85,
+	0x7c, 0xcb, 0xd5, 0xad, 0xf6, 0x79, 0x70, 0x67, 0x44, 0x0b, 0xe9, 0x6d, 0xbb, 0xdf, 0x37, 0x7c,
+	0x79, 0x6d, 0x64, 0x0b, 0xc7, 0xe8, 0x99, 0x76, 0x4b, 0xc9, 0x89, 0x31, 0xf0, 0x1b, 0x69, 0xa6,
+	0xfe, 0xe3, 0xa5, 0x92, 0x27, 0x8d, 0xd1, 0x37, 0xdb, 0x84, 0x72, 0xd7, 0xb5, 0xfb, 0x4d, 0x39,
+	0x48, 0x81, 0xc4, 0x01, 0x49, 0xfb, 0x62, 0xa0, 0x4d, 0x28, 0x93, 0x9b, 0x69, 0x76, 0x0d, 0x93,
+	0x7b, 0x4a, 0x91, 0xfa, 0x02, 0x91, 0x5e, 0x20, 0x05, 0x6d, 0xf2, 0x8d, 0x6d, 0x58, 0x4d, 0xdb,
+	0x52, 0x4a, 0x62, 0x09, 0xd8, 0xfc, 0xc1, 0x42, 0x37, 0x60, 0x0f, 0x7c, 0xee, 0x36, 0xb1, 0xad,
+	0x80, 0x3c, 0x26, 0xa4, 0x1c, 0xdb, 0x86, 0xc5, 0x6e, 0x41, 0xb1, 0xe7, 0xda, 0x03, 0xa7, 0xd9,
+	0xba, 0x54, 0xca, 0xd4, 0xb1, 0x40, 0xed, 0xbd, 0x4b, 0xf5, 0x2f, 0x52, 0x50, 0xda, 0x77, 0x6d,
+	0x6b, 0x66, 0x15, 0xc9, 0x5d, 0x64, 0x46, 0x55, 0xe1, 0x39, 0xbc, 0x2d, 0x15, 0x44, 0xdf, 0xec,
+	0x2b, 0xbc, 0xd0, 0xba, 0xeb, 0x93, 0x7e, 0xca, 0x3b, 0xf5, 0x6d, 0xe1, 0x1c, 0xb7, 0x03, 0xe7,
+	0xb8, 0x7d, 0x16, 0x78, 0x4f, 0x4d, 0x08, 0xaa, 0x06, 0x14, 0x5f, 0x1a, 0xfe, 0xd5, 0x2b, 0xba,
+	0x05, 0x99, 0x81, 0x6b, 0x8a, 0x05, 0xed, 0x15, 0x3e, 0x7e, 0xd8, 0xc4, 0x6b, 0xa2, 0x21, 0x6d,
+	0xd6, 0xb3, 0x53, 0x7f, 0x9d, 0x82, 0x9c, 0x98, 0x48, 0x85, 0xac, 0xee, 0xdb, 0x7d, 0x9a, 0xa8,
+	0xbc, 0x53, 0x25, 0xcb, 0x0c, 0x6d, 0x47, 0x23, 0x1e, 0xdb, 0x82, 0x5c, 0xdb, 0xb5, 0xbd, 0xc0,
+	0x7c, 0x81, 0x84, 0x84, 0x80, 0x60, 0xa0, 0xc4, 0xc0, 0x32, 0x6c, 0x4b, 0xc9, 0x8c, 0x4b, 0x10,
+	0x03, 0xe7, 0x69, 0xbb, 0xb6, 0xa5, 0x64, 0x23, 0xf3, 0x84, 0x07, 0xa0, 0x11, 0x8f, 0x6d, 0x42,
+	0xa6, 0x67, 0x04, 0x0a, 0x9b, 0x27, 0x91, 0x40, 0x21, 0x1a, 0x72, 0xd8, 0x06, 0x64, 0xe9, 0xa4,
+	0xf3, 0x63, 0xb3, 0x10, 0x1d, 0x97, 0x41, 0x07, 0xac, 0x14, 0xc6, 0x97, 0x41, 0x0c, 0xf5, 0x2d,
+	0x14, 0x8f, 0xed, 0x96, 0xd8, 0xfa, 0xbd, 0x50, 0x39, 0x62, 0xf3, 0xe5, 0x6d, 0x0c, 0x3f, 0xc2,
+	0x28, 0xc7, 0xac, 0x3c, 0x9d, 0x60, 0xe5, 0x99, 0x88, 0x95, 0x07, 0x07, 0x96, 0x1d, 0x1e, 0x98,
+	0xfa, 0x1a, 0x16, 0x4e, 0x75, 0x57, 0x37, 0x4d, 0x6e, 0x1a, 0x5e, 0xbf, 0x81, 0x56, 0x51, 0x87,
+	0x62, 0xdb, 0xb6, 0x3c, 0x5f, 0xb7, 0x84, 0x03, 0xca, 0x6a, 0x61, 0x9b, 0x6d, 0x41, 0xb9, 0x6d,
+	0xf3, 0x6e, 0xd7, 0x68, 0x63, 0x3c, 0xa4, 0xd1, 0x53, 0x5a, 0x94, 0x74, 0x9c, 0x2d, 0xa6, 0x6a,
+	0x69, 0xf5, 0x29, 0x94, 0x68, 0x03, 0x78, 0x39, 0x70, 0x5e, 0x8a, 0x81, 0x72, 0x5e, 0xfc, 0x46,
+	0xda, 0xb9, 0xee, 0x9d, 0x93, 0x22, 0x2b, 0x1a, 0x7d, 0xab, 0xbf, 0x03, 0xb9, 0x03, 0xdd, 0x1f,
+	0xf4, 0xaf, 0xf2, 0xa7, 0xac, 0x0e, 0x99, 0x37, 0x72, 0x9f, 0xe5, 0x9d, 0x22, 0x69, 0xee, 0xd8,
+	0x6e, 0x69, 0x48, 0x54, 0x7f, 0x95, 0x82, 0x12, 0xf5, 0x3e, 0xb2, 0xba, 0x36, 0x6a, 0xb9, 0x83,
+	0x0d, 0xa9, 0x36, 0xa1, 0x65, 0x62, 0x6b, 0x82, 0xc1, 0xee, 0x93, 0xed, 0xfb, 0xc2, 0x35, 0x55,
+	0x77, 0x16, 0x86, 0x12, 0x0d, 0x24, 0x6b, 0x82, 0xcb, 0x3e, 0x17, 0x62, 0x1e, 0x6d, 0xb5, 0xbc,
+	0xb3, 0x28, 0xdc, 0xa2, 0x6b, 0xb7, 0xb9, 0xe7, 0xa1, 0xa0, 0x27, 0x04, 0x3d, 0xf6, 0x00, 0x4a,
+	0x4e, 0xd7, 0x6b, 0x8a, 0x31, 0x85, 0x05, 0x95, 0xe8, 0xb0, 0x50, 0x05, 0x5a, 0xd1, 0xe9, 0x92,
+	0x38, 0x67, 0x77, 0x21, 0xdb, 0xd1, 0x7d, 0x9d, 0x62, 0x28, 0x59, 0x90, 0x14, 0xc1, 0x65, 0x6b,
+	0xc4, 0x52, 0xff, 0x39, 0x05, 0xa5, 0xdd, 0x5e, 0xcf, 0xe5, 0x3d, 0xec, 0xb0, 0x0c, 0xb9, 0x36,
+	0xa2, 0x06, 0xda, 0x4a, 0x46, 0x13, 0x0d, 0xd4, 0x5f, 0x9f, 0xeb, 0x16, 0xad, 0x3e, 0xa5, 0xd1,
+	0x37, 0xde, 0x24, 0xcf, 0xef, 0x74, 0xf8, 0x85, 0x3c, 0x17, 0xd9, 0x62, 0x8f, 0xa0, 0xd6, 0x35,
+	0xba, 0xfe, 0x79, 0xd3, 0xe1, 0x6e, 0x9b, 0x5b, 0xbe, 0x61, 0x8a, 0x15, 0xa6, 0xb4, 0x05, 0xa2,
+	0x9f, 0x86, 0x64, 0xf6, 0x0c, 0xd6, 0x2c, 0xc3, 0xe2, 0xe4, 0xe8, 0x46, 0x7a, 0xe4, 0xa8, 0xc7,
+	0x8a, 0x60, 0xbf, 0x88, 0xf7, 0x53, 0xff, 0x32, 0x0d, 0x95, 0xa8, 0x56, 0xd8, 0xb7, 0x30, 0xdf,
+	0xb1, 0xdf, 0x59, 0xa6, 0xad, 0x77, 0x9a, 0x88, 0xc1, 0xe4, 0x41, 0xdc, 0x1a, 0x73, 0x31, 0x07,
+	0x12, 0x7f, 0x69, 0x95, 0x40, 0x1e, 0x9d, 0x0e, 0xfb, 0x06, 0x2a, 0x8e, 0x18, 0x4f, 0x74, 0x4f,
+	0x4f, 0xeb, 0x5e, 0x96, 0xe2, 0xd4, 0xfb, 0x39, 0x94, 0x07, 0xce, 0x70, 0xee, 0xcc, 0xb4, 0xce,
+	0x20, 0xa4, 0xa9, 0xef, 0x7d, 0xa8, 0x86, 0x2b, 0x6f, 0x5d, 0xfa, 0xdc, 0x23, 0x5d, 0x65, 0xb5,
+	0x70, 0x3f, 0x7b, 0x48, 0x64, 0x77, 0xa1, 0x32, 0x70, 0x22, 0x42, 0x39, 0x12, 0x92, 0xd3, 0x92,
+	0x88, 0xfa, 0xb7, 0x69, 0x58, 0x09, 0xcf, 0x31, 0xa6, 0x9d, 0xa7, 0xc9, 0xda, 0x91, 0xae, 0x2d,
+	0xe8, 0x32, 0xa2, 0x92, 0xaf, 0x13, 0x55, 0x32, 0xda, 0x27, 0xa6, 0x87, 0x27, 0x49, 0x7a, 0x18,
+	0xed, 0x11, 0xdd, 0xfc, 0x6f, 0x25, 0x6e, 0x7e, 0xbc, 0xcf, 0x88, 0x32, 0xbe, 0x4e, 0x50, 0x46,
+	0xc2, 0xd2, 0xa2, 0xca, 0xf9, 0xdf, 0x14, 0x54, 0xfe, 0xd0, 0x76, 0xdf, 0x72, 0x17, 0x55, 0x32,
+	0xf0, 0xd8, 0x23, 0x28, 0xbd, 0xa3, 0x76, 0x33, 0xbc, 0xfb, 0x95, 0x8f, 0x1f, 0x36, 0x8b, 0x42,
+	0xe8, 0xe8, 0x40, 0x2b, 0x0a, 0xf6, 0x51, 0x87, 0x6d, 0x41, 0xfe, 0x8d, 0xdd, 0x42, 0x39, 0x11,
+	0x68, 0x4a, 0x1f, 0x3f, 0x6c, 0xe6, 0xd0, 0x67, 0x1e, 0x68, 0xb9, 0x37, 0x76, 0xeb, 0xa8, 0x83,
+	0xae, 0x9c, 0x6e, 0x99, 0xf0, 0xf5, 0xd5, 0xa1, 0x93, 0xa5, 0xdb, 0x48, 0x3c, 0xf6, 0x13, 0x28,
+	0x50, 0x50, 0xe3, 0x1d, 0x25, 0x3b, 0x35, 0xfe, 0x05, 0xa2, 0x43, 0x87, 0x90, 0x9b, 0xe2, 0x10,
+	0xee, 0x00, 0xfc, 0x72, 0xc0, 0x07, 0xbc, 0xe9, 0x19, 0x3f, 0x72, 0x42, 0x1b, 0x19, 0xad, 0x44,
+	0x94, 0x86, 0xf1, 0x23, 0x57, 0x8f, 0xa1, 0xa2, 0x71, 0xcf, 0x1e, 0xb8, 0x6d, 0x4e, 0x5e, 0x17,
+	0x01, 0xbc, 0x33, 0xa0, 0x8d, 0xa7, 0x35, 0xfc, 0xc4, 0xeb, 0xdc, 0xe7, 0x7d, 0xdb, 0xbd, 0x94,
+	0x8e, 0x5d, 0xb6, 0x50, 0xb2, 0xe7, 0x0c, 0xe8, 0x30, 0x33, 0x1a, 0x7e, 0xaa, 0x7f, 0x56, 0x81,
+	0x02, 0x85, 0x8c, 0xae, 0x1d, 0xf8, 0xc8, 0x54, 0x82, 0x8f, 0x64, 0x5f, 0x40, 0xc9, 0x0f, 0x52,
+	0x80, 0x98, 0xf9, 0x84, 0x89, 0x81, 0x36, 0x14, 0x60, 0x8f, 0xa0, 0xe8, 0x48, 0xfc, 0xa7, 0x64,
+	0x22, 0xf1, 0x2e, 0x00, 0x85, 0x5a, 0xc8, 0x66, 0x9f, 0x03, 0x38, 0xba, 0xcb, 0x2d, 0xbf, 0x89,
+	0x73, 0xe7, 0x47, 0xe6, 0x2e, 0x09, 0x1e, 0x22, 0xe5, 0x88, 0xce, 0x0b, 0xd7, 0xd7, 0xf9, 0x33,
+	0x28, 0x76, 0x0d, 0xcb, 0xf0, 0xce, 0x79, 0x47, 0x29, 0x4e, 0xed, 0x16, 0xca, 0xb2, 0xaf, 0x60,
+	0xde, 0x1e, 0xf8, 0xce, 0xc0, 0x0f, 0x80, 0x5d, 0x69, 0x3c, 0x88, 0x56, 0x84, 0x84, 0x68, 0xb1,
+	0x7b, 0x41, 0x54, 0x00, 0x8a, 0x0a, 0xf3, 0xc1, 0x1e, 0x62, 0x31, 0xe1, 0x3b, 0xa8, 0x39, 0xc3,
+	0x98, 0xd9, 0x24, 0x58, 0x55, 0xa1, 0x91, 0x97, 0x85, 0x82, 0xe2, 0x01, 0x55, 0x5b, 0x70, 0xe2,
+	0x04, 0x74, 0xc8, 0x81, 0xea, 0x9a, 0x17, 0xdc, 0xf5, 0x10, 0x95, 0xcc, 0x93, 0xff, 0x58, 0x08,
+	0xe8, 0xbf, 0x10, 0x64, 0xf6, 0x00, 0x53, 0x33, 0x4a, 0x21, 0x94, 0x2a, 0x4d, 0x51, 0x91, 0xa9,
+	0x19, 0xd1, 0xb4, 0x80, 0x89, 0x40, 0x81, 0x53, 0x96, 0xa2, 0x2c, 0x04, 0x7b, 0x74, 0xbc, 0x6d,
+	0x91, 0xb8, 0x68, 0x92, 0x85, 0xf9, 0x85, 0xd4, 0x87, 0x44, 0x62, 0x8b, 0x64, 0x58, 0x52, 0x05,
+	0x7b, 0x44, 0x63, 0x8f, 0xa1, 0x2c, 0x85, 0x08, 0x5b, 0xb2, 0x48, 0x28, 0xd3, 0xb8, 0x63, 0x6b,
+	0x20, 0xb8, 0xf8, 0xcd, 0x14, 0x28, 0xb8, 0x5c, 0x40, 0xc8, 0x65, 0x5a, 0x7f, 0xd0, 0x24, 0x2f,
+	0xaa, 0xfb, 0x7a, 0x53, 0x7a, 0x23, 0xde, 0x51, 0x56, 0xc9, 0x5e, 0xe7, 0x91, 0x7a, 0x1a, 0x10,
+	0xf1, 0x92, 0x90, 0x98, 0x6f, 0xfb, 0xba, 0xa9, 0xac, 0x89, 0x4b, 0x82, 0x94, 0x33, 0x24, 0xb0,
+	0x67, 0x30, 0x2f, 0x7d, 0x82, 0x47, 0x4e, 0x42, 0x51, 0xb6, 0x32, 0xe1, 0xa5, 0x8b, 0x7a, 0x0f,
+	0xad, 0xf2, 0x2e, 0xd2, 0x62, 0xdf, 0xc2, 0xa2, 0x2b, 0x2f, 0x57, 0xd3, 0xe5, 0xbf, 0x1c, 0x70,
+	0xcf, 0xf7, 0x94, 0x5b, 0x91, 0x0b, 0x1b, 0xbd, 0x7a, 0x5a, 0x2d, 0x90, 0xd5, 0xa4, 0x28, 0xc2,
+	0x07, 0x03, 0xbd, 0x85, 0x52, 0x8f, 0xc0, 0x07, 0x09, 0xd2, 0x88, 0xc1, 0xb6, 0x01, 0x2c, 0xfe,
+	0x2e, 0xd0, 0xe3, 0x3a, 0x89, 0x2d, 0x90, 0x92, 0x84, 0x1a, 0x29, 0x9c, 0x97, 0x2c, 0xfe, 0x4e,
+	0x34, 0x11, 0x38, 0x19, 0x56, 0xdb, 0xe5, 0x7d, 0x6e, 0xe1, 0x4e, 0x6f, 0x13, 0x2c, 0x8b, 0x92,
+	0xd8, 0x36, 0x54, 0xc8, 0x71, 0x04, 0xb6, 0x7a, 0x67, 0xdc, 0x56, 0xcb, 0x24, 0x20, 0x1a, 0x18,
+	0x80, 0x48, 0x75, 0xde, 0x5b, 0xc3, 0x71, 0x78, 0x47, 0xd9, 0x20, 0xe5, 0x95, 0x91, 0xd6, 0x10,
+	0xa4, 0xa1, 0xaf, 0xda, 0x9c, 0xe2, 0xab, 0xee, 0x42, 0x85, 0x5b, 0x7a, 0xcb, 0xe4, 0x4d, 0x21,
+	0xbf, 0x25, 0x96, 0x27, 0x68, 0x24, 0x49, 0xf9, 0x83, 0x6e, 0xfa, 0xca, 0x5d, 0x99, 0x3f, 0xe8,
+	0xa6, 0x8f, 0xd0, 0xa4, 0xa5, 0xfb, 0xed, 0x73, 0x45, 0x15, 0xc9, 0x3c, 0x35, 0xd0, 0x6f, 0xb9,
+	0x5c, 0xf7, 0x6c, 0x4b, 0xb9, 0x27, 0xfc, 0x96, 0x68, 0xb1, 0xe7, 0xb0, 0x10, 0x1e, 0x8a, 0x69,
+	0xf4, 0x0d, 0xdf, 0x53, 0x3e, 0xbb, 0xea, 0x48, 0xaa, 0x81, 0xe4, 0x09, 0x09, 0xb2, 0x2f, 0x01,
+	0xda, 0xe7, 0x03, 0xeb, 0xad, 0xb8, 0x6c, 0xf7, 0xa3, 0x00, 0x1d, 0xc9, 0xd4, 0xa7, 0xd4, 0x0e,
+	0x3e, 0x09, 0x7d, 0x20, 0x94, 0xa3, 0xb0, 0x67, 0x0f, 0x7c, 0xe5, 0xc1, 0x74, 0xf4, 0x81, 0xf2,
+	0x67, 0x42, 0x1c, 0xf1, 0x03, 0x06, 0x98, 0xa0, 0xf7, 0xe7, 0xd3, 0x7a, 0xc3, 0x1b, 0xbb, 0x15,
+	0xf4, 0xdd, 0x04, 0x3a, 0x83, 0x66, 0x57, 0x37, 0x4c, 0xde, 0x51, 0x1e, 0xd2, 0xb1, 0x90, 0x95,
+	0xbf, 0x20, 0x8a, 0x14, 0xc0, 0xc5, 0xb9, 0x06, 0xf7, 0x94, 0x47, 0xa1, 0xc0, 0xa0, 0x7f, 0x86,
+	0x14, 0xac, 0x86, 0xe0, 0xa1, 0xca, 0x11, 0x9a, 0xc4, 0xf1, 0x94, 0xc7, 0xa4, 0xe3, 0x1a, 0x72,
+	0xc4, 0x40, 0x04, 0x56, 0xbd, 0xe3, 0x6c, 0x31, 0x5b, 0xcb, 0x1d, 0x67, 0x8b, 0xb9, 0x5a, 0x5e,
+	0x3d, 0x80, 0xbc, 0xb8, 0x15, 0x89, 0xc9, 0xd9, 0x83, 0x38, 0xe4, 0xad, 0x8d, 0xdc, 0xa2, 0xc0,
+	0xbf, 0xa9, 0x4f, 0x65, 0x02, 0xd2, 0xb5, 0x3d, 0xf6, 0x39, 0x14, 0x29, 0xd4, 0x5a, 0x5d, 0x5b,
+	0x49, 0x6d, 0x65, 0x42, 0x07, 0x24, 0x05, 0xb4, 0xc2, 0x1b, 0xf1, 0xa1, 0x6e, 0x40, 0x31, 0x08,
+	0x0c, 0x49, 0x93, 0xab, 0xff, 0x98, 0x82, 0xf9, 0x40, 0x40, 0xe4, 0x36, 0x77, 0x64, 0xf6, 0x9a,
+	0x1a, 0xf5, 0x30, 0xa3, 0xb9, 0x7e, 0x3a, 0x96, 0x2f, 0x06, 0xd9, 0x4e, 0x26, 0x21, 0xdb, 0xc9,
+	0x26, 0x64, 0x3b, 0xb9, 0x88, 0x06, 0x36, 0x21, 0x8b, 0x49, 0xbd, 0x92, 0x1f, 0xbf, 0x5b, 0xc4,
+	0x50, 0xff, 0xbd, 0x0c, 0x95, 0xe1, 0x2a, 0xbb, 0x76, 0x2c, 0x08, 0xa6, 0x26, 0x07, 0xc1, 0xd9,
+	0xa2, 0xeb, 0x6f, 0x03, 0xb4, 0x5d, 0xae, 0xfb, 0xbc, 0xd3, 0xd4, 0x7d, 0x25, 0x3f, 0x35, 0xaa,
+	0x95, 0xa4, 0xf4, 0xae, 0xcf, 0x1e, 0x06, 0xe7, 0x58, 0xa0, 0x73, 0x64, 0xb1, 0x05, 0xc5, 0x22,
+	0xd5, 0x5d, 0xa8, 0xb8, 0x1c, 0x31, 0x7a, 0x93, 0xbb, 0xae, 0xed, 0x52, 0xf0, 0x2c, 0x69, 0x65,
+	0x41, 0x3b, 0x44, 0x12, 0xfb, 0x0e, 0xd0, 0x78, 0x9b, 0x94, 0x55, 0x88, 0x92, 0x5b, 0x79, 0x67,
+	0x2b, 0x36, 0x22, 0xea, 0x01, 0xcf, 0x7b, 0x9f, 0x44, 0x44, 0xf5, 0xa7, 0xf4, 0x26, 0x68, 0x27,
+	0x46, 0x43, 0x98, 0x25, 0x1a, 0x2a, 0x50, 0x08, 0x82, 0x60, 0x59, 0x04, 0x11, 0xd9, 0xfc, 0xc4,
+	0xa0, 0x56, 0x4b, 0x08, 0x6a, 0x22, 0xa3, 0x5c, 0x1c, 0xcb, 0x28, 0xbf, 0x87, 0x65, 0xaf, 0xad,
+	0x9b, 0xbc, 0x89, 0x78, 0xb6, 0xe9, 0x9f, 0xbb, 0xdc, 0x3b, 0xb7, 0xcd, 0x8e, 0xc2, 0xa6, 0xdd,
+	0x78, 0x46, 0xdd, 0x0e, 0xec, 0x77, 0xd6, 0x59, 0xd0, 0x29, 0x39, 0xea, 0x2c, 0x7d, 0x42, 0xd4,
+	0x59, 0xbe, 0x2a, 0xea, 0x6c, 0x41, 0xb9, 0xc3, 0xbd, 0xb6, 0x6b, 0x38, 0xb8, 0x08, 0x65, 0x45,
+	0x1c, 0x67, 0x84, 0x34, 0x1a, 0x67, 0x56, 0xc7, 0xe3, 0xcc, 0x1d, 0x80, 0xb6, 0xde, 0x3e, 0x97,
+	0xb8, 0x74, 0x4d, 0xd4, 0xa5, 0x89, 0x82, 0xb8, 0x74, 0x2c, 0x14, 0x28, 0x57, 0x87, 0x82, 0x5b,
+	0x91, 0x50, 0xb0, 0x81, 0xa3, 0x3a, 0x7a, 0xcb, 0x30, 0x0d, 0xff, 0x92, 0xc2, 0x66, 0x49, 0x8b,
+	0x50, 0x86, 0xa1, 0x62, 0x3d, 0x39, 0x54, 0xdc, 0x8e, 0x85, 0x8a, 0xcf, 0xa0, 0xda, 0xd7, 0xdf,
+	0x37, 0x23, 0xf8, 0xf9, 0x0e, 0x79, 0xc9, 0x4a, 0x5f, 0x7f, 0xff, 0x07, 0x01, 0x84, 0x8e, 0x62,
+	0xa3, 0x8d, 0x49, 0xd8, 0x28, 0x21, 0xf0, 0x6c, 0x7e, 0x5a, 0xe0, 0xd9, 0x9a, 0x39, 0xf0, 0xdc,
+	0xbd, 0x51, 0xe0, 0x51, 0x67, 0x09, 0x3c, 0x4f, 0xa0, 0xdc, 0x33, 0xfc, 0x73, 0xdb, 0x7e, 0xdb,
+	0xc4, 0x1a, 0x1c, 0x05, 0xdf, 0xbd, 0xea, 0xc7, 0x0f, 0x9b, 0xf0, 0x52, 0x90, 0xb1, 0x14, 0x07,
+	0x52, 0xe4, 0xb5, 0x6b, 0x8e, 0x06, 0xa2, 0xcf, 0xae, 0x19, 0x88, 0xee, 0x27, 0x07, 0x22, 0x34,
+	0x5f, 0xcf, 0x19, 0x06, 0x5b, 0x61, 0xbe, 0x54, 0xaa, 0xd6, 0x04, 0x83, 0x7d, 0x1d, 0x29, 0x3f,
+	0x8b, 0x98, 0xba, 0x92, 0x58, 0x66, 0x1e, 0x56, 0xa5, 0xeb, 0xdf, 0x40, 0x35, 0xee, 0x7a, 0xa2,
+	0x55, 0xe6, 0x5c, 0x42, 0x95, 0x39, 0x17, 0xa9, 0x32, 0x1f, 0x67, 0x8b, 0x99, 0x5a, 0x56, 0x44,
+	0x48, 0xf5, 0x65, 0x34, 0xfe, 0x60, 0x68, 0x7b, 0x06, 0xf3, 0x21, 0x0a, 0x8f, 0xc4, 0xb7, 0xc5,
+	0x31, 0xe7, 0xa7, 0x55, 0x9c, 0x48, 0x4b, 0xfd, 0xab, 0x22, 0xd4, 0xf6, 0xc9, 0x19, 0x63, 0x72,
+	0x23, 0x2e, 0x6f, 0xdc, 0xf9, 0xa7, 0x66, 0x49, 0xad, 0xd2, 0x93, 0xa3, 0x4a, 0x92, 0x7b, 0x2d,
+	0xcc, 0xe2, 0x5e, 0x23, 0xb7, 0xa4, 0x78, 0xbd, 0x0c, 0xa2, 0x74, 0xb5, 0xb3, 0x4d, 0xca, 0x5c,
+	0x20, 0x39, 0x73, 0x19, 0xf3, 0xcb, 0xe5, 0xe9, 0xc9, 0x46, 0x65, 0x52, 0xb2, 0x11, 0x4f, 0x32,
+	0xe7, 0xaf, 0x4e, 0x32, 0x13, 0xfd, 0x70, 0xf5, 0x13, 0xfc, 0xf0, 0xc2, 0xf5, 0xd0, 0x7f, 0x6d,
+	0x56, 0xf4, 0xbf, 0x38, 0xee, 0x95, 0x47, 0xdd, 0x2e, 0xbb, 0xda, 0xed, 0x2e, 0x25, 0x21, 0xf0,
+	0xe5, 0xa8, 0x5b, 0x4d, 0x70, 0x78, 0x2b, 0x9f, 0xe6, 0xf0, 0x56, 0x67, 0x76, 0x78, 0x6b, 0x37,
+	0x72, 0x78, 0xca, 0xec, 0x48, 0x3b, 0xf4, 0x5f, 0xb7, 0xae, 0xe9, 0xbf, 0xea, 0x57, 0xf8, 0xaf,
+	0xb1, 0x6a, 0xc1, 0xfa, 0x94, 0x6a, 0x41, 0xcc, 0xbd, 0x9c, 0xc2, 0xe2, 0x91, 0x85, 0x3a, 0xf3,
+	0x23, 0x5e, 0x61, 0x52, 0x31, 0x66, 0x13, 0xca, 0x2d, 0xd3, 0x6e, 0xbf, 0x6d, 0x0e, 0x31, 0x79,
+	0x51, 0x03, 0x22, 0x11, 0x86, 0x53, 0xff, 0x3e, 0x05, 0xd5, 0x13, 0xc3, 0x8b, 0x8e, 0x37, 0x03,
+	0x1a, 0xdd, 0x86, 0x8a, 0x61, 0x45, 0x36, 0x93, 0xde, 0xca, 0x8c, 0x6e, 0xa6, 0x4c, 0x02, 0xa2,
+	0x31, 0xbe, 0xfb, 0xcc, 0x94, 0xdd, 0xab, 0xdb, 0x50, 0x3b, 0xe0, 0x26, 0xf7, 0xf9, 0xf5, 0x36,
+	0xac, 0x7e, 0x01, 0xd5, 0x86, 0x6f, 0x3b, 0xd7, 0x94, 0xfe, 0xef, 0x14, 0x54, 0x5f, 0x72, 0xff,
+	0xc4, 0xee, 0x79, 0xd7, 0xd1, 0xe6, 0x0c, 0x1e, 0x35, 0x48, 0x9c, 0xbb, 0x86, 0xe9, 0x73, 0xd7,
+	0xa3, 0x1a, 0x61, 0x49, 0x24, 0xce, 0x2f, 0x04, 0x89, 0x4a, 0x6f, 0xba, 0xe7, 0x73, 0x97, 0xb2,
+	0x87, 0xa2, 0x26, 0x5b, 0xc3, 0x67, 0x85, 0xfc, 0x55, 0xcf, 0x0a, 0xab, 0x90, 0xef, 0xda, 0xa6,
+	0x69, 0xbf, 0x93, 0x0f, 0xdc, 0xb2, 0x85, 0x97, 0xd7, 0xd7, 0x0d, 0x93, 0x5c, 0x70, 0x46, 0xa3,
+	0x6f, 0x69, 0x38, 0xff, 0x92, 0x06, 0x38, 0xb1, 0x7b, 0xbf, 0xcf, 0x3d, 0x0f, 0x1f, 0xf2, 0xef,
+	0x45, 0xa2, 0x52, 0x24, 0x89, 0x0a, 0x43, 0xd0, 0x2b, 0xcc, 0x63, 0x86, 0x05, 0xd0, 0xcc, 0x94,
+	0x02, 0x68, 0x76, 0x42, 0x01, 0xf4, 0x31, 0xa4, 0xc3, 0x3a, 0xe6, 0xa4, 0xd4, 0x23, 0xed, 0x7b,
+	0x08, 0xd2, 0xfb, 0x62, 0x85, 0xb4, 0xf7, 0x92, 0x16, 0x34, 0xe3, 0x75, 0xdb, 0xc2, 0xc4, 0xba,
+	0x2d, 0x83, 0xec, 0xc0, 0xe3, 0xae, 0x7c, 0x3e, 0xa5, 0x6f, 0xf6, 0x00, 0x8a, 0xe2, 0x12, 0x1b,
+	0x1d, 0xf1, 0x72, 0xba, 0x57, 0xfe, 0xf8, 0x61, 0xb3, 0x20, 0x9e, 0x72, 0x0e, 0xb4, 0x02, 0x31,
+	0x8f, 0x3a, 0x91, 0x23, 0x81, 0xe8, 0x91, 0xa8, 0x67, 0xb0, 0xa4, 0x89, 0x9a, 0x93, 0x38, 0x87,
+	0x6b, 0xd8, 0xca, 0xa8, 0x01, 0xa4, 0xc7, 0x0c, 0x40, 0xfd, 0x29, 0x2c, 0xc9, 0xdb, 0x1c, 0x1b,
+	0x75, 0xea, 0xb3, 0x92, 0xfa, 0x4f, 0x29, 0xa8, 0xe1, 0xa5, 0xbd, 0xf6, 0x62, 0xd6, 0xa1, 0xe4,
+	0xe8, 0x3d, 0x89, 0x72, 0xd3, 0x64, 0x1d, 0x45, 0x24, 0x10, 0xc2, 0xa5, 0x97, 0xb3, 0x1e, 0x97,
+	0xb5, 0x5e, 0xfa, 0x1e, 0x46, 0xa7, 0xec, 0x55, 0xd1, 0x29, 0x7a, 0x17, 0x72, 0x13, 0xef, 0x82,
+	0x7a, 0x09, 0x8b, 0x91, 0xd5, 0x7a, 0x8e, 0x6d, 0x79, 0xf4, 0x6a, 0x20, 0x8f, 0x04, 0x71, 0x92,
+	0x92, 0x8a, 0x98, 0x50, 0xf8, 0xc2, 0x26, 0xfd, 0xac, 0x40, 0x52, 0x9b, 0x50, 0xa6, 0x02, 0x5e,
+	0x13, 0x17, 0xe8, 0xc9, 0x5d, 0x00, 0x91, 0x4e, 0x91, 0x92, 0xb4, 0x0f, 0xf5, 0x4f, 0x60, 0x2d,
+	0x9c, 0xba, 0xe1, 0xbb, 0x5c, 0x1f, 0x2e, 0xe0, 0x4b, 0x80, 0xe1, 0x02, 0x62, 0x68, 0x6a, 0x38,
+	0x7f, 0x29, 0x9c, 0xff, 0xd3, 0xa6, 0xdf, 0x83, 0x52, 0x18, 0xd0, 0xd0, 0xb8, 0xac, 0x41, 0xbf,
+	0xc5, 0x5d, 0xf9, 0xc8, 0x26, 0x5b, 0x98, 0x2b, 0xe1, 0xb9, 0xc8, 0x57, 0x0d, 0x31, 0x70, 0x09,
+	0x29, 0xe2, 0x0d, 0xe3, 0x3f, 0x8b, 0xb0, 0x22, 0x90, 0x60, 0xa8, 0xda, 0xd9, 0x1d, 0xf5, 0x6c,
+	0x65, 0x83, 0x55, 0xc8, 0x0f, 0x9c, 0x8e, 0xee, 0x8b, 0x93, 0x2d, 0x6a, 0xb2, 0x75, 0x73, 0x98,
+	0x78, 0x2d, 0xf8, 0x37, 0x86, 0xe9, 0x20, 0x01, 0xd3, 0x5d, 0x95, 0x53, 0x97, 0xff, 0xdf, 0x72,
+	0xea, 0xca, 0x27, 0x60, 0xb9, 0xf9, 0x6b, 0xe6, 0xd4, 0xd5, 0xa9, 0x39, 0xf5, 0xc2, 0xb4, 0x9c,
+	0xba, 0x36, 0x2d, 0xa7, 0x5e, 0x1c, 0x07, 0x77, 0xb7, 0xa1, 0xe4, 0x72, 0x59, 0x2c, 0x97, 0xe0,
+	0x6f, 0x48, 0x18, 0xc2, 0xbc, 0xa5, 0x28, 0xcc, 0x1b, 0xcf, 0x92, 0x97, 0x27, 0x67, 0xc9, 0x2b,
+	0x33, 0x66, 0xc9, 0xab, 0x9f, 0x06, 0x1a, 0xd7, 0x66, 0x06, 0x8d, 0xca, 0x8d, 0x40, 0xe3, 0xad,
+	0x1b, 0x80, 0xc6, 0xfa, 0x35, 0x41, 0xe3, 0xfa, 0xb4, 0xa4, 0xf7, 0xf6, 0x75, 0x92, 0xde, 0x3b,
+	0xd7, 0x4a, 0x7a, 0x63, 0xb8, 0xf2, 0x8f, 0x60, 0x55, 0x46, 0xa2, 0x1b, 0xf8, 0x98, 0x48, 0x89,
+	0x2d, 0x1d, 0x2b, 0xb1, 0xa9, 0xbf, 0x07, 0xeb, 0xe8, 0x85, 0x4f, 0xe3, 0xc9, 0x9b, 0x37, 0xfb,
+	0x1c, 0xea, 0x1f, 0xc3, 0x9a, 0x66, 0x9b, 0x66, 0x4b, 0x6f, 0xbf, 0xfd, 0x4d, 0xac, 0x34, 0x7e,
+	0x43, 0x32, 0x23, 0x37, 0x44, 0x5d, 0x81, 0xa5, 0xe8, 0x3e, 0xe4, 0xcc, 0xea, 0x4e, 0x88, 0xca,
+	0x0f, 0x76, 0x5f, 0x06, 0xcb, 0x99, 0x5c, 0x78, 0x56, 0x7f, 0x9d, 0x86, 0xc2, 0xc1, 0xee, 0xcb,
+	0x57, 0xf8, 0x77, 0xc5, 0xc9, 0xa2, 0xec, 0x1e, 0x14, 0x44, 0xda, 0x19, 0xfc, 0xef, 0x28, 0x22,
+	0x11, 0x70, 0x66, 0x79, 0x47, 0x0d, 0x2b, 0xbb, 0xd9, 0x69, 0x95, 0xdd, 0x7b, 0x50, 0x34, 0x75,
+	0x4f, 0xa4, 0xc2, 0xb9, 0x11, 0x5c, 0x51, 0x40, 0x0e, 0x26, 0xc2, 0x4f, 0xa1, 0x1a, 0x08, 0xc9,
+	0x2c, 0x23, 0x9f, 0xf4, 0xac, 0x59, 0x91, 0xf2, 0xd4, 0x62, 0xcf, 0x63, 0x05, 0x61, 0xf1, 0x2f,
+	0xa5, 0x75, 0x11, 0x7c, 0x85, 0x52, 0xae, 0xae, 0x05, 0xdf, 0xac, 0x5a, 0xa3, 0x7e, 0x49, 0x7a,
+	0xa7, 0x90, 0xae, 0x42, 0xce, 0xb2, 0x3b, 0xdc, 0x8b, 0xbd, 0x39, 0xc8, 0xf9, 0x35, 0xc1, 0x52,
+	0xff, 0x3a, 0x05, 0x2b, 0x22, 0x01, 0xb9, 0x81, 0xbd, 0xa1, 0x3b, 0xa0, 0x31, 0x50, 0x49, 0x5e,
+	0x90, 0x85, 0x75, 0x82, 0xbc, 0xc6, 0x8b, 0x08, 0x90, 0x21, 0x64, 0xa2, 0x02, 0x54, 0x96, 0xa8,
+	0x41, 0x46, 0x37, 0x4d, 0xf9, 0xf4, 0x80, 0x9f, 0xea, 0x2e, 0x2c, 0x37, 0x10, 0x90, 0x7e, 0xfa,
+	0xb2, 0xd4, 0x9f, 0xc3, 0x12, 0xe6, 0x4a, 0x37, 0x18, 0xe1, 0xcf, 0x53, 0xb0, 0xac, 0x71, 0x77,
+	0x60, 0xdd, 0x40, 0x39, 0xf7, 0xa1, 0xc0, 0xdf, 0xb7, 0xcd, 0x41, 0x87, 0x27, 0xa5, 0x8f, 0x01,
+	0x0f, 0xc5, 0x0c, 0x4b, 0x88, 0x65, 0x12, 0xc4, 0x24, 0x4f, 0x5d, 0x83, 0x95, 0x97, 0xba, 0xdb,
+	0xd2, 0x7b, 0x7c, 0xdf, 0x36, 0x4d, 0xde, 0xf6, 0x83, 0x4b, 0xaa, 0xc0, 0xea, 0x28, 0x43, 0x00,
+	0xc1, 0xc7, 0x4d, 0x28, 0x86, 0x76, 0x59, 0x83, 0xca, 0xf1, 0x0f, 0x7b, 0xcd, 0xc6, 0xd9, 0xae,
+	0x76, 0x76, 0xf4, 0xea, 0x65, 0x6d, 0x8e, 0x2d, 0x40, 0x19, 0x29, 0xda, 0xeb, 0x57, 0xaf, 0x90,
+	0x90, 0x0a, 0x08, 0x2f, 0x76, 0x8f, 0x4e, 0x5e, 0x6b, 0x87, 0xb5, 0x74, 0x40, 0x68, 0xbc, 0xde,
+	0xdf, 0x3f, 0x6c, 0x34, 0x6a, 0x19, 0x56, 0x05, 0x40, 0xc2, 0xf7, 0x47, 0x27, 0x27, 0x87, 0x07,
+	0xb5, 0xec, 0xe3, 0x9f, 0x03, 0x0c, 0xff, 0xf3, 0xc5, 0x00, 0xf2, 0xd8, 0xf7, 0xf0, 0xa0, 0x36,
+	0xc7, 0xca, 0x50, 0x08, 0xba, 0xa5, 0xa8, 0xf1, 0xfd, 0xd1, 0xe9, 0xe9, 0xe1, 0x41, 0x2d, 0xcd,
+	0x2a, 0x50, 0x0c, 0x17, 0x91, 0x79, 0xfc, 0x1d, 0x94, 0x23, 0x4f, 0x68, 0x38, 0xe3, 0xe9, 0x0f,
+	0x07, 0xe1, 0x9a, 0xe6, 0x02, 0xc2, 0x70, 0xac, 0x2a, 0x00, 0x12, 0xe4, 0x44, 0xe9, 0xc7, 0x7f,
+	0x1a, 0x79, 0x18, 0x13, 0x63, 0xac, 0xc0, 0xe2, 0xe9, 0xd1, 0xe9, 0xe1, 0xc9, 0xd1, 0xab, 0xc3,
+	0xe8, 0x76, 0x97, 0xa1, 0x16, 0x92, 0x87, 0x7b, 0x5e, 0x83, 0xa5, 0x21, 0xf5, 0x30, 0x14, 0x4f,
+	0xc7, 0xc4, 0x03, 0x8d, 0x64, 0xd8, 0x12, 0x2c, 0x84, 0xd4, 0xd3, 0xdd, 0xd7, 0x0d, 0xd4, 0xc2,
+	0xce, 0xbf, 0x96, 0x21, 0xb3, 0x7b, 0x7a, 0xc4, 0xb6, 0xa1, 0x24, 0xe0, 0x2c, 0x3a, 0x8f, 0x15,
+	0xf9, 0xff, 0xc7, 0x78, 0xa1, 0xb3, 0x1e, 0xba, 0x19, 0x75, 0x8e, 0xfd, 0x04, 0x60, 0x58, 0xf3,
+	0x60, 0xab, 0x12, 0x5b, 0x8d, 0x14, 0x41, 0xea, 0xb1, 0x07, 0x43, 0x75, 0x8e, 0x3d, 0x81, 0x82,
+	0x2c, 0x6b, 0xb0, 0x25, 0x62, 0xc5, 0x8b, 0x1c, 0xf5, 0xf9, 0xa8, 0xbc, 0xa7, 0xce, 0x61, 0xa1,
+	0x56, 0x8a, 0x88, 0x3c, 0x21, 0xb9, 0xdb, 0xc8, 0x34, 0x5f, 0xa5, 0xd8, 0x37, 0x50, 0x0a, 0x0b,
+	0x14, 0x72, 0x3b, 0xa3, 0x05, 0x8b, 0xfa, 0xea, 0x18, 0x6a, 0x38, 0xc4, 0x3f, 0xf5, 0xaa, 0x73,
+	0xec, 0x67, 0x50, 0x90, 0xe5, 0x0a, 0x39, 0x5f, 0xbc, 0x78, 0x31, 0xa1, 0xe7, 0x73, 0xa8, 0x44,
+	0x93, 0x47, 0xa6, 0x44, 0x15, 0x13, 0x4d, 0x0c, 0xeb, 0x23, 0x49, 0x8d, 0x3a, 0x87, 0x6b, 0x0e,
+	0xb3, 0x22, 0xb9, 0xe6, 0xd1, 0x74, 0xb2, 0xbe, 0x3a, 0x4a, 0x16, 0xb7, 0x45, 0x9d, 0x63, 0xc7,
+	0xb0, 0x30, 0x92, 0x53, 0x5d, 0x35, 0xc6, 0xed, 0x38, 0x39, 0x9e, 0x80, 0x91, 0xf6, 0xf6, 0xe8,
+	0x0f, 0x4a, 0x61, 0x62, 0x2d, 0x77, 0x91, 0x90, 0x6b, 0x4f, 0xd0, 0xc4, 0x0b, 0xa8, 0xc6, 0xf3,
+	0x23, 0x56, 0x8f, 0x58, 0xd5, 0x88, 0x67, 0x9a, 0x30, 0xce, 0x3e, 0x2c, 0x8c, 0x80, 0x20, 0xb6,
+	0x1e, 0x55, 0xea, 0xe8, 0x48, 0xe3, 0x35, 0x7c, 0x75, 0x8e, 0x7d, 0x0b, 0x95, 0x28, 0x44, 0x90,
+	0x1b, 0x4a, 0x40, 0x0d, 0x75, 0x36, 0xd6, 0x1d, 0xcd, 0xf0, 0x15, 0x2c, 0x27, 0x41, 0x25, 0xb6,
+	0x35, 0x36, 0xce, 0x08, 0x8a, 0xba, 0x62, 0xbc, 0x17, 0x50, 0x8d, 0x87, 0x2f, 0xa9, 0x9c, 0xc4,
+	0x98, 0x36, 0x41, 0x39, 0x07, 0x30, 0x1f, 0x0b, 0x37, 0xec, 0x96, 0x34, 0xd7, 0xf1, 0x10, 0x34,
+	0x61, 0x94, 0x3d, 0xa8, 0x44, 0x23, 0x8e, 0xd4, 0x4e, 0x42, 0x10, 0x9a, 0xbc, 0x92, 0x58, 0xc8,
+	0x91, 0x2b, 0x49, 0x0a, 0x43, 0x13, 0x46, 0x39, 0x86, 0xda, 0x28, 0x90, 0x64, 0xc2, 0x5c, 0xaf,
+	0xc0, 0x97, 0x13, 0xc6, 0x1a, 0x7a, 0xa8, 0x83, 0xdd, 0x97, 0x71, 0x0f, 0x35, 0x04, 0x84, 0xf5,
+	0x10, 0x5e, 0x48, 0x4b, 0xf9, 0xdd, 0xc0, 0x71, 0xec, 0x9a, 0x26, 0xbb, 0x62, 0xf0, 0x09, 0x93,
+	0x3e, 0x85, 0x82, 0xac, 0x5c, 0x4a, 0xcf, 0x11, 0xaf, 0x63, 0xd6, 0xc5, 0x7f, 0x8d, 0x87, 0x35,
+	0x3f, 0xba, 0x6e, 0xdf, 0x43, 0x35, 0x1e, 0x04, 0xa5, 0x35, 0x24, 0x86, 0xcc, 0xfa, 0x7a, 0x22,
+	0x2f, 0xb8, 0xbd, 0x7b, 0xb5, 0x5f, 0x7d, 0xdc, 0x48, 0xfd, 0xdb, 0xc7, 0x8d, 0xd4, 0x7f, 0x7c,
+	0xdc, 0x48, 0xfd, 0xcd, 0x7f, 0x6d, 0xcc, 0xb5, 0xf2, 0xb4, 0xca, 0xa7, 0xff, 0x37, 0x00, 0xf6,
+	0x7b, 0xcf, 0x3f, 0x59, 0x35, 0x00, 0x00,
 }
@@ -12,6 +12,10 @@ import (
 
 // VisitInput visits each input recursively in ascending order (root last)
 func VisitInput(input *Input, f func(*Input)) {
+	if input == nil {
+		// Spout pipelines have no input
+		return
+	}
 	switch {
 	case input.Cross != nil:
 		for _, input := range input.Cross {
@@ -21,6 +25,14 @@ func VisitInput(input *Input, f func(*Input)) {
 		for _, input := range input.Union {
 			VisitInput(input, f)
 		}
+	case input.Join != nil:
+		for _, input := range input.Join {
+			VisitInput(input, f)
+		}
+	case input.Group != nil:
+		for _, input := range input.Group {
+			VisitInput(input, f)
+		}
 	}
 	f(input)
 }
@@ -38,6 +50,14 @@ func InputName(input *Input) string {
 		if len(input.Union) > 0 {
 			return InputName(input.Union[0])
 		}
+	case input.Join != nil:
+		if len(input.Join) > 0 {
+			return InputName(input.Join[0])
+		}
+	case input.Group != nil:
+		if len(input.Group) > 0 {
+			return InputName(input.Group[0])
+		}
 	}
 	return ""
 }
@@ -53,6 +73,10 @@ func SortInput(input *Input) {
 			SortInputs(input.Cross)
 		case input.Union != nil:
 			SortInputs(input.Union)
+		case input.Join != nil:
+			SortInputs(input.Join)
+		case input.Group != nil:
+			SortInputs(input.Group)
 		}
 	})
 }
@@ -83,6 +107,36 @@ func InputCommits(input *Input) []*pfs.Commit {
 	return result
 }
 
+// InputBranches returns the branches that an Input reads from, without
+// duplicates. These make up the provenance of the output branch of a pipeline
+// with the Input.
+func InputBranches(input *Input) []*pfs.Branch {
+	var result []*pfs.Branch
+	seen := make(map[string]bool)
+	add := func(repo string, branch string) {
+		if seen[repo+"/"+branch] {
+			return
+		}
+		seen[repo+"/"+branch] = true
+		result = append(result, &pfs.Branch{
+			Repo: &pfs.Repo{repo},
+			Name: branch,
+		})
+	}
+	VisitInput(input, func(input *Input) {
+		if input.Atom != nil {
+			add(input.Atom.Repo, input.Atom.Branch)
+		}
+		if input.Cron != nil {
+			add(input.Cron.Repo, "master")
+		}
+		if input.Git != nil {
+			add(input.Git.Name, input.Git.Branch)
+		}
+	})
+	return result
+}
+
 // ValidateGitCloneURL returns an error if the provided URL is invalid
 func ValidateGitCloneURL(url string) error {
 	exampleURL := "https://github.com/org/foo.git"
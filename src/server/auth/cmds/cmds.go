@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"text/tabwriter"
 	"text/template"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
@@ -371,6 +374,79 @@ func ModifyAdminsCmd() *cobra.Command {
 	return modifyAdmins
 }
 
+// AuditLogCmd returns a cobra command that prints the cluster's audit log
+func AuditLogCmd() *cobra.Command {
+	var since string
+	auditLog := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Print the log of API calls that modified the cluster",
+		Long: "Print the log of API calls that modified the cluster (e.g. creating " +
+			"repos, putting files, creating pipelines and changing ACLs), along " +
+			"with the user that made each call. Only cluster admins may read the " +
+			"audit log. --since accepts a duration (e.g. \"1h\"), in which case " +
+			"calls made within that long of now are printed, or an RFC 3339 " +
+			"timestamp (e.g. \"2018-06-01T00:00:00Z\")",
+		Run: cmdutil.Run(func([]string) error {
+			req := &auth.GetAuditLogRequest{}
+			if since != "" {
+				sinceTime, err := parseSince(since)
+				if err != nil {
+					return err
+				}
+				req.Since, err = types.TimestampProto(sinceTime)
+				if err != nil {
+					return err
+				}
+			}
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			fmt.Fprint(writer, "TIME\tUSER\tMETHOD\tREPO\tPIPELINE\tERROR\t\n")
+			// Print the log a page at a time, as it may be too large to fetch in
+			// a single call
+			for {
+				resp, err := c.GetAuditLog(c.Ctx(), req)
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				for _, entry := range resp.Entries {
+					t, err := types.TimestampFromProto(entry.Time)
+					if err != nil {
+						return err
+					}
+					fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t\n", t.Format(time.RFC3339),
+						entry.Username, entry.Method, entry.Repo, entry.Pipeline, entry.Error)
+				}
+				if req.Page+1 >= resp.TotalPages {
+					break
+				}
+				req.Page++
+			}
+			return writer.Flush()
+		}),
+	}
+	auditLog.Flags().StringVar(&since, "since", "", "Only print calls made "+
+		"after this time, given as a duration before now (e.g. \"24h\") or an "+
+		"RFC 3339 timestamp")
+	return auditLog
+}
+
+// parseSince parses the argument to 'audit-log --since', which may be either a
+// duration before now or an absolute time
+func parseSince(since string) (time.Time, error) {
+	if d, err := time.ParseDuration(since); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse --since %q as a duration "+
+			"or an RFC 3339 timestamp", since)
+	}
+	return t, nil
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds() []*cobra.Command {
@@ -389,5 +465,6 @@ func Cmds() []*cobra.Command {
 	auth.AddCommand(GetCmd())
 	auth.AddCommand(ListAdminsCmd())
 	auth.AddCommand(ModifyAdminsCmd())
+	auth.AddCommand(AuditLogCmd())
 	return []*cobra.Command{auth}
 }
@@ -839,3 +839,62 @@ func TestListRepoAdminIsOwnerOfAllRepos(t *testing.T) {
 		require.Equal(t, auth.Scope_OWNER, info.AuthInfo.AccessLevel)
 	}
 }
+
+// TestAuditLog tests that mutating calls are recorded in the audit log along
+// with the user that made them, and that only admins can read it
+func TestAuditLog(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	alice := tu.UniqueString("alice")
+	aliceClient, adminClient := getPachClient(t, alice), getPachClient(t, "admin")
+	start, err := types.TimestampProto(time.Now())
+	require.NoError(t, err)
+
+	// alice creates a repo and puts a file in it
+	repo := tu.UniqueString("TestAuditLog")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	_, err = aliceClient.PutFile(repo, "master", "/file", strings.NewReader("test"))
+	require.NoError(t, err)
+	// alice fails to delete a repo she doesn't own
+	adminRepo := tu.UniqueString("TestAuditLogAdmin")
+	require.NoError(t, adminClient.CreateRepo(adminRepo))
+	require.YesError(t, aliceClient.DeleteRepo(adminRepo, false))
+
+	// alice can't read the audit log
+	_, err = aliceClient.GetAuditLog(aliceClient.Ctx(), &auth.GetAuditLogRequest{Since: start})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// admin can, and it has alice's calls
+	resp, err := adminClient.GetAuditLog(adminClient.Ctx(), &auth.GetAuditLogRequest{Since: start})
+	require.NoError(t, err)
+	var methods []string
+	for _, entry := range resp.Entries {
+		if entry.Username != alice {
+			continue
+		}
+		methods = append(methods, entry.Method)
+		switch entry.Method {
+		case "/pfs.API/CreateRepo", "/pfs.API/PutFile":
+			require.Equal(t, repo, entry.Repo)
+			require.Equal(t, "", entry.Error)
+		case "/pfs.API/DeleteRepo":
+			require.Equal(t, adminRepo, entry.Repo)
+			require.Matches(t, "not authorized", entry.Error)
+		}
+	}
+	require.NoError(t, ElementsEqual(
+		[]string{"/pfs.API/CreateRepo", "/pfs.API/PutFile", "/pfs.API/DeleteRepo"}, methods))
+
+	// The log can be read a page at a time
+	resp, err = adminClient.GetAuditLog(adminClient.Ctx(), &auth.GetAuditLogRequest{
+		Since:    start,
+		PageSize: 1,
+		Page:     1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Entries))
+	require.True(t, resp.TotalPages >= 4) // alice's calls and admin's CreateRepo
+	require.Equal(t, int64(1), resp.Page)
+}
@@ -26,6 +26,7 @@ import (
 	enterpriseclient "github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/pkg/audit"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
@@ -54,6 +55,10 @@ const (
 	// to indicate that they're GitHub usernames. Right now, all users are GitHub
 	// usernames, but someday they may be groups or LDAP users
 	githubPrefix = "github:"
+
+	// maxAuditLogPageSize is the largest number of audit log entries that
+	// GetAuditLog returns at once
+	maxAuditLogPageSize = 1000
 )
 
 // epsilon is small, nonempty protobuf to use as an etcd value (the etcd client
@@ -61,9 +66,21 @@ const (
 // though empty values are still stored in etcd)
 var epsilon = &types.BoolValue{Value: true}
 
+// APIServer is the auth API server. Beyond authclient.APIServer, it lets other
+// parts of pachd find out who made a request.
+type APIServer interface {
+	authclient.APIServer
+	// AuthenticatedUser returns the user that made the request in ctx. It
+	// returns a NotActivatedError if auth isn't activated.
+	AuthenticatedUser(ctx context.Context) (*authclient.User, error)
+}
+
 type apiServer struct {
 	pachLogger log.Logger
 	etcdClient *etcd.Client
+	// auditLog is where mutating API calls are recorded. It's nil if this
+	// pachd doesn't keep an audit log (e.g. in sidecar mode)
+	auditLog audit.Log
 
 	address        string            // address of a Pachd server
 	pachClient     *client.APIClient // pachd client
@@ -115,8 +132,9 @@ func (a *apiServer) getPachClient() (*client.APIClient, error) {
 	return a.pachClient, nil
 }
 
-// NewAuthServer returns an implementation of authclient.APIServer.
-func NewAuthServer(pachdAddress string, etcdAddress string, etcdPrefix string) (authclient.APIServer, error) {
+// NewAuthServer returns an implementation of APIServer. auditLog is served by
+// GetAuditLog, and may be nil if this pachd doesn't keep an audit log.
+func NewAuthServer(pachdAddress string, etcdAddress string, etcdPrefix string, auditLog audit.Log) (APIServer, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   []string{etcdAddress},
 		DialOptions: client.EtcdDialOptions(),
@@ -128,6 +146,7 @@ func NewAuthServer(pachdAddress string, etcdAddress string, etcdPrefix string) (
 	s := &apiServer{
 		pachLogger: log.NewLogger("authclient.API"),
 		etcdClient: etcdClient,
+		auditLog:   auditLog,
 		address:    pachdAddress,
 		adminCache: make(map[string]struct{}),
 		tokens: col.NewCollection(
@@ -956,6 +975,57 @@ func hashToken(token string) string {
 	return fmt.Sprintf("%x", sum)
 }
 
+func (a *apiServer) GetAuditLog(ctx context.Context, req *authclient.GetAuditLogRequest) (resp *authclient.GetAuditLogResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
+	if !a.isActivated() {
+		return nil, authclient.NotActivatedError{}
+	}
+
+	// Get calling user. The user must be an admin to read the audit log
+	user, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !a.isAdmin(user.Username) {
+		return nil, errors.New("not authorized to read the audit log, must be a cluster admin")
+	}
+	if a.auditLog == nil {
+		return nil, errors.New("this pachd does not keep an audit log (AUDIT_LOG_PATH is not set)")
+	}
+
+	var since time.Time
+	if req.Since != nil {
+		since, err = types.TimestampFromProto(req.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp %v: %v", req.Since, err)
+		}
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 || pageSize > maxAuditLogPageSize {
+		pageSize = maxAuditLogPageSize
+	}
+	entries, totalPages, err := a.auditLog.Read(since, req.Page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("error reading audit log: %v", err)
+	}
+	for _, entry := range entries {
+		entry.Username = strings.TrimPrefix(entry.Username, githubPrefix)
+	}
+	return &authclient.GetAuditLogResponse{
+		Entries:    entries,
+		TotalPages: totalPages,
+		Page:       req.Page,
+	}, nil
+}
+
+func (a *apiServer) AuthenticatedUser(ctx context.Context) (*authclient.User, error) {
+	if !a.isActivated() {
+		return nil, authclient.NotActivatedError{}
+	}
+	return a.getAuthenticatedUser(ctx)
+}
+
 func (a *apiServer) getAuthenticatedUser(ctx context.Context) (*authclient.User, error) {
 	// TODO(msteffen) cache these lookups, especially since users always authorize
 	// themselves at the beginning of a request. Don't want to look up the same
@@ -76,3 +76,8 @@ func (a *InactiveAPIServer) GetCapability(ctx context.Context, req *auth.GetCapa
 func (a *InactiveAPIServer) RevokeAuthToken(ctx context.Context, req *auth.RevokeAuthTokenRequest) (resp *auth.RevokeAuthTokenResponse, retErr error) {
 	return nil, auth.NotActivatedError{}
 }
+
+// GetAuditLog implements the GetAuditLog RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetAuditLog(ctx context.Context, req *auth.GetAuditLogRequest) (resp *auth.GetAuditLogResponse, retErr error) {
+	return nil, auth.NotActivatedError{}
+}
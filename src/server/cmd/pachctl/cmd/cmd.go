@@ -24,16 +24,19 @@ import (
 	"github.com/fatih/color"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/health"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 	authcmds "github.com/pachyderm/pachyderm/src/server/auth/cmds"
+	debugcmds "github.com/pachyderm/pachyderm/src/server/debug/cmds"
 	enterprisecmds "github.com/pachyderm/pachyderm/src/server/enterprise/cmds"
 	pfscmds "github.com/pachyderm/pachyderm/src/server/pfs/cmds"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	deploycmds "github.com/pachyderm/pachyderm/src/server/pkg/deploy/cmds"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 	ppscmds "github.com/pachyderm/pachyderm/src/server/pps/cmds"
 
 	log "github.com/sirupsen/logrus"
@@ -187,6 +190,10 @@ Environment variables:
 	for _, cmd := range enterpriseCmds {
 		rootCmd.AddCommand(cmd)
 	}
+	debugCmds := debugcmds.Cmds()
+	for _, cmd := range debugCmds {
+		rootCmd.AddCommand(cmd)
+	}
 
 	var clientOnly bool
 	versionCmd := &cobra.Command{
@@ -373,6 +380,47 @@ Currently "pachctl garbage-collect" can only be started when there are no active
 		}),
 	}
 
+	diagnose := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Check whether pachd can reach its dependencies.",
+		Long: `Check whether pachd can reach its dependencies.
+
+pachd probes each of the services it depends on (etcd, object storage, the
+Kubernetes API and the other pachd nodes that serve its cache) and reports
+whether each is healthy, how long the probe took, and the most recent error
+the probe returned, if any. Components marked as required must be healthy for
+pachd's readiness check to pass.
+
+diagnose exits with a non-zero status if any component is unhealthy.
+`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := client.NewOnUserMachine(!noMetrics, "user")
+			if err != nil {
+				return err
+			}
+			components, err := client.HealthDetailed()
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			printComponentHealthHeader(writer)
+			unhealthy := 0
+			for _, component := range components {
+				printComponentHealth(writer, component)
+				if !component.Healthy {
+					unhealthy++
+				}
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			if unhealthy > 0 {
+				return fmt.Errorf("%d of %d components are unhealthy", unhealthy, len(components))
+			}
+			return nil
+		}),
+	}
+
 	var from, to, namespace string
 	migrate := &cobra.Command{
 		Use:   "migrate",
@@ -504,6 +552,7 @@ $ pachctl migrate --from 1.4.8 --to 1.5.0
 	rootCmd.AddCommand(deleteAll)
 	rootCmd.AddCommand(portForward)
 	rootCmd.AddCommand(garbageCollect)
+	rootCmd.AddCommand(diagnose)
 	rootCmd.AddCommand(migrate)
 	rootCmd.AddCommand(completion)
 	return rootCmd, nil
@@ -524,3 +573,24 @@ func printVersionHeader(w io.Writer) {
 func printVersion(w io.Writer, component string, v *versionpb.Version) {
 	fmt.Fprintf(w, "%s\t%s\t\n", component, version.PrettyPrintVersion(v))
 }
+
+func printComponentHealthHeader(w io.Writer) {
+	fmt.Fprintf(w, "COMPONENT\tSTATUS\tREQUIRED\tLATENCY\tERROR\tLAST ERROR\t\n")
+}
+
+func printComponentHealth(w io.Writer, c *health.ComponentHealth) {
+	status := "healthy"
+	if !c.Healthy {
+		status = "unhealthy"
+	}
+	latency, _ := types.DurationFromProto(c.Latency)
+	lastError := "-"
+	if c.LastError != "" {
+		lastError = fmt.Sprintf("%s (%s)", c.LastError, pretty.Ago(c.LastErrorTime))
+	}
+	errString := "-"
+	if c.Error != "" {
+		errString = c.Error
+	}
+	fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\t\n", c.Name, status, c.Required, latency.Round(time.Microsecond), errString, lastError)
+}
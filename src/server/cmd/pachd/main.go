@@ -9,9 +9,11 @@ import (
 	"strconv"
 	"strings"
 
+	etcd "github.com/coreos/etcd/clientv3"
 	units "github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/src/client"
 	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	debugclient "github.com/pachyderm/pachyderm/src/client/debug"
 	deployclient "github.com/pachyderm/pachyderm/src/client/deploy"
 	eprsclient "github.com/pachyderm/pachyderm/src/client/enterprise"
 	healthclient "github.com/pachyderm/pachyderm/src/client/health"
@@ -19,25 +21,31 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/discovery"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/shard"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	authserver "github.com/pachyderm/pachyderm/src/server/auth/server"
+	debugserver "github.com/pachyderm/pachyderm/src/server/debug"
 	deployserver "github.com/pachyderm/pachyderm/src/server/deploy"
 	eprsserver "github.com/pachyderm/pachyderm/src/server/enterprise/server"
 	"github.com/pachyderm/pachyderm/src/server/health"
 	pfs_server "github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"github.com/pachyderm/pachyderm/src/server/pkg/audit"
 	cache_pb "github.com/pachyderm/pachyderm/src/server/pkg/cache/groupcachepb"
 	cache_server "github.com/pachyderm/pachyderm/src/server/pkg/cache/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/migration"
 	"github.com/pachyderm/pachyderm/src/server/pkg/netutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prometheus"
 	pps_server "github.com/pachyderm/pachyderm/src/server/pps/server"
 	"github.com/pachyderm/pachyderm/src/server/pps/server/githook"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	flag "github.com/spf13/pflag"
+	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
@@ -79,6 +87,10 @@ type appEnv struct {
 	LogLevel              string `env:"LOG_LEVEL,default=info"`
 	IAMRole               string `env:"IAM_ROLE,default="`
 	ImagePullSecret       string `env:"IMAGE_PULL_SECRET,default="`
+	// AuditLogPath is the file that mutating API calls are recorded in. It
+	// must be on a persistent volume (StorageRoot isn't one), and no audit log
+	// is kept if it's unset.
+	AuditLogPath string `env:"AUDIT_LOG_PATH,default="`
 }
 
 func main() {
@@ -94,6 +106,8 @@ func main() {
 
 func doSidecarMode(appEnvObj interface{}) error {
 	debug.SetGCPercent(50)
+	defer tracing.InstallJaegerTracerFromEnv("pachd").Close()
+	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		log.Println(http.ListenAndServe(":651", nil))
 	}()
@@ -156,8 +170,17 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	healthServer := health.NewHealthServer()
-	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, appEnv.AuthEtcdPrefix)
+	etcdV3Client, err := getEtcdV3Client(etcdAddress)
+	if err != nil {
+		return err
+	}
+	healthServer := health.NewHealthServer(
+		health.EtcdProbe(etcdV3Client),
+		health.Probe{Name: "object-storage", Required: true, Check: blockAPIServer.CheckObjectStorage},
+	)
+	// Sidecars only serve their pipeline's workers, so they don't keep an
+	// audit log
+	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, appEnv.AuthEtcdPrefix, nil)
 	if err != nil {
 		return err
 	}
@@ -177,6 +200,14 @@ func doSidecarMode(appEnvObj interface{}) error {
 		grpcutil.ServeOptions{
 			Version:    version.Version,
 			MaxMsgSize: grpcutil.MaxMsgSize,
+			UnaryInterceptors: []grpc.UnaryServerInterceptor{
+				tracing.UnaryServerInterceptor(),
+				prometheus.UnaryServerInterceptor(),
+			},
+			StreamInterceptors: []grpc.StreamServerInterceptor{
+				tracing.StreamServerInterceptor(),
+				prometheus.StreamServerInterceptor(),
+			},
 		},
 		grpcutil.ServeEnv{
 			GRPCPort: appEnv.Port,
@@ -198,6 +229,8 @@ func doFullMode(appEnvObj interface{}) error {
 		return nil
 	}
 
+	defer tracing.InstallJaegerTracerFromEnv("pachd").Close()
+	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		log.Println(http.ListenAndServe(":651", nil))
 	}()
@@ -232,6 +265,18 @@ func doFullMode(appEnvObj interface{}) error {
 			return err
 		}
 
+		// Also fail if pachd can't reach the dependencies it needs to serve
+		// requests, such as etcd and its object storage bucket.
+		components, err := c.HealthDetailed()
+		if err != nil {
+			return err
+		}
+		for _, component := range components {
+			if component.Required && !component.Healthy {
+				return fmt.Errorf("%s is unhealthy: %s", component.Name, component.Error)
+			}
+		}
+
 		os.Exit(0)
 
 		return nil
@@ -319,23 +364,65 @@ func doFullMode(appEnvObj interface{}) error {
 		return err
 	}
 
-	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, appEnv.AuthEtcdPrefix)
+	var auditLog audit.Log
+	if appEnv.AuditLogPath != "" {
+		auditLog, err = audit.NewFileLog(appEnv.AuditLogPath)
+		if err != nil {
+			return fmt.Errorf("error opening audit log: %v", err)
+		}
+	}
+	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, appEnv.AuthEtcdPrefix, auditLog)
 	if err != nil {
 		return err
 	}
+	// auditUsername identifies the caller in audit log entries
+	auditUsername := func(ctx context.Context) string {
+		user, err := authAPIServer.AuthenticatedUser(ctx)
+		if err != nil {
+			return ""
+		}
+		return user.Username
+	}
 	enterpriseAPIServer, err := eprsserver.NewEnterpriseServer(etcdAddress, appEnv.EnterpriseEtcdPrefix)
 	if err != nil {
 		return err
 	}
 
-	healthServer := health.NewHealthServer()
+	etcdV3Client, err := getEtcdV3Client(etcdAddress)
+	if err != nil {
+		return err
+	}
+	healthServer := health.NewHealthServer(
+		health.EtcdProbe(etcdV3Client),
+		health.Probe{Name: "object-storage", Required: true, Check: blockAPIServer.CheckObjectStorage},
+		health.KubeProbe(kubeClient),
+		health.Probe{Name: "cache-peers", Check: cacheServer.CheckPeers},
+	)
 
 	deployServer := deployserver.NewDeployServer(kubeClient, kubeNamespace)
+	debugServer := debugserver.NewDebugServer(
+		address,
+		etcdV3Client,
+		appEnv.PPSEtcdPrefix,
+		[]string{appEnv.AuthEtcdPrefix, appEnv.EnterpriseEtcdPrefix},
+	)
 
 	httpServer, err := pfs_server.NewHTTPServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, blockCacheBytes)
 	if err != nil {
 		return err
 	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		tracing.UnaryServerInterceptor(),
+		prometheus.UnaryServerInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		tracing.StreamServerInterceptor(),
+		prometheus.StreamServerInterceptor(),
+	}
+	if auditLog != nil {
+		unaryInterceptors = append(unaryInterceptors, audit.UnaryServerInterceptor(auditLog, auditUsername))
+		streamInterceptors = append(streamInterceptors, audit.StreamServerInterceptor(auditLog, auditUsername))
+	}
 	var eg errgroup.Group
 	eg.Go(func() error {
 		return http.ListenAndServe(fmt.Sprintf(":%v", pfs_server.HTTPPort), httpServer)
@@ -354,10 +441,13 @@ func doFullMode(appEnvObj interface{}) error {
 				authclient.RegisterAPIServer(s, authAPIServer)
 				eprsclient.RegisterAPIServer(s, enterpriseAPIServer)
 				deployclient.RegisterAPIServer(s, deployServer)
+				debugclient.RegisterAPIServer(s, debugServer)
 			},
 			grpcutil.ServeOptions{
-				Version:    version.Version,
-				MaxMsgSize: grpcutil.MaxMsgSize,
+				Version:            version.Version,
+				MaxMsgSize:         grpcutil.MaxMsgSize,
+				UnaryInterceptors:  unaryInterceptors,
+				StreamInterceptors: streamInterceptors,
 			},
 			grpcutil.ServeEnv{
 				GRPCPort: appEnv.Port,
@@ -371,6 +461,13 @@ func getEtcdClient(etcdAddress string) discovery.Client {
 	return discovery.NewEtcdClient(etcdAddress)
 }
 
+func getEtcdV3Client(etcdAddress string) (*etcd.Client, error) {
+	return etcd.New(etcd.Config{
+		Endpoints:   []string{etcdAddress},
+		DialOptions: client.EtcdDialOptions(),
+	})
+}
+
 const clusterIDKey = "cluster-id"
 
 func getClusterID(client discovery.Client) (string, error) {
@@ -13,11 +13,14 @@ import (
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prometheus"
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 	"github.com/pachyderm/pachyderm/src/server/worker"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 
 	log "github.com/sirupsen/logrus"
@@ -73,6 +76,8 @@ func getPipelineInfo(etcdClient *etcd.Client, appEnv *appEnv) (*pps.PipelineInfo
 }
 
 func do(appEnvObj interface{}) error {
+	defer tracing.InstallJaegerTracerFromEnv("pachyderm-worker").Close()
+	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		log.Println(http.ListenAndServe(":652", nil))
 	}()
@@ -121,6 +126,14 @@ func do(appEnvObj interface{}) error {
 			grpcutil.ServeOptions{
 				Version:    version.Version,
 				MaxMsgSize: grpcutil.MaxMsgSize,
+				UnaryInterceptors: []grpc.UnaryServerInterceptor{
+					tracing.UnaryServerInterceptor(),
+					prometheus.UnaryServerInterceptor(),
+				},
+				StreamInterceptors: []grpc.StreamServerInterceptor{
+					tracing.StreamServerInterceptor(),
+					prometheus.StreamServerInterceptor(),
+				},
 			},
 			grpcutil.ServeEnv{
 				GRPCPort: client.PPSWorkerPort,
@@ -0,0 +1,72 @@
+package cmds
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/spf13/cobra"
+)
+
+// DumpCmd returns a cobra.Command that writes a tarball of debugging
+// information about a Pachyderm cluster.
+func DumpCmd() *cobra.Command {
+	var output string
+	var logLines int64
+	dump := &cobra.Command{
+		Use:   "dump",
+		Short: "Collect debugging information about a Pachyderm cluster.",
+		Long: `Collect debugging information about a Pachyderm cluster into a gzipped tarball.
+
+The tarball contains goroutine and heap profiles of pachd and every worker,
+the pipeline and job lists, each worker's status, recent pipeline logs and
+the contents of etcd (with auth and enterprise secrets redacted). It's
+intended to be attached to bug reports. The caller must be a cluster admin
+if auth is activated.
+
+Examples:
+
+	# write a dump to debug.tar.gz
+	$ pachctl debug dump -o debug.tar.gz
+
+	# include the last 100 log lines from each pipeline, and extract the dump
+	$ pachctl debug dump --log-lines=100 | tar -xzv`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %s", err.Error())
+			}
+			var w io.Writer = os.Stdout
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				w = f
+			}
+			return c.Dump(logLines, w)
+		}),
+	}
+	dump.Flags().StringVarP(&output, "output", "o", "", "The file to write the dump to. If unset, it's written to stdout.")
+	dump.Flags().Int64Var(&logLines, "log-lines", 0, "The number of recent log lines to include from each pipeline's master and workers. If 0, pachd's default (1000) is used.")
+	return dump
+}
+
+// Cmds returns pachctl commands for debugging Pachyderm itself
+func Cmds() []*cobra.Command {
+	debug := &cobra.Command{
+		Use:   "debug",
+		Short: "Debug commands collect information for diagnosing problems with Pachyderm",
+		Long:  "Debug commands collect information for diagnosing problems with Pachyderm",
+	}
+	debug.AddCommand(DumpCmd())
+	return []*cobra.Command{debug}
+}
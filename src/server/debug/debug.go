@@ -0,0 +1,319 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/debug"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+	"github.com/pachyderm/pachyderm/src/server/worker"
+	"golang.org/x/net/context"
+)
+
+// defaultLogLines is the number of log lines included from each pipeline's
+// master and workers if the request doesn't specify a number.
+const defaultLogLines = 1000
+
+// redacted replaces the values of etcd keys that may hold secrets (such as
+// auth tokens and enterprise activation codes) in the dump.
+const redacted = "<redacted>"
+
+type apiServer struct {
+	address        string
+	etcdClient     *etcd.Client
+	ppsEtcdPrefix  string
+	redactPrefixes []string
+
+	pachClient     *client.APIClient
+	pachClientOnce sync.Once
+}
+
+// NewDebugServer creates a debug server. The values of etcd keys beginning
+// with any of redactPrefixes are left out of the dumps it produces.
+func NewDebugServer(address string, etcdClient *etcd.Client, ppsEtcdPrefix string, redactPrefixes []string) debug.APIServer {
+	return &apiServer{
+		address:        address,
+		etcdClient:     etcdClient,
+		ppsEtcdPrefix:  ppsEtcdPrefix,
+		redactPrefixes: redactPrefixes,
+	}
+}
+
+func (s *apiServer) Dump(request *debug.DumpRequest, server debug.API_DumpServer) (retErr error) {
+	pachClient, err := s.getPachClient()
+	if err != nil {
+		return err
+	}
+	// Forward the caller's auth token, so that the dump only contains what the
+	// caller is allowed to see
+	pachClient = pachClient.WithCtx(server.Context())
+	if me, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{}); err == nil {
+		if !me.IsAdmin {
+			return fmt.Errorf("not authorized to dump cluster state, must be a cluster admin")
+		}
+	} else if !auth.IsNotActivatedError(err) {
+		return fmt.Errorf("could not verify that caller is admin: %v", err)
+	}
+	logLines := request.LogLines
+	if logLines <= 0 {
+		logLines = defaultLogLines
+	}
+
+	gw := gzip.NewWriter(grpcutil.NewStreamingBytesWriter(server))
+	defer func() {
+		if err := gw.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	d := &dumper{tw: tar.NewWriter(gw), start: time.Now()}
+	defer func() {
+		if err := d.tw.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	d.dump("pachd/goroutine", func(w io.Writer) error { return writeProfile("goroutine", 2, w) })
+	d.dump("pachd/heap", func(w io.Writer) error { return writeProfile("heap", 0, w) })
+	d.dump("etcd.json", func(w io.Writer) error { return s.dumpEtcd(server.Context(), w) })
+	var pipelineInfos []*pps.PipelineInfo
+	d.dump("pipelines.json", func(w io.Writer) error {
+		resp, err := pachClient.PpsAPIClient.ListPipeline(pachClient.Ctx(), &pps.ListPipelineRequest{})
+		if err != nil {
+			return err
+		}
+		pipelineInfos = resp.PipelineInfo
+		redactPipelineInfos(pipelineInfos)
+		return writeJSON(resp, w)
+	})
+	d.dump("jobs.json", func(w io.Writer) error {
+		resp, err := pachClient.PpsAPIClient.ListJob(pachClient.Ctx(), &pps.ListJobRequest{})
+		if err != nil {
+			return err
+		}
+		return writeJSON(resp, w)
+	})
+	for _, pipelineInfo := range pipelineInfos {
+		name := pipelineInfo.Pipeline.Name
+		dir := path.Join("pipelines", name)
+		d.dump(path.Join(dir, "master.log"), func(w io.Writer) error {
+			return writeLogs(pachClient.GetLogs(name, "", nil, "", true, false, logLines), w)
+		})
+		d.dump(path.Join(dir, "worker.log"), func(w io.Writer) error {
+			return writeLogs(pachClient.GetLogs(name, "", nil, "", false, false, logLines), w)
+		})
+		workerClients, err := worker.Clients(server.Context(), ppsserver.PipelineRcName(name, pipelineInfo.Version), s.etcdClient, s.ppsEtcdPrefix)
+		if err != nil {
+			d.dumpError(path.Join(dir, "workers"), err)
+			continue
+		}
+		for i, workerClient := range workerClients {
+			workerClient := workerClient
+			workerDir := path.Join(dir, "workers", fmt.Sprint(i))
+			d.dump(path.Join(workerDir, "status.json"), func(w io.Writer) error {
+				status, err := workerClient.Status(server.Context(), &types.Empty{})
+				if err != nil {
+					return err
+				}
+				return writeJSON(status, w)
+			})
+			d.dump(path.Join(workerDir, "goroutine"), func(w io.Writer) error {
+				return writeWorkerProfile(server.Context(), workerClient, "goroutine", 2, w)
+			})
+			d.dump(path.Join(workerDir, "heap"), func(w io.Writer) error {
+				return writeWorkerProfile(server.Context(), workerClient, "heap", 0, w)
+			})
+		}
+	}
+	return d.err
+}
+
+// dumpEtcd writes every key in etcd and its value to w as a JSON object.
+// Values that may hold secrets are redacted (see redactKVs).
+func (s *apiServer) dumpEtcd(ctx context.Context, w io.Writer) error {
+	resp, err := s.etcdClient.Get(ctx, "", etcd.WithPrefix())
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s.redactKVs(resp.Kvs))
+}
+
+// redactKVs returns the keys and values in kvs as a map, with the values that
+// may hold secrets redacted (see redactValue).
+func (s *apiServer) redactKVs(kvs []*mvccpb.KeyValue) map[string]string {
+	result := make(map[string]string)
+	for _, kv := range kvs {
+		result[string(kv.Key)] = s.redactValue(string(kv.Key), kv.Value)
+	}
+	return result
+}
+
+// redactValue returns the value of the etcd key 'key' as it appears in the
+// dump. Values under the redacted prefixes are replaced, and the auth tokens
+// (capabilities) are removed from the pipelines stored under the PPS prefix.
+func (s *apiServer) redactValue(key string, value []byte) string {
+	for _, prefix := range s.redactPrefixes {
+		if hasPrefix(key, prefix) {
+			return redacted
+		}
+	}
+	for _, pipelines := range []col.Collection{
+		ppsdb.Pipelines(nil, s.ppsEtcdPrefix),
+		ppsdb.PipelineVersions(nil, s.ppsEtcdPrefix),
+	} {
+		if hasPrefix(key, pipelines.Path("")+"/") {
+			return redactPipelineInfo(value)
+		}
+	}
+	return string(value)
+}
+
+// hasPrefix returns true if the etcd key 'key' begins with 'prefix', ignoring
+// leading slashes
+func hasPrefix(key string, prefix string) bool {
+	return strings.HasPrefix(strings.TrimPrefix(key, "/"), strings.TrimPrefix(prefix, "/"))
+}
+
+// redactPipelineInfo removes the capability from 'value', a serialized
+// PipelineInfo. If 'value' can't be parsed, it's redacted entirely, as it may
+// still hold the capability.
+func redactPipelineInfo(value []byte) string {
+	pipelineInfo := &pps.PipelineInfo{}
+	if err := proto.Unmarshal(value, pipelineInfo); err != nil {
+		return redacted
+	}
+	pipelineInfo.Capability = ""
+	result, err := proto.Marshal(pipelineInfo)
+	if err != nil {
+		return redacted
+	}
+	return string(result)
+}
+
+// redactPipelineInfos removes the capabilities from pipelineInfos, so that
+// they aren't included in the dump
+func redactPipelineInfos(pipelineInfos []*pps.PipelineInfo) {
+	for _, pipelineInfo := range pipelineInfos {
+		pipelineInfo.Capability = ""
+	}
+}
+
+func (s *apiServer) getPachClient() (*client.APIClient, error) {
+	if s.pachClient == nil {
+		var onceErr error
+		s.pachClientOnce.Do(func() {
+			s.pachClient, onceErr = client.NewFromAddress(s.address)
+		})
+		if onceErr != nil {
+			return nil, onceErr
+		}
+	}
+	return s.pachClient, nil
+}
+
+// dumper writes files to a tarball. A file that can't be produced is
+// replaced by a "<name>.error" file holding the error, so that one
+// unreachable component doesn't prevent the rest of the dump.
+type dumper struct {
+	tw    *tar.Writer
+	start time.Time
+	// err is set if writing to the tarball itself fails, at which point
+	// nothing more is written
+	err error
+}
+
+func (d *dumper) dump(name string, f func(w io.Writer) error) {
+	if d.err != nil {
+		return
+	}
+	var buf bytes.Buffer
+	if err := f(&buf); err != nil {
+		d.dumpError(name, err)
+		return
+	}
+	d.writeFile(name, buf.Bytes())
+}
+
+func (d *dumper) dumpError(name string, err error) {
+	d.writeFile(name+".error", []byte(grpcutil.ScrubGRPC(err).Error()+"\n"))
+}
+
+func (d *dumper) writeFile(name string, data []byte) {
+	if d.err != nil {
+		return
+	}
+	if err := d.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: d.start,
+	}); err != nil {
+		d.err = err
+		return
+	}
+	if _, err := d.tw.Write(data); err != nil {
+		d.err = err
+	}
+}
+
+func writeProfile(name string, debugLevel int, w io.Writer) error {
+	profile := pprof.Lookup(name)
+	if profile == nil {
+		return fmt.Errorf("unable to find profile %q", name)
+	}
+	return profile.WriteTo(w, debugLevel)
+}
+
+func writeWorkerProfile(ctx context.Context, workerClient worker.WorkerClient, name string, debugLevel int64, w io.Writer) error {
+	profileClient, err := workerClient.Profile(ctx, &worker.ProfileRequest{
+		Profile: name,
+		Debug:   debugLevel,
+	})
+	if err != nil {
+		return err
+	}
+	return grpcutil.WriteFromStreamingBytesClient(profileClient, w)
+}
+
+func writeLogs(iter *client.LogsIter, w io.Writer) error {
+	marshaler := &jsonpb.Marshaler{}
+	for iter.Next() {
+		if err := marshaler.Marshal(w, iter.Message()); err != nil {
+			return err
+		}
+		if _, err := w.Write([]byte("\n")); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+func writeJSON(msg proto.Message, w io.Writer) error {
+	marshaler := &jsonpb.Marshaler{Indent: "  "}
+	if err := marshaler.Marshal(w, msg); err != nil {
+		return err
+	}
+	_, err := w.Write([]byte("\n"))
+	return err
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+)
+
+const capability = "secret-capability-token"
+
+func TestRedactKVs(t *testing.T) {
+	s := &apiServer{
+		ppsEtcdPrefix:  "pachyderm_pps",
+		redactPrefixes: []string{"pachyderm_auth"},
+	}
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:   &pps.Pipeline{Name: "pipeline"},
+		Capability: capability,
+	}
+	value, err := proto.Marshal(pipelineInfo)
+	require.NoError(t, err)
+	pipelineKey := ppsdb.Pipelines(nil, s.ppsEtcdPrefix).Path("pipeline")
+	versionKey := ppsdb.PipelineVersions(nil, s.ppsEtcdPrefix).Path(ppsdb.PipelineVersionKey("pipeline", 1))
+	kvs := s.redactKVs([]*mvccpb.KeyValue{
+		{Key: []byte(pipelineKey), Value: value},
+		{Key: []byte(versionKey), Value: value},
+		{Key: []byte("pachyderm_pps/pipelines/corrupt"), Value: []byte(capability)},
+		{Key: []byte("pachyderm_auth/tokens/abc"), Value: []byte(capability)},
+		{Key: []byte("pachyderm_pfs/repos/repo"), Value: []byte("repo")},
+	})
+
+	for key, value := range kvs {
+		require.False(t, strings.Contains(value, capability), "%s holds a capability", key)
+	}
+	// The rest of each pipeline is kept
+	for _, key := range []string{pipelineKey, versionKey} {
+		redactedInfo := &pps.PipelineInfo{}
+		require.NoError(t, proto.Unmarshal([]byte(kvs[key]), redactedInfo))
+		require.Equal(t, "pipeline", redactedInfo.Pipeline.Name)
+	}
+	require.Equal(t, redacted, kvs["pachyderm_auth/tokens/abc"])
+	require.Equal(t, "repo", kvs["pachyderm_pfs/repos/repo"])
+}
+
+func TestRedactPipelineInfos(t *testing.T) {
+	pipelineInfos := []*pps.PipelineInfo{
+		{Pipeline: &pps.Pipeline{Name: "a"}, Capability: capability},
+		{Pipeline: &pps.Pipeline{Name: "b"}, Capability: capability},
+	}
+	redactPipelineInfos(pipelineInfos)
+	var buf bytes.Buffer
+	require.NoError(t, writeJSON(&pps.PipelineInfos{PipelineInfo: pipelineInfos}, &buf))
+	require.False(t, strings.Contains(buf.String(), capability))
+	require.True(t, strings.Contains(buf.String(), `"b"`))
+}
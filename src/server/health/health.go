@@ -1,18 +1,133 @@
 package health
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/health"
 	"golang.org/x/net/context"
+	kube "k8s.io/client-go/kubernetes"
 )
 
-// NewHealthServer returns a new health server
-func NewHealthServer() health.HealthServer {
-	return &healthServer{}
+// probeTimeout is how long a probe may take before its component is
+// reported as unhealthy.
+const probeTimeout = 10 * time.Second
+
+// Probe checks whether pachd can reach one of its dependencies.
+type Probe struct {
+	// Name identifies the dependency in HealthDetailed's response.
+	Name string
+	// Required is true if pachd can't serve requests while the dependency is
+	// unreachable. pachd's readiness check fails if a required probe fails.
+	Required bool
+	// Check returns an error if the dependency can't be reached.
+	Check func(ctx context.Context) error
+}
+
+// EtcdProbe returns a Probe that reads from etcd.
+func EtcdProbe(etcdClient *etcd.Client) Probe {
+	return Probe{
+		Name:     "etcd",
+		Required: true,
+		Check: func(ctx context.Context) error {
+			_, err := etcdClient.Get(ctx, "health", etcd.WithCountOnly())
+			return err
+		},
+	}
+}
+
+// KubeProbe returns a Probe that asks the Kubernetes API for its version.
+// It isn't required, since PFS keeps working without Kubernetes and an
+// unreachable API server would otherwise take every pachd out of service.
+func KubeProbe(kubeClient *kube.Clientset) Probe {
+	return Probe{
+		Name: "kubernetes",
+		Check: func(ctx context.Context) error {
+			_, err := kubeClient.Discovery().ServerVersion()
+			return err
+		},
+	}
+}
+
+// NewHealthServer returns a new health server that runs probes when
+// HealthDetailed is called.
+func NewHealthServer(probes ...Probe) health.HealthServer {
+	return &healthServer{
+		probes:     probes,
+		lastErrors: make(map[string]*lastError),
+	}
 }
 
-type healthServer struct{}
+type lastError struct {
+	err  string
+	time *types.Timestamp
+}
+
+type healthServer struct {
+	probes []Probe
+
+	// lastErrors maps probe names to the most recent error they returned
+	lastErrors   map[string]*lastError
+	lastErrorsMu sync.Mutex
+}
 
 func (*healthServer) Health(context.Context, *types.Empty) (*types.Empty, error) {
 	return &types.Empty{}, nil
 }
+
+func (s *healthServer) HealthDetailed(ctx context.Context, request *types.Empty) (*health.HealthDetailedResponse, error) {
+	response := &health.HealthDetailedResponse{
+		Components: make([]*health.ComponentHealth, len(s.probes)),
+	}
+	var wg sync.WaitGroup
+	for i, probe := range s.probes {
+		i, probe := i, probe
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response.Components[i] = s.runProbe(ctx, probe)
+		}()
+	}
+	wg.Wait()
+	return response, nil
+}
+
+func (s *healthServer) runProbe(ctx context.Context, probe Probe) *health.ComponentHealth {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- probe.Check(ctx)
+	}()
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		// Some checks (e.g. the Kubernetes client's) don't take a context,
+		// so stop waiting for them here.
+		err = fmt.Errorf("probe did not finish: %v", ctx.Err())
+	}
+	result := &health.ComponentHealth{
+		Name:     probe.Name,
+		Healthy:  err == nil,
+		Required: probe.Required,
+		Latency:  types.DurationProto(time.Since(start)),
+	}
+	s.lastErrorsMu.Lock()
+	defer s.lastErrorsMu.Unlock()
+	if err != nil {
+		result.Error = err.Error()
+		if ts, err := types.TimestampProto(time.Now()); err == nil {
+			s.lastErrors[probe.Name] = &lastError{err: result.Error, time: ts}
+		}
+	}
+	if last, ok := s.lastErrors[probe.Name]; ok {
+		result.LastError = last.err
+		result.LastErrorTime = last.time
+	}
+	return result
+}
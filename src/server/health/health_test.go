@@ -0,0 +1,40 @@
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"golang.org/x/net/context"
+)
+
+func TestHealthDetailed(t *testing.T) {
+	var bucketErr error
+	server := NewHealthServer(
+		Probe{Name: "etcd", Required: true, Check: func(context.Context) error { return nil }},
+		Probe{Name: "bucket", Required: true, Check: func(context.Context) error { return bucketErr }},
+	).(*healthServer)
+
+	bucketErr = errors.New("access denied")
+	resp, err := server.HealthDetailed(context.Background(), &types.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Components))
+	require.Equal(t, "etcd", resp.Components[0].Name)
+	require.True(t, resp.Components[0].Healthy)
+	require.Equal(t, "", resp.Components[0].LastError)
+	require.Equal(t, "bucket", resp.Components[1].Name)
+	require.False(t, resp.Components[1].Healthy)
+	require.True(t, resp.Components[1].Required)
+	require.Equal(t, "access denied", resp.Components[1].Error)
+	require.NotNil(t, resp.Components[1].Latency)
+
+	// The bucket recovers, but its last error is still reported
+	bucketErr = nil
+	resp, err = server.HealthDetailed(context.Background(), &types.Empty{})
+	require.NoError(t, err)
+	require.True(t, resp.Components[1].Healthy)
+	require.Equal(t, "", resp.Components[1].Error)
+	require.Equal(t, "access denied", resp.Components[1].LastError)
+	require.NotNil(t, resp.Components[1].LastErrorTime)
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/base64"
 	"encoding/hex"
@@ -18,6 +20,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,6 +42,7 @@ import (
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 	ppspretty "github.com/pachyderm/pachyderm/src/server/pps/pretty"
 	"github.com/pachyderm/pachyderm/src/server/pps/server/githook"
+	"github.com/pachyderm/pachyderm/src/server/worker"
 
 	"github.com/gogo/protobuf/types"
 	apps "k8s.io/api/apps/v1beta2"
@@ -1504,6 +1508,67 @@ func TestAcceptReturnCode(t *testing.T) {
 	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
 }
 
+func TestErrCmd(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := uniqueString("TestErrCmd_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "good", strings.NewReader("foo"))
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "bad", strings.NewReader("bar"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	pipelineName := uniqueString("TestErrCmd")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: &pps.Pipeline{pipelineName},
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("if [ -f /pfs/%s/bad ]; then exit 1; fi", dataRepo),
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+				ErrCmd: []string{"bash"},
+				ErrStdin: []string{
+					fmt.Sprintf("echo recovered >/pfs/out/$(ls /pfs/%s)", dataRepo),
+				},
+			},
+			Input: client.NewAtomInput(dataRepo, "/*"),
+		},
+	)
+	require.NoError(t, err)
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, commitInfos[0].Commit.ID, "good", 0, 0, &buf))
+	require.Equal(t, "foo", buf.String())
+	buf.Reset()
+	require.NoError(t, c.GetFile(pipelineName, commitInfos[0].Commit.ID, "bad", 0, 0, &buf))
+	require.Equal(t, "recovered\n", buf.String())
+
+	jobInfos, err := c.ListJob(pipelineName, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	jobInfo, err := c.InspectJob(jobInfos[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(0), jobInfo.DataFailed)
+}
+
 // TODO(msteffen): This test breaks the suite when run against cloud providers,
 // because killing the pachd pod breaks the connection with pachctl port-forward
 func TestRestartAll(t *testing.T) {
@@ -1876,6 +1941,549 @@ func TestUpdatePipeline(t *testing.T) {
 	require.Equal(t, "buzz\n", buffer.String())
 }
 
+func TestPipelineVersions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestPipelineVersions_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := uniqueString("TestPipelineVersions_pipeline")
+	createPipeline := func(stdin string, update bool) {
+		require.NoError(t, c.CreatePipeline(
+			pipelineName,
+			"",
+			[]string{"bash"},
+			[]string{stdin},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewAtomInput(dataRepo, "/*"),
+			"",
+			update,
+		))
+	}
+	createPipeline("echo foo >/pfs/out/file", false)
+	createPipeline("echo bar >/pfs/out/file", true)
+
+	pipelineInfos, err := c.ListPipelineVersions(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pipelineInfos))
+	require.Equal(t, uint64(2), pipelineInfos[0].Version)
+	require.Equal(t, uint64(1), pipelineInfos[1].Version)
+
+	pipelineInfo, err := c.InspectPipelineVersion(pipelineName, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pipelineInfo.Version)
+	require.Equal(t, []string{"echo foo >/pfs/out/file"}, pipelineInfo.Transform.Stdin)
+
+	_, err = c.InspectPipelineVersion(pipelineName, 5)
+	require.YesError(t, err)
+
+	// Rolling back creates a new version with the old spec
+	require.NoError(t, c.RollbackPipeline(pipelineName, 1, false))
+	pipelineInfo, err = c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), pipelineInfo.Version)
+	require.Equal(t, []string{"echo foo >/pfs/out/file"}, pipelineInfo.Transform.Stdin)
+
+	pipelineInfos, err = c.ListPipelineVersions(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pipelineInfos))
+
+	// Deleting the pipeline deletes its history
+	require.NoError(t, c.DeletePipeline(pipelineName, false))
+	_, err = c.ListPipelineVersions(pipelineName)
+	require.YesError(t, err)
+}
+
+func TestDeletePipelineKeepsOtherVersions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestDeletePipelineKeepsOtherVersions_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	// The second pipeline's name starts with the first's
+	pipeline := uniqueString("TestDeletePipelineKeepsOtherVersions_pipeline")
+	otherPipeline := pipeline + "bar"
+	for _, name := range []string{pipeline, otherPipeline} {
+		for _, update := range []bool{false, true} {
+			require.NoError(t, c.CreatePipeline(
+				name,
+				"",
+				[]string{"bash"},
+				[]string{"echo foo >/pfs/out/file"},
+				&pps.ParallelismSpec{
+					Constant: 1,
+				},
+				client.NewAtomInput(dataRepo, "/*"),
+				"",
+				update,
+			))
+		}
+	}
+
+	// Each pipeline only lists its own versions
+	pipelineInfos, err := c.ListPipelineVersions(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pipelineInfos))
+
+	// Deleting the first pipeline leaves the second's history alone
+	require.NoError(t, c.DeletePipeline(pipeline, false))
+	pipelineInfos, err = c.ListPipelineVersions(otherPipeline)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pipelineInfos))
+	pipelineInfo, err := c.InspectPipelineVersion(otherPipeline, 1)
+	require.NoError(t, err)
+	require.Equal(t, otherPipeline, pipelineInfo.Pipeline.Name)
+}
+
+func TestPipelineTemplate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	dataRepo := uniqueString("TestPipelineTemplate_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := uniqueString("TestPipelineTemplate_pipeline")
+	template := &pps.PipelineTemplate{
+		Template: `{"pipeline": {"name": "{{.name}}"}, "input": {"atom": {"repo": "{{.repo}}", "glob": "/*"}}}`,
+		Args: map[string]string{
+			"name": pipelineName,
+			"repo": dataRepo,
+		},
+	}
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipelineName),
+		Transform: &pps.Transform{
+			Cmd:   []string{"bash"},
+			Stdin: []string{"cp /pfs/*/* /pfs/out/"},
+		},
+		Input:    client.NewAtomInput(dataRepo, "/*"),
+		Template: template,
+	})
+	require.NoError(t, err)
+
+	pipelineInfo, err := c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, template.Template, pipelineInfo.Template.Template)
+	require.Equal(t, template.Args, pipelineInfo.Template.Args)
+}
+
+func TestHealthDetailed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	components, err := c.HealthDetailed()
+	require.NoError(t, err)
+	healthy := make(map[string]bool)
+	for _, component := range components {
+		healthy[component.Name] = component.Healthy
+		require.NotNil(t, component.Latency)
+	}
+	require.True(t, healthy["etcd"])
+	require.True(t, healthy["object-storage"])
+	require.True(t, healthy["kubernetes"])
+}
+
+func TestDebugDump(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestDebugDump_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	pipeline := uniqueString("TestDebugDump_pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collectCommitInfos(t, commitIter)))
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Dump(10, &buf))
+	gr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	files := make(map[string]bool)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		files[hdr.Name] = true
+	}
+	for _, name := range []string{
+		"pachd/goroutine",
+		"pachd/heap",
+		"etcd.json",
+		"pipelines.json",
+		"jobs.json",
+		path.Join("pipelines", pipeline, "master.log"),
+		path.Join("pipelines", pipeline, "worker.log"),
+		path.Join("pipelines", pipeline, "workers", "0", "status.json"),
+		path.Join("pipelines", pipeline, "workers", "0", "goroutine"),
+	} {
+		require.True(t, files[name], "dump is missing %s", name)
+	}
+}
+
+func TestInspectDAG(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := uniqueString("TestInspectDAG_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	otherRepo := uniqueString("TestInspectDAG_other")
+	require.NoError(t, c.CreateRepo(otherRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	pipeline1 := uniqueString("TestInspectDAG_pipeline1")
+	pipeline2 := uniqueString("TestInspectDAG_pipeline2")
+	for _, pipeline := range [][2]string{{pipeline1, dataRepo}, {pipeline2, pipeline1}} {
+		require.NoError(t, c.CreatePipeline(
+			pipeline[0],
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", pipeline[1])},
+			nil,
+			client.NewAtomInput(pipeline[1], "/*"),
+			"",
+			false,
+		))
+	}
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(collectCommitInfos(t, commitIter)))
+
+	nodes, err := c.InspectDAG("")
+	require.NoError(t, err)
+	var names []string
+	for _, node := range nodes {
+		names = append(names, node.Repo.Name)
+	}
+	require.Equal(t, []string{dataRepo, otherRepo, pipeline1, pipeline2}, names)
+	require.Nil(t, nodes[0].Pipeline)
+	require.Equal(t, 0, len(nodes[0].Parents))
+	require.Equal(t, pipeline1, nodes[2].Pipeline.Name)
+	require.Equal(t, dataRepo, nodes[2].Parents[0].Name)
+	require.Equal(t, pps.PipelineState_PIPELINE_RUNNING, nodes[2].State)
+	require.NotNil(t, nodes[2].LastJob)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, nodes[2].LastJobState)
+	require.Equal(t, int32(1), nodes[2].JobCounts[int32(pps.JobState_JOB_SUCCESS)])
+	require.Equal(t, pipeline1, nodes[3].Parents[0].Name)
+
+	// Only the repos related to pipeline1 are returned
+	nodes, err = c.InspectDAG(pipeline1)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(nodes))
+	for _, node := range nodes {
+		require.NotEqual(t, otherRepo, node.Repo.Name)
+	}
+
+	_, err = c.InspectDAG(uniqueString("TestInspectDAG_missing"))
+	require.YesError(t, err)
+}
+
+func TestRunLocal(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := getPachClient(t)
+
+	dataRepo := uniqueString("TestRunLocal_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "file1", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "file2", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	pipeline := uniqueString("TestRunLocal")
+	outputDir, err := ioutil.TempDir("", "TestRunLocal")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	require.NoError(t, worker.RunLocal(context.Background(), c, &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Cmd:   []string{"sh"},
+			Stdin: []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		},
+		Input: client.NewAtomInput(dataRepo, "/*"),
+	}, &worker.LocalOptions{OutputDir: outputDir}))
+
+	contents, err := ioutil.ReadFile(filepath.Join(outputDir, "file1"))
+	require.NoError(t, err)
+	require.Equal(t, "foo\n", string(contents))
+	contents, err = ioutil.ReadFile(filepath.Join(outputDir, "file2"))
+	require.NoError(t, err)
+	require.Equal(t, "bar\n", string(contents))
+
+	// Nothing is committed in pachd
+	_, err = c.InspectRepo(pipeline)
+	require.YesError(t, err)
+}
+
+func TestRunLocalInputDir(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "TestRunLocalInputDir_input")
+	require.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(inputDir, "data", "dir"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "data", "file1"), []byte("foo\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "data", "dir", "file2"), []byte("bar\n"), 0644))
+	outputDir, err := ioutil.TempDir("", "TestRunLocalInputDir_output")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	// Every datum appends the files it sees to the same output file
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("TestRunLocalInputDir"),
+		Transform: &pps.Transform{
+			Cmd:   []string{"sh"},
+			Stdin: []string{"find /pfs/data -type f | sort | xargs cat >> /pfs/out/all"},
+		},
+		Input: client.NewAtomInput("data", "/*"),
+	}
+	require.NoError(t, worker.RunLocal(context.Background(), nil, request, &worker.LocalOptions{
+		InputDir:  inputDir,
+		OutputDir: outputDir,
+	}))
+	contents, err := ioutil.ReadFile(filepath.Join(outputDir, "all"))
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(contents), "\n"))
+	require.True(t, strings.Contains(string(contents), "foo\n"))
+	require.True(t, strings.Contains(string(contents), "bar\n"))
+
+	// A failing datum fails the run, unless failed datums are skipped
+	require.NoError(t, os.RemoveAll(outputDir))
+	request.Transform.Stdin = []string{"test -f /pfs/data/file1"}
+	require.YesError(t, worker.RunLocal(context.Background(), nil, request, &worker.LocalOptions{
+		InputDir:  inputDir,
+		OutputDir: outputDir,
+	}))
+	request.SkipFailedDatums = true
+	require.NoError(t, worker.RunLocal(context.Background(), nil, request, &worker.LocalOptions{
+		InputDir:  inputDir,
+		OutputDir: outputDir,
+	}))
+}
+
+func TestListDatumInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo1 := uniqueString("TestListDatumInput_data1")
+	require.NoError(t, c.CreateRepo(dataRepo1))
+	dataRepo2 := uniqueString("TestListDatumInput_data2")
+	require.NoError(t, c.CreateRepo(dataRepo2))
+
+	_, err := c.StartCommit(dataRepo1, "master")
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = c.PutFile(dataRepo1, "master", fmt.Sprintf("file%d", i), strings.NewReader("foo"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(dataRepo1, "master"))
+	_, err = c.StartCommit(dataRepo2, "master")
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = c.PutFile(dataRepo2, "master", fmt.Sprintf("file%d", i), strings.NewReader("bar"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(dataRepo2, "master"))
+
+	resp, err := c.ListDatumInput(client.NewAtomInput(dataRepo1, "/*"), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.DatumInfos))
+	resp, err = c.ListDatumInput(client.NewAtomInput(dataRepo1, "/"), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.DatumInfos))
+
+	input := client.NewCrossInput(
+		client.NewAtomInput(dataRepo1, "/*"),
+		client.NewAtomInput(dataRepo2, "/*"),
+	)
+	resp, err = c.ListDatumInput(input, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(resp.DatumInfos))
+	for _, datumInfo := range resp.DatumInfos {
+		require.Equal(t, 2, len(datumInfo.Data))
+	}
+	resp, err = c.ListDatumInput(input, 4, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.DatumInfos))
+	require.Equal(t, int64(2), resp.TotalPages)
+
+	// Inputs from repos that don't exist are an error
+	_, err = c.ListDatumInput(client.NewAtomInput(uniqueString("TestListDatumInput_missing"), "/*"), 0, 0)
+	require.YesError(t, err)
+}
+
+func TestJoinInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	images := uniqueString("TestJoinInput_images")
+	require.NoError(t, c.CreateRepo(images))
+	labels := uniqueString("TestJoinInput_labels")
+	require.NoError(t, c.CreateRepo(labels))
+
+	_, err := c.StartCommit(images, "master")
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		_, err = c.PutFile(images, "master", fmt.Sprintf("%d.png", i), strings.NewReader("image"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(images, "master"))
+	_, err = c.StartCommit(labels, "master")
+	require.NoError(t, err)
+	for i := 2; i < 6; i++ {
+		_, err = c.PutFile(labels, "master", fmt.Sprintf("%d.json", i), strings.NewReader("label"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(labels, "master"))
+
+	// Only 2 and 3 are in both repos
+	resp, err := c.ListDatumInput(client.NewJoinInput(
+		client.NewJoinAtomInput(images, "/(*).png", "$1", false),
+		client.NewJoinAtomInput(labels, "/(*).json", "$1", false),
+	), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.DatumInfos))
+	for i, datumInfo := range resp.DatumInfos {
+		require.Equal(t, 2, len(datumInfo.Data))
+		require.Equal(t, fmt.Sprintf("/%d.png", i+2), datumInfo.Data[0].File.Path)
+		require.Equal(t, fmt.Sprintf("/%d.json", i+2), datumInfo.Data[1].File.Path)
+	}
+
+	// An outer join on images also produces datums for 0 and 1
+	resp, err = c.ListDatumInput(client.NewJoinInput(
+		client.NewJoinAtomInput(images, "/(*).png", "$1", true),
+		client.NewJoinAtomInput(labels, "/(*).json", "$1", false),
+	), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.DatumInfos))
+	require.Equal(t, 1, len(resp.DatumInfos[0].Data))
+	require.Equal(t, "/0.png", resp.DatumInfos[0].Data[0].File.Path)
+
+	// Pipelines can't reference capture groups that don't exist
+	require.YesError(t, c.CreatePipeline(
+		uniqueString("TestJoinInput_pipeline"),
+		"",
+		[]string{"bash"},
+		nil,
+		nil,
+		client.NewJoinInput(
+			client.NewJoinAtomInput(images, "/(*).png", "$2", false),
+			client.NewJoinAtomInput(labels, "/(*).json", "$1", false),
+		),
+		"",
+		false,
+	))
+}
+
+func TestGroupInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	shards := uniqueString("TestGroupInput_shards")
+	require.NoError(t, c.CreateRepo(shards))
+	config := uniqueString("TestGroupInput_config")
+	require.NoError(t, c.CreateRepo(config))
+
+	_, err := c.StartCommit(shards, "master")
+	require.NoError(t, err)
+	for _, customer := range []string{"a", "b", "c"} {
+		for i := 0; i < 3; i++ {
+			_, err = c.PutFile(shards, "master", fmt.Sprintf("%s-%d", customer, i), strings.NewReader("shard"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, c.FinishCommit(shards, "master"))
+	_, err = c.StartCommit(config, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(config, "master", "config", strings.NewReader("config"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(config, "master"))
+
+	group := client.NewGroupInput(client.NewGroupAtomInput(shards, "/(*)-*", "$1"))
+	resp, err := c.ListDatumInput(group, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.DatumInfos))
+	for _, datumInfo := range resp.DatumInfos {
+		require.Equal(t, 3, len(datumInfo.Data))
+	}
+
+	// Datums hash the same way until their files change
+	resp2, err := c.ListDatumInput(group, 0, 0)
+	require.NoError(t, err)
+	for i := range resp.DatumInfos {
+		require.Equal(t, resp.DatumInfos[i].Datum.ID, resp2.DatumInfos[i].Datum.ID)
+	}
+	_, err = c.StartCommit(shards, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(shards, "master", "a-0", strings.NewReader("more"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(shards, "master"))
+	resp2, err = c.ListDatumInput(group, 0, 0)
+	require.NoError(t, err)
+	require.NotEqual(t, resp.DatumInfos[0].Datum.ID, resp2.DatumInfos[0].Datum.ID)
+	require.Equal(t, resp.DatumInfos[1].Datum.ID, resp2.DatumInfos[1].Datum.ID)
+
+	// Groups can be crossed with other inputs
+	resp, err = c.ListDatumInput(client.NewCrossInput(group, client.NewAtomInput(config, "/")), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.DatumInfos))
+	for _, datumInfo := range resp.DatumInfos {
+		require.Equal(t, 4, len(datumInfo.Data))
+	}
+}
+
 func TestManyFilesSingleCommit(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -5296,6 +5904,93 @@ func TestService(t *testing.T) {
 	}, backoff.NewTestingBackOff()))
 }
 
+func TestSpout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	// Spouts can't have an input
+	dataRepo := uniqueString("TestSpout_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline:  client.NewPipeline(uniqueString("TestSpout_invalid")),
+			Transform: &pps.Transform{Cmd: []string{"true"}},
+			Input:     client.NewAtomInput(dataRepo, "/*"),
+			Spout:     &pps.Spout{},
+		},
+	)
+	require.YesError(t, err)
+
+	pipeline := uniqueString("TestSpout")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					"while true; do",
+					"  echo foo >/tmp/file",
+					"  tar -cf /pfs/out -C /tmp file",
+					"  sleep 5",
+					"done",
+				},
+			},
+			Spout: &pps.Spout{},
+		},
+	)
+	require.NoError(t, err)
+
+	// A downstream pipeline sees the spout's output like any other input
+	downstreamPipeline := uniqueString("TestSpout_downstream")
+	require.NoError(t, c.CreatePipeline(
+		downstreamPipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/file /pfs/out/file", pipeline)},
+		nil,
+		client.NewAtomInput(pipeline, "/"),
+		"",
+		false,
+	))
+
+	// Each batch the spout writes is its own commit, and files are appended
+	// to by default
+	require.NoError(t, backoff.Retry(func() error {
+		commitInfos, err := c.ListCommit(pipeline, "master", "", 0)
+		if err != nil {
+			return err
+		}
+		if len(commitInfos) < 2 {
+			return fmt.Errorf("expected at least 2 commits, got %d", len(commitInfos))
+		}
+		var buf bytes.Buffer
+		if err := c.GetFile(pipeline, "master", "file", 0, 0, &buf); err != nil {
+			return err
+		}
+		if !strings.HasPrefix(buf.String(), "foo\nfoo\n") {
+			return fmt.Errorf("expected file to have been appended to, got %q", buf.String())
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{client.NewCommit(pipeline, "master")}, []*pfs.Repo{client.NewRepo(downstreamPipeline)})
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(downstreamPipeline, commitInfos[0].Commit.ID, "file", 0, 0, &buf))
+	require.True(t, strings.HasPrefix(buf.String(), "foo\n"))
+
+	jobInfos, err := c.ListJob(pipeline, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(jobInfos))
+}
+
 func TestChunkSpec(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -6282,6 +6977,82 @@ func TestPipelineWithJobTimeout(t *testing.T) {
 	require.True(t, epsilon <= 1.0)
 }
 
+func TestPipelineSkipFailedDatums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := uniqueString("TestPipelineSkipFailedDatums_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit1.ID, "good", strings.NewReader("foo"))
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit1.ID, "bad", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	pipeline := uniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("if [ -f /pfs/%s/bad ]; then exit 1; fi", dataRepo),
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			Input:            client.NewAtomInput(dataRepo, "/*"),
+			EnableStats:      true,
+			DatumTries:       2,
+			SkipFailedDatums: true,
+		},
+	)
+	require.NoError(t, err)
+
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pipelineInfo.DatumTries)
+	require.True(t, pipelineInfo.SkipFailedDatums)
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit1}, []*pfs.Repo{client.NewRepo(pipeline)})
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	// Only the good datum's output makes it into the output commit
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(pipeline, commitInfos[0].Commit.ID, "good", 0, 0, &buf))
+	require.Equal(t, "foo", buf.String())
+	_, err = c.InspectFile(pipeline, commitInfos[0].Commit.ID, "bad")
+	require.YesError(t, err)
+
+	jobs, err := c.ListJob(pipeline, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+	jobInfo, err := c.InspectJob(jobs[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, int64(1), jobInfo.DataFailed)
+
+	resp, err := c.ListDatum(jobInfo.Job.ID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.DatumInfos))
+	var states []pps.DatumState
+	for _, datumInfo := range resp.DatumInfos {
+		states = append(states, datumInfo.State)
+	}
+	require.OneOfEquals(t, pps.DatumState_FAILED, states)
+	require.OneOfEquals(t, pps.DatumState_SUCCESS, states)
+}
+
 func TestCommitDescription(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
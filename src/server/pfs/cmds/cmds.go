@@ -16,6 +16,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -125,6 +126,57 @@ func Cmds(noMetrics *bool) []*cobra.Command {
 	}
 	rawFlag(inspectRepo)
 
+	var quotaSize, quotaSoftSize string
+	var quota pfsclient.Quota
+	setQuota := &cobra.Command{
+		Use:   "set-quota repo-name [branch]",
+		Short: "Set the size limits of a repo or a branch.",
+		Long: `Set the size limits of a repo or, if a branch is given, of the branch's head commit. Writes that would take the repo or branch over a hard limit fail, while going over a soft limit only produces a warning in inspect-repo. Limits that aren't set are removed.
+
+Examples:
+
+` + codestart + `# limit repo "foo" to 100GB, with a warning after 80GB
+$ pachctl set-quota foo --size 100GB --soft-size 80GB
+
+# limit repo "foo" to a million files
+$ pachctl set-quota foo --files 1000000
+
+# limit branch "master" of repo "foo" to 10GB
+$ pachctl set-quota foo master --size 10GB
+
+# remove the quota of repo "foo"
+$ pachctl set-quota foo
+` + codeend,
+		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
+			c, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			if quotaSize != "" {
+				size, err := units.RAMInBytes(quotaSize)
+				if err != nil {
+					return err
+				}
+				quota.SizeBytes = uint64(size)
+			}
+			if quotaSoftSize != "" {
+				size, err := units.RAMInBytes(quotaSoftSize)
+				if err != nil {
+					return err
+				}
+				quota.SoftSizeBytes = uint64(size)
+			}
+			if len(args) == 2 {
+				return c.SetBranchQuota(args[0], args[1], &quota)
+			}
+			return c.SetQuota(args[0], &quota)
+		}),
+	}
+	setQuota.Flags().StringVar(&quotaSize, "size", "", "The maximum size of the repo, e.g. 100GB.")
+	setQuota.Flags().StringVar(&quotaSoftSize, "soft-size", "", "The size of the repo above which inspect-repo warns.")
+	setQuota.Flags().Uint64Var(&quota.FileCount, "files", 0, "The maximum number of files in the repo's commits.")
+	setQuota.Flags().Uint64Var(&quota.SoftFileCount, "soft-files", 0, "The number of files above which inspect-repo warns.")
+
 	var listRepoProvenance cmdutil.RepeatedStringArg
 	listRepo := &cobra.Command{
 		Use:   "list-repo",
@@ -504,6 +556,90 @@ $ pachctl subscribe-commit test master --new
 		}),
 	}
 
+	squashCommit := &cobra.Command{
+		Use:   "squash-commit repo-name commit-id",
+		Short: "Remove a finished commit from a repo's history.",
+		Long: `Remove a finished commit from a repo's history, merging its changes into its children.
+
+The commit's children become children of the commit's parent. The commit
+can't be squashed if it's the head of a branch, or if any of its children
+are still open.
+
+Examples:
+
+` + codestart + `# Squash commit XXX in repo "foo" into its child
+$ pachctl squash-commit foo XXX` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.SquashCommit(args[0], args[1])
+		}),
+	}
+
+	revertCommit := &cobra.Command{
+		Use:   "revert-commit repo-name commit-id branch-name",
+		Short: "Undo the changes made by a commit.",
+		Long: `Undo the changes made by a commit, by adding a new commit to the given branch.
+
+Examples:
+
+` + codestart + `# Undo the changes made by commit XXX in repo "foo" on branch "master"
+$ pachctl revert-commit foo XXX master` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			commit, err := client.RevertCommit(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			fmt.Println(commit.ID)
+			return nil
+		}),
+	}
+
+	mergeBranch := &cobra.Command{
+		Use:   "merge-branch repo-name from-branch to-branch",
+		Short: "Merge the changes on one branch into another.",
+		Long: `Merge the changes on one branch into another.
+
+A new commit is added to to-branch whose parents are the heads of both
+branches. If the same file was changed differently on both branches since
+they diverged, no commit is made and the conflicting files are printed.
+
+Examples:
+
+` + codestart + `# Merge branch "feature" into branch "master" in repo "foo"
+$ pachctl merge-branch foo feature master` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			response, err := client.MergeBranch(args[0], args[1], args[2], description)
+			if err != nil {
+				return err
+			}
+			if len(response.Conflicts) > 0 {
+				writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+				pretty.PrintMergeConflictHeader(writer)
+				for _, conflict := range response.Conflicts {
+					pretty.PrintMergeConflict(writer, conflict)
+				}
+				if err := writer.Flush(); err != nil {
+					return err
+				}
+				return fmt.Errorf("could not merge %s into %s due to conflicts", args[1], args[2])
+			}
+			fmt.Println(response.Commit.ID)
+			return nil
+		}),
+	}
+	mergeBranch.Flags().StringVarP(&description, "message", "m", "", "A description of the merge commit")
+
 	listBranch := &cobra.Command{
 		Use:   "list-branch repo-name",
 		Short: "Return all branches on a repo.",
@@ -535,6 +671,77 @@ $ pachctl subscribe-commit test master --new
 	}
 	rawFlag(listBranch)
 
+	var branchProvenance cmdutil.RepeatedStringArg
+	var head string
+	var clearProvenance bool
+	createBranch := &cobra.Command{
+		Use:   "create-branch repo-name branch-name",
+		Short: "Create a new branch, or update an existing branch, on a repo.",
+		Long: `Create a new branch, or update an existing branch, on a repo.
+
+A branch may have provenance: a set of branches (in this repo or in other
+repos) that it depends on. Whenever the head of one of those branches moves
+to a finished commit, a new commit is opened on this branch.
+
+Examples:
+
+` + codestart + `# Create branch master in repo foo, with commit XXX as its head.
+$ pachctl create-branch foo master --head XXX
+
+# Create branch master in repo bar, which gets a new commit whenever
+# master in repo foo gets a new finished commit.
+$ pachctl create-branch bar master -p foo/master
+
+# Remove the provenance of branch master in repo bar.
+$ pachctl create-branch bar master --clear-provenance` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			provenance, err := cmdutil.ParseBranches(branchProvenance)
+			if err != nil {
+				return err
+			}
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			if clearProvenance {
+				if len(provenance) > 0 {
+					return fmt.Errorf("--provenance and --clear-provenance can't be used together")
+				}
+				if err := client.ClearBranchProvenance(args[0], args[1]); err != nil {
+					return err
+				}
+				if head == "" {
+					return nil
+				}
+			}
+			return client.CreateBranch(args[0], args[1], head, provenance)
+		}),
+	}
+	createBranch.Flags().VarP(&branchProvenance, "provenance", "p", "The provenance for the branch, of the form repo/branch (may be given multiple times). An existing branch keeps its provenance if this isn't given.")
+	createBranch.Flags().BoolVar(&clearProvenance, "clear-provenance", false, "Remove the provenance of an existing branch.")
+	createBranch.Flags().StringVar(&head, "head", "", "The head of the newly created branch.")
+
+	inspectBranch := &cobra.Command{
+		Use:   "inspect-branch repo-name branch-name",
+		Short: "Return info about a branch.",
+		Long:  "Return info about a branch, including its head, provenance and subvenance.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			branchInfo, err := client.InspectBranch(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, branchInfo)
+			}
+			return pretty.PrintDetailedBranchInfo(args[0], branchInfo)
+		}),
+	}
+	rawFlag(inspectBranch)
+
 	setBranch := &cobra.Command{
 		Use:   "set-branch repo-name commit-id/branch-name new-branch-name",
 		Short: "Set a commit and its ancestors to a branch",
@@ -592,6 +799,7 @@ $ pachctl set-branch foo test master` + codeend,
 	var targetFileBytes uint
 	var putFileCommit bool
 	var overwrite bool
+	var fileMetadata cmdutil.RepeatedStringArg
 	putFile := &cobra.Command{
 		Use:   "put-file repo-name branch [path/to/file/in/pfs]",
 		Short: "Put a file into the filesystem.",
@@ -628,6 +836,9 @@ $ pachctl put-file repo branch -i file
 # NOTE this URL can reference local files, so it could cause you to put sensitive
 # files into your Pachyderm cluster.
 $ pachctl put-file repo branch -i http://host/path
+
+# Put a file from the local filesystem as repo/branch/path, and set its metadata:
+$ pachctl put-file repo branch path -f file --metadata content-type=text/csv --metadata source=camera-1
 ` + codeend + `
 NOTE there's a small performance overhead for using a branch name as opposed
 to a commit ID in put-file.  In most cases the performance overhead is
@@ -664,6 +875,13 @@ want to consider using commit IDs directly.
 			} else if description != "" {
 				return fmt.Errorf("cannot set --message (-m) or --description without --commit (-c)")
 			}
+			metadata, err := cmdutil.ParseKeyValues(fileMetadata)
+			if err != nil {
+				return err
+			}
+			if len(metadata) > 0 && split != "" {
+				return fmt.Errorf("cannot set --metadata with --split")
+			}
 
 			limiter := limit.New(int(parallelism))
 			var sources []string
@@ -713,19 +931,19 @@ want to consider using commit IDs directly.
 						return fmt.Errorf("no filename specified")
 					}
 					eg.Go(func() error {
-						return putFileHelper(cli, repoName, branch, joinPaths("", source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes)
+						return putFileHelper(cli, repoName, branch, joinPaths("", source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, metadata)
 					})
 				} else if len(sources) == 1 && len(args) == 3 {
 					// We have a single source and the user has specified a path,
 					// we use the path and ignore source (in terms of naming the file).
 					eg.Go(func() error {
-						return putFileHelper(cli, repoName, branch, path, source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes)
+						return putFileHelper(cli, repoName, branch, path, source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, metadata)
 					})
 				} else if len(sources) > 1 && len(args) == 3 {
 					// We have multiple sources and the user has specified a path,
 					// we use that path as a prefix for the filepaths.
 					eg.Go(func() error {
-						return putFileHelper(cli, repoName, branch, joinPaths(path, source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes)
+						return putFileHelper(cli, repoName, branch, joinPaths(path, source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, metadata)
 					})
 				}
 			}
@@ -743,6 +961,7 @@ want to consider using commit IDs directly.
 	putFile.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "Overwrite the existing content of the file, either from previous commits or previous calls to put-file within this commit.")
 	putFile.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents (only allowed with -c)")
 	putFile.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	putFile.Flags().Var(&fileMetadata, "metadata", "Metadata to set on the file(s), of the form key=value (may be given multiple times).")
 
 	copyFile := &cobra.Command{
 		Use:   "copy-file src-repo src-commit src-path dst-repo dst-commit dst-path",
@@ -758,6 +977,47 @@ want to consider using commit IDs directly.
 	}
 	copyFile.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "Overwrite the existing content of the file, either from previous commits or previous calls to put-file within this commit.")
 
+	moveFile := &cobra.Command{
+		Use:   "move-file repo-name commit-id src-path dst-path",
+		Short: "Move a file or directory within an open commit.",
+		Long: `Move a file or directory within an open commit. Moving a directory doesn't require rewriting each of the files in it, so large directories can be moved quickly.
+
+Examples:
+
+` + codestart + `# move "dir" to "archive/dir" in the open commit on the master branch of repo "foo"
+$ pachctl move-file foo master dir archive/dir
+` + codeend,
+		Run: cmdutil.RunFixedArgs(4, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.MoveFile(args[0], args[1], args[2], args[3])
+		}),
+	}
+
+	createSymlink := &cobra.Command{
+		Use:   "create-symlink repo-name commit-id path/to/link target",
+		Short: "Create a symlink in a commit.",
+		Long: `Create a symlink in a commit. The target is either a path in the same commit (absolute, or relative to the link's directory) or a path in another repo, of the form pfs://repo/commit/path.
+
+Examples:
+
+` + codestart + `# create a symlink "latest" to "/data/2018" in the master branch of repo "foo"
+$ pachctl create-symlink foo master latest /data/2018
+
+# create a symlink "lib" to a directory in the master branch of repo "bar"
+$ pachctl create-symlink foo master lib pfs://bar/master/lib
+` + codeend,
+		Run: cmdutil.RunFixedArgs(4, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.CreateSymlink(args[0], args[1], args[2], args[3])
+		}),
+	}
+
 	var outputPath string
 	getFile := &cobra.Command{
 		Use:   "get-file repo-name commit-id path/to/file",
@@ -896,13 +1156,21 @@ $ pachctl glob-file foo master "A*"
 
 # Return files in repo "foo" on branch "master" under directory "data".
 $ pachctl glob-file foo master "data/*"
+
+# Return files in repo "foo" on branch "master" under directory "data" whose
+# metadata has "status" set to "labelled".
+$ pachctl glob-file foo master "data/*" --metadata status=labelled
 ` + codeend,
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			client, err := client.NewOnUserMachine(metrics, "user")
 			if err != nil {
 				return err
 			}
-			fileInfos, err := client.GlobFile(args[0], args[1], args[2])
+			metadata, err := cmdutil.ParseKeyValues(fileMetadata)
+			if err != nil {
+				return err
+			}
+			fileInfos, err := client.GlobFileWithMetadata(args[0], args[1], args[2], metadata)
 			if err != nil {
 				return err
 			}
@@ -921,6 +1189,7 @@ $ pachctl glob-file foo master "data/*"
 			return writer.Flush()
 		}),
 	}
+	globFile.Flags().Var(&fileMetadata, "metadata", "Return only files whose metadata has this key=value pair (may be given multiple times).")
 	rawFlag(globFile)
 
 	var shallow bool
@@ -982,6 +1251,50 @@ $ pachctl diff-file foo master path1 bar master path2
 	}
 	diffFile.Flags().BoolVarP(&shallow, "shallow", "s", false, "Specifies whether or not to diff subdirectories")
 
+	fileLog := &cobra.Command{
+		Use:   "file-log repo-name commit-id path/to/file",
+		Short: "Return the commits that changed a file.",
+		Long: `Return the commits that changed a file, newest first.
+
+The history starts at the given commit (or the head of the given branch) and
+follows its parents, listing each commit in which the file was added,
+modified or deleted.
+
+Examples:
+
+` + codestart + `# Return the commits on branch "master" in repo "foo" that changed /labels/cats.csv
+$ pachctl file-log foo master /labels/cats.csv
+
+# Return the last 10 commits that changed /labels/cats.csv
+$ pachctl file-log foo master /labels/cats.csv -n 10` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			c, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			history, err := c.ListFileHistory(args[0], args[1], args[2], uint64(number))
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, entry := range history {
+					if err := marshaller.Marshal(os.Stdout, entry); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintFileHistoryHeader(writer)
+			for _, entry := range history {
+				pretty.PrintFileHistory(writer, entry)
+			}
+			return writer.Flush()
+		}),
+	}
+	fileLog.Flags().IntVarP(&number, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
+	rawFlag(fileLog)
+
 	deleteFile := &cobra.Command{
 		Use:   "delete-file repo-name commit-id path/to/file",
 		Short: "Delete a file.",
@@ -1106,6 +1419,7 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, createRepo)
 	result = append(result, updateRepo)
 	result = append(result, inspectRepo)
+	result = append(result, setQuota)
 	result = append(result, listRepo)
 	result = append(result, deleteRepo)
 	result = append(result, commit)
@@ -1116,17 +1430,25 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, flushCommit)
 	result = append(result, subscribeCommit)
 	result = append(result, deleteCommit)
+	result = append(result, squashCommit)
+	result = append(result, revertCommit)
+	result = append(result, mergeBranch)
+	result = append(result, createBranch)
+	result = append(result, inspectBranch)
 	result = append(result, listBranch)
 	result = append(result, setBranch)
 	result = append(result, deleteBranch)
 	result = append(result, file)
 	result = append(result, putFile)
 	result = append(result, copyFile)
+	result = append(result, moveFile)
+	result = append(result, createSymlink)
 	result = append(result, getFile)
 	result = append(result, inspectFile)
 	result = append(result, listFile)
 	result = append(result, globFile)
 	result = append(result, diffFile)
+	result = append(result, fileLog)
 	result = append(result, deleteFile)
 	result = append(result, getObject)
 	result = append(result, getTag)
@@ -1155,8 +1477,12 @@ func parseCommitMounts(args []string) []*fuse.CommitMount {
 
 func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 	recursive bool, overwrite bool, limiter limit.ConcurrencyLimiter, split string,
-	targetFileDatums uint, targetFileBytes uint) (retErr error) {
+	targetFileDatums uint, targetFileBytes uint, metadata map[string]string) (retErr error) {
 	putFile := func(reader io.ReadSeeker) error {
+		if len(metadata) > 0 {
+			_, err := client.PutFileWithMetadata(repo, commit, path, reader, overwrite, metadata)
+			return err
+		}
 		if split == "" {
 			if overwrite {
 				return sync.PushFile(client, &pfsclient.File{
@@ -1194,6 +1520,9 @@ func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 	if url, err := url.Parse(source); err == nil && url.Scheme != "" {
 		limiter.Acquire()
 		defer limiter.Release()
+		if len(metadata) > 0 {
+			return fmt.Errorf("cannot set --metadata when putting a URL")
+		}
 		return client.PutFileURL(repo, commit, path, url.String(), recursive, overwrite)
 	}
 	if recursive {
@@ -1207,7 +1536,7 @@ func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 				return nil
 			}
 			eg.Go(func() error {
-				return putFileHelper(client, repo, commit, filepath.Join(path, strings.TrimPrefix(filePath, source)), filePath, false, overwrite, limiter, split, targetFileDatums, targetFileBytes)
+				return putFileHelper(client, repo, commit, filepath.Join(path, strings.TrimPrefix(filePath, source)), filePath, false, overwrite, limiter, split, targetFileDatums, targetFileBytes, metadata)
 			})
 			return nil
 		}); err != nil {
@@ -253,6 +253,9 @@ func (f *file) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
 	}
 	if fileInfo != nil {
 		a.Size = fileInfo.SizeBytes
+		if fileInfo.Modified != nil {
+			a.Mtime, _ = types.TimestampFromProto(fileInfo.Modified)
+		}
 	}
 	a.Mode = 0666
 	a.Inode = f.fs.inode(f.File)
@@ -594,6 +597,12 @@ func (d *directory) lookUpFile(ctx context.Context, name string) (fs.Node, error
 	if err != nil {
 		return nil, fuse.ENOENT
 	}
+	// Symlinks are presented as whatever they point to; reads through the
+	// link's path are resolved by pachd
+	fileInfo, err = d.followSymlink(fileInfo)
+	if err != nil {
+		return nil, fuse.ENOENT
+	}
 	if d.Node.Write {
 		fileInfo.SizeBytes = 0
 	}
@@ -602,6 +611,9 @@ func (d *directory) lookUpFile(ctx context.Context, name string) (fs.Node, error
 	// path currently being looked up
 	directory := d.copy()
 	directory.File.Path = fileInfo.File.Path
+	if fileInfo.Modified != nil {
+		directory.Modified = fileInfo.Modified
+	}
 
 	switch fileInfo.FileType {
 	case pfsclient.FileType_FILE:
@@ -616,6 +628,27 @@ func (d *directory) lookUpFile(ctx context.Context, name string) (fs.Node, error
 	}
 }
 
+// followSymlink returns the info of the file that 'fileInfo' points to if
+// it's a symlink, under the symlink's path.
+func (d *directory) followSymlink(fileInfo *pfsclient.FileInfo) (*pfsclient.FileInfo, error) {
+	link := fileInfo.File
+	for depth := 0; fileInfo.FileType == pfsclient.FileType_SYMLINK; depth++ {
+		if depth >= pfsclient.MaxSymlinkDepth {
+			return nil, fmt.Errorf("too many levels of symbolic links resolving %s", link.Path)
+		}
+		target, err := pfsclient.ResolveSymlink(fileInfo.File, fileInfo.SymlinkTarget)
+		if err != nil {
+			return nil, err
+		}
+		fileInfo, err = d.fs.apiClient.InspectFile(target.Commit.Repo.Name, target.Commit.ID, target.Path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fileInfo.File = link
+	return fileInfo, nil
+}
+
 func (d *directory) readRepos(ctx context.Context) ([]fuse.Dirent, error) {
 	var result []fuse.Dirent
 	if len(d.fs.CommitMounts) == 0 {
@@ -678,6 +711,9 @@ func (d *directory) readFiles(ctx context.Context) ([]fuse.Dirent, error) {
 			result = append(result, fuse.Dirent{Name: shortPath, Type: fuse.DT_File})
 		case pfsclient.FileType_DIR:
 			result = append(result, fuse.Dirent{Name: shortPath, Type: fuse.DT_Dir})
+		case pfsclient.FileType_SYMLINK:
+			// The type of a symlink's target is only known once it's looked up
+			result = append(result, fuse.Dirent{Name: shortPath, Type: fuse.DT_Unknown})
 		default:
 			continue
 		}
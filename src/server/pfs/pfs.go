@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ErrFileNotFound represents a file-not-found error.
@@ -69,6 +73,25 @@ func (e ErrParentCommitNotFound) Error() string {
 	return fmt.Sprintf("parent commit %v not found in repo %v", e.Commit.ID, e.Commit.Repo.Name)
 }
 
+// NewQuotaExceededError returns the error for a write that would take 'repo'
+// (or, if it's set, 'branch' in 'repo') over one of its hard limits. 'limit'
+// is the name of the exceeded limit, e.g. "size". The error has the GRPC
+// status code ResourceExhausted, so that it can be recognized by
+// IsQuotaExceededErr on either side of a GRPC call.
+func NewQuotaExceededError(repo *pfs.Repo, branch string, limit string, value uint64, quota uint64) error {
+	target := fmt.Sprintf("repo %v", repo.Name)
+	if branch != "" {
+		target = fmt.Sprintf("branch %v of repo %v", branch, repo.Name)
+	}
+	return status.Errorf(codes.ResourceExhausted, "quota exceeded: the %v of %v would be %d, but its quota is %d", limit, target, value, quota)
+}
+
+// IsQuotaExceededErr returns true if 'err' was returned by
+// NewQuotaExceededError, i.e. it has the GRPC status code ResourceExhausted.
+func IsQuotaExceededErr(err error) bool {
+	return grpcutil.Code(err) == codes.ResourceExhausted
+}
+
 // ByteRangeSize returns byteRange.Upper - byteRange.Lower.
 func ByteRangeSize(byteRange *pfs.ByteRange) uint64 {
 	return byteRange.Upper - byteRange.Lower
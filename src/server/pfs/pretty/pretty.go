@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -43,7 +44,10 @@ Description: {{.Description}}{{end}}
 Created: {{prettyAgo .Created}}
 Size: {{prettySize .SizeBytes}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Name}} {{end}}{{end}}{{if .AuthInfo}}
-Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
+Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}{{if .Quota}}
+Quota: {{prettyQuota .Quota}}{{end}}{{if .FileCount}}
+Files: {{.FileCount}}{{end}}{{range .QuotaWarnings}}
+Warning: {{.}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -63,7 +67,30 @@ func PrintBranchHeader(w io.Writer) {
 // PrintBranch pretty-prints a Branch.
 func PrintBranch(w io.Writer, branch *pfs.BranchInfo) {
 	fmt.Fprintf(w, "%s\t", branch.Name)
-	fmt.Fprintf(w, "%s\t\n", branch.Head.ID)
+	if branch.Head != nil {
+		fmt.Fprintf(w, "%s\t\n", branch.Head.ID)
+	} else {
+		fmt.Fprint(w, "-\t\n")
+	}
+}
+
+// PrintDetailedBranchInfo pretty-prints detailed branch info.
+func PrintDetailedBranchInfo(repoName string, branchInfo *pfs.BranchInfo) error {
+	template, err := template.New("BranchInfo").Funcs(funcMap).Parse(
+		`Name: ` + repoName + `/{{.Name}}{{if .Head}}
+Head: {{.Head.ID}}{{end}}{{if .Provenance}}
+Provenance: {{range .Provenance}} {{.Repo.Name}}/{{.Name}} {{end}}{{end}}{{if .Subvenance}}
+Subvenance: {{range .Subvenance}} {{.Repo.Name}}/{{.Name}} {{end}}{{end}}{{if .Quota}}
+Quota: {{prettyQuota .Quota}}{{end}}
+`)
+	if err != nil {
+		return err
+	}
+	err = template.Execute(os.Stdout, branchInfo)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
 // PrintCommitInfoHeader prints a commit info header.
@@ -100,7 +127,8 @@ func PrintDetailedCommitInfo(commitInfo *pfs.CommitInfo) error {
 	template, err := template.New("CommitInfo").Funcs(funcMap).Parse(
 		`Commit: {{.Commit.Repo.Name}}/{{.Commit.ID}}{{if .Description}}
 Description: {{.Description}}{{end}}{{if .ParentCommit}}
-Parent: {{.ParentCommit.ID}}{{end}}
+Parent: {{.ParentCommit.ID}}{{end}}{{if .MergeParent}}
+Merge parent: {{.MergeParent.ID}}{{end}}
 Started: {{prettyAgo .Started}}{{if .Finished}}
 Finished: {{prettyAgo .Finished}} {{end}}
 Size: {{prettySize .SizeBytes}}{{if .Provenance}}
@@ -116,6 +144,16 @@ Provenance: {{range .Provenance}} {{.Repo.Name}}/{{.ID}} {{end}} {{end}}
 	return nil
 }
 
+// PrintMergeConflictHeader prints a merge conflict header.
+func PrintMergeConflictHeader(w io.Writer) {
+	fmt.Fprint(w, "PATH\tREASON\t\n")
+}
+
+// PrintMergeConflict pretty-prints a MergeConflict.
+func PrintMergeConflict(w io.Writer, conflict *pfs.MergeConflict) {
+	fmt.Fprintf(w, "%s\t%s\t\n", conflict.Path, conflict.Reason)
+}
+
 // PrintFileInfoHeader prints a file info header.
 func PrintFileInfoHeader(w io.Writer) {
 	fmt.Fprint(w, "NAME\tTYPE\tSIZE\t\n")
@@ -125,22 +163,46 @@ func PrintFileInfoHeader(w io.Writer) {
 // If recurse is false and directory size is 0, display "-" instead
 // If fast is true and file size is 0, display "-" instead
 func PrintFileInfo(w io.Writer, fileInfo *pfs.FileInfo) {
-	fmt.Fprintf(w, "%s\t", fileInfo.File.Path)
-	if fileInfo.FileType == pfs.FileType_FILE {
-		fmt.Fprint(w, "file\t")
+	if fileInfo.FileType == pfs.FileType_SYMLINK {
+		fmt.Fprintf(w, "%s -> %s\t", fileInfo.File.Path, fileInfo.SymlinkTarget)
 	} else {
-		fmt.Fprint(w, "dir\t")
+		fmt.Fprintf(w, "%s\t", fileInfo.File.Path)
 	}
+	fmt.Fprintf(w, "%s\t", fileType(fileInfo.FileType))
 	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(fileInfo.SizeBytes)))
 }
 
+// PrintFileHistoryHeader prints a file history header.
+func PrintFileHistoryHeader(w io.Writer) {
+	fmt.Fprint(w, "COMMIT\tFINISHED\tSIZE\tDELTA\t\n")
+}
+
+// PrintFileHistory pretty-prints a commit in a file's history.
+func PrintFileHistory(w io.Writer, history *pfs.FileHistory) {
+	fmt.Fprintf(w, "%s\t", history.Commit.ID)
+	fmt.Fprintf(w, "%s\t", pretty.Ago(history.Finished))
+	if history.FileInfo != nil {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(history.FileInfo.SizeBytes)))
+	} else {
+		fmt.Fprint(w, "deleted\t")
+	}
+	if history.SizeDelta < 0 {
+		fmt.Fprintf(w, "-%s\t\n", units.BytesSize(float64(-history.SizeDelta)))
+	} else {
+		fmt.Fprintf(w, "+%s\t\n", units.BytesSize(float64(history.SizeDelta)))
+	}
+}
+
 // PrintDetailedFileInfo pretty-prints detailed file info.
 func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 	template, err := template.New("FileInfo").Funcs(funcMap).Parse(
 		`Path: {{.File.Path}}
-Type: {{fileType .FileType}}
-Size: {{prettySize .SizeBytes}}
-Children: {{range .Children}} {{.}} {{end}}
+Type: {{fileType .FileType}}{{if .SymlinkTarget}}
+Target: {{.SymlinkTarget}}{{end}}
+Size: {{prettySize .SizeBytes}}{{if .ModifiedCommit}}
+Modified: {{prettyAgo .Modified}} (commit {{.ModifiedCommit.ID}}){{end}}
+Children: {{range .Children}} {{.}} {{end}}{{if .Metadata}}
+Metadata: {{range $key, $value := .Metadata}} {{$key}}={{$value}} {{end}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -155,14 +217,36 @@ func (s uint64Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s uint64Slice) Less(i, j int) bool { return s[i] < s[j] }
 
 func fileType(fileType pfs.FileType) string {
-	if fileType == pfs.FileType_FILE {
+	switch fileType {
+	case pfs.FileType_FILE:
 		return "file"
+	case pfs.FileType_SYMLINK:
+		return "symlink"
+	default:
+		return "dir"
+	}
+}
+
+func prettyQuota(quota *pfs.Quota) string {
+	var limits []string
+	if quota.SizeBytes > 0 {
+		limits = append(limits, fmt.Sprintf("size %s", units.BytesSize(float64(quota.SizeBytes))))
+	}
+	if quota.SoftSizeBytes > 0 {
+		limits = append(limits, fmt.Sprintf("soft size %s", units.BytesSize(float64(quota.SoftSizeBytes))))
+	}
+	if quota.FileCount > 0 {
+		limits = append(limits, fmt.Sprintf("%d files", quota.FileCount))
+	}
+	if quota.SoftFileCount > 0 {
+		limits = append(limits, fmt.Sprintf("soft %d files", quota.SoftFileCount))
 	}
-	return "dir"
+	return strings.Join(limits, ", ")
 }
 
 var funcMap = template.FuncMap{
-	"prettyAgo":  pretty.Ago,
-	"prettySize": pretty.Size,
-	"fileType":   fileType,
+	"prettyAgo":   pretty.Ago,
+	"prettySize":  pretty.Size,
+	"prettyQuota": prettyQuota,
+	"fileType":    fileType,
 }
@@ -70,7 +70,14 @@ func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoReq
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	return a.driver.inspectRepo(ctx, request.Repo, true)
+	repoInfo, err := a.driver.inspectRepo(ctx, request.Repo, true)
+	if err != nil {
+		return nil, err
+	}
+	if repoInfo.QuotaWarnings, err = a.driver.quotaWarnings(ctx, repoInfo); err != nil {
+		return nil, err
+	}
+	return repoInfo, nil
 }
 
 func (a *apiServer) ListRepo(ctx context.Context, request *pfs.ListRepoRequest) (response *pfs.ListRepoResponse, retErr error) {
@@ -98,6 +105,15 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) SetQuota(ctx context.Context, request *pfs.SetQuotaRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.setQuota(ctx, request.Repo, request.Branch, request.Quota); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitRequest) (response *pfs.Commit, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -124,7 +140,7 @@ func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitR
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	if err := a.driver.finishCommit(ctx, request.Commit, request.Description); err != nil {
+	if err := a.driver.finishCommit(ctx, request.Commit, request.Description, request.Tree, request.Empty); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -192,6 +208,23 @@ func (a *apiServer) SetBranch(ctx context.Context, request *pfs.SetBranchRequest
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) CreateBranch(ctx context.Context, request *pfs.CreateBranchRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.createBranch(ctx, request.Branch, request.Head, request.Provenance, request.ClearProvenance); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) InspectBranch(ctx context.Context, request *pfs.InspectBranchRequest) (response *pfs.BranchInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.inspectBranch(ctx, request.Branch)
+}
+
 func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -212,6 +245,30 @@ func (a *apiServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitR
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) SquashCommit(ctx context.Context, request *pfs.SquashCommitRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.squashCommit(ctx, request.Commit); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) RevertCommit(ctx context.Context, request *pfs.RevertCommitRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.revertCommit(ctx, request.Commit, request.Branch)
+}
+
+func (a *apiServer) MergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (response *pfs.MergeBranchResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.mergeBranch(ctx, request.Repo, request.FromBranch, request.ToBranch, request.Description)
+}
+
 func (a *apiServer) FlushCommit(request *pfs.FlushCommitRequest, stream pfs.API_FlushCommitServer) (retErr error) {
 	ctx := stream.Context()
 	func() { a.Log(request, nil, nil, 0) }()
@@ -244,7 +301,7 @@ func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 
-	commitStream, err := a.driver.subscribeCommit(ctx, request.Repo, request.Branch, request.From)
+	commitStream, err := a.driver.subscribeCommit(ctx, request.Repo, request.Branch, request.From, request.State)
 	if err != nil {
 		return err
 	}
@@ -345,7 +402,7 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 		}
 		r = &reader
 	}
-	return a.driver.putFile(ctx, request.File, request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, r)
+	return a.driver.putFile(ctx, request.File, request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, request.Metadata, r)
 }
 
 func (a *apiServer) putFilePfs(ctx context.Context, request *pfs.PutFileRequest, url *url.URL) error {
@@ -358,7 +415,7 @@ func (a *apiServer) putFilePfs(ctx context.Context, request *pfs.PutFileRequest,
 		if err != nil {
 			return err
 		}
-		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, outPath), request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, r)
+		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, outPath), request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, request.Metadata, r)
 	}
 	splitPath := strings.Split(strings.TrimPrefix(url.Path, "/"), "/")
 	if len(splitPath) < 2 {
@@ -412,7 +469,7 @@ func (a *apiServer) putFileObj(ctx context.Context, objClient obj.Client, reques
 			}
 		}()
 		return a.driver.putFile(ctx, client.NewFile(request.File.Commit.Repo.Name, request.File.Commit.ID, filePath),
-			request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, r)
+			request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, request.OverwriteIndex, request.Metadata, r)
 	}
 	if request.Recursive {
 		eg, egContext := errgroup.WithContext(ctx)
@@ -452,6 +509,24 @@ func (a *apiServer) CopyFile(ctx context.Context, request *pfs.CopyFileRequest)
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) MoveFile(ctx context.Context, request *pfs.MoveFileRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.moveFile(ctx, request.Src, request.Dst); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) CreateSymlink(ctx context.Context, request *pfs.CreateSymlinkRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.createSymlink(ctx, request.File, request.Target); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) GetFile(request *pfs.GetFileRequest, apiGetFileServer pfs.API_GetFileServer) (retErr error) {
 	ctx := apiGetFileServer.Context()
 	func() { a.Log(request, nil, nil, 0) }()
@@ -521,7 +596,7 @@ func (a *apiServer) GlobFile(ctx context.Context, request *pfs.GlobFileRequest)
 		}
 	}(time.Now())
 
-	fileInfos, err := a.driver.globFile(auth.In2Out(ctx), request.Commit, request.Pattern)
+	fileInfos, err := a.driver.globFile(auth.In2Out(ctx), request.Commit, request.Pattern, request.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -536,7 +611,7 @@ func (a *apiServer) GlobFileStream(request *pfs.GlobFileRequest, respServer pfs.
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	fileInfos, err := a.driver.globFile(auth.In2Out(respServer.Context()), request.Commit, request.Pattern)
+	fileInfos, err := a.driver.globFile(auth.In2Out(respServer.Context()), request.Commit, request.Pattern, request.Metadata)
 	if err != nil {
 		return err
 	}
@@ -572,6 +647,19 @@ func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest)
 	}, nil
 }
 
+func (a *apiServer) ListFileHistory(ctx context.Context, request *pfs.ListFileHistoryRequest) (response *pfs.FileHistories, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	history, err := a.driver.listFileHistory(ctx, request.File, request.Number)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.FileHistories{
+		History: history,
+	}, nil
+}
+
 func (a *apiServer) DeleteFile(ctx context.Context, request *pfs.DeleteFileRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -593,6 +681,30 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) StartTransaction(ctx context.Context, request *pfs.StartTransactionRequest) (response *pfs.Transaction, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.startTransaction(ctx)
+}
+
+func (a *apiServer) FinishTransaction(ctx context.Context, request *pfs.FinishTransactionRequest) (response *pfs.TransactionInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.finishTransaction(ctx, request.Transaction)
+}
+
+func (a *apiServer) DeleteTransaction(ctx context.Context, request *pfs.DeleteTransactionRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.deleteTransaction(ctx, request.Transaction); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 type putFileReader struct {
 	server pfs.API_PutFileServer
 	buffer bytes.Buffer
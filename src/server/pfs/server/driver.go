@@ -5,12 +5,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
@@ -30,6 +33,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/hashicorp/golang-lru"
 	"google.golang.org/grpc"
@@ -97,6 +101,8 @@ type driver struct {
 	commits        collectionFactory
 	branches       collectionFactory
 	openCommits    col.Collection
+	transactions   col.Collection
+	txnRequests    col.Collection
 
 	// a cache for hashtrees
 	treeCache *lru.Cache
@@ -136,8 +142,10 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCa
 		branches: func(repo string) col.Collection {
 			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
 		},
-		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
-		treeCache:   treeCache,
+		openCommits:  pfsdb.OpenCommits(etcdClient, etcdPrefix),
+		transactions: pfsdb.Transactions(etcdClient, etcdPrefix),
+		txnRequests:  pfsdb.TransactionRequests(etcdClient, etcdPrefix),
+		treeCache:    treeCache,
 	}
 	go func() { d.initializePachConn() }() // Begin dialing connection on startup
 	return d, nil
@@ -204,83 +212,107 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*p
 	if err := validateRepoName(repo.Name); err != nil {
 		return err
 	}
+	if txnID := transactionID(ctx); txnID != "" {
+		if update {
+			return fmt.Errorf("repos cannot be updated in a transaction")
+		}
+		return d.addToTransaction(ctx, txnID, &pfs.TransactionRequest{
+			CreateRepo: &pfs.CreateRepoRequest{
+				Repo:        repo,
+				Provenance:  provenance,
+				Description: description,
+			},
+		})
+	}
 	d.initializePachConn()
 	if update {
 		return d.updateRepo(ctx, repo, provenance, description)
 	}
 
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		repos := d.repos.ReadWrite(stm)
-		repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
+	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		return d.createRepoInSTM(ctx, stm, repo, provenance, description)
+	}); err != nil {
+		return err
+	}
+	return d.setRepoOwner(ctx, repo)
+}
 
-		// check if 'repo' already exists. If so, return that error. Otherwise,
-		// proceed with ACL creation (avoids awkward "access denied" error when
-		// calling "createRepo" on a repo that already exists)
-		var existingRepoInfo pfs.RepoInfo
-		err := repos.Get(repo.Name, &existingRepoInfo)
-		if err != nil && !col.IsErrNotFound(err) {
-			return fmt.Errorf("error checking whether \"%s\" exists: %v",
-				repo.Name, err)
-		} else if err == nil {
-			return fmt.Errorf("cannot create \"%s\" as it already exists", repo.Name)
+// setRepoOwner makes the caller an owner of the newly created 'repo' (and
+// clears any existing ACL under this name that might have been created by
+// accident), if auth is active. It's called after the STM that creates the
+// repo has committed, as the STM may be retried and setting the ACL can't be
+// rolled back.
+func (d *driver) setRepoOwner(ctx context.Context, repo *pfs.Repo) error {
+	whoAmI, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx),
+		&auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsNotActivatedError(err) {
+			return nil
 		}
+		return fmt.Errorf("error while creating repo \"%s\": %v",
+			repo.Name, grpcutil.ScrubGRPC(err))
+	}
+	if _, err := d.pachClient.AuthAPIClient.SetACL(auth.In2Out(ctx), &auth.SetACLRequest{
+		Repo: repo.Name,
+		Entries: []*auth.ACLEntry{{
+			Username: whoAmI.Username,
+			Scope:    auth.Scope_OWNER,
+		}},
+	}); err != nil {
+		return fmt.Errorf("could not create ACL for new repo \"%s\": %v",
+			repo.Name, grpcutil.ScrubGRPC(err))
+	}
+	return nil
+}
 
-		// Create ACL for new repo
-		whoAmI, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx),
-			&auth.WhoAmIRequest{})
-		if err != nil && !auth.IsNotActivatedError(err) {
-			return fmt.Errorf("error while creating repo \"%s\": %v",
-				repo.Name, grpcutil.ScrubGRPC(err))
-		} else if err == nil {
-			// auth is active, and user is logged in. Make user an owner of the new
-			// repo (and clear any existing ACL under this name that might have been
-			// created by accident)
-			_, err := d.pachClient.AuthAPIClient.SetACL(auth.In2Out(ctx), &auth.SetACLRequest{
-				Repo: repo.Name,
-				Entries: []*auth.ACLEntry{{
-					Username: whoAmI.Username,
-					Scope:    auth.Scope_OWNER,
-				}},
-			})
-			if err != nil {
-				return fmt.Errorf("could not create ACL for new repo \"%s\": %v",
-					repo.Name, grpcutil.ScrubGRPC(err))
-			}
+// createRepoInSTM creates a new repo as part of 'stm', which may also contain
+// the other requests of a transaction.
+func (d *driver) createRepoInSTM(ctx context.Context, stm col.STM, repo *pfs.Repo, provenance []*pfs.Repo, description string) error {
+	repos := d.repos.ReadWrite(stm)
+	repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
+
+	// check if 'repo' already exists. If so, return that error. The repo's
+	// ACL is only created once the repo has been (see setRepoOwner)
+	var existingRepoInfo pfs.RepoInfo
+	err := repos.Get(repo.Name, &existingRepoInfo)
+	if err != nil && !col.IsErrNotFound(err) {
+		return fmt.Errorf("error checking whether \"%s\" exists: %v",
+			repo.Name, err)
+	} else if err == nil {
+		return fmt.Errorf("cannot create \"%s\" as it already exists", repo.Name)
+	}
+
+	// compute the full provenance of this repo
+	fullProv := make(map[string]bool)
+	for _, prov := range provenance {
+		fullProv[prov.Name] = true
+		provRepo := new(pfs.RepoInfo)
+		if err := repos.Get(prov.Name, provRepo); err != nil {
+			return err
 		}
-
-		// compute the full provenance of this repo
-		fullProv := make(map[string]bool)
-		for _, prov := range provenance {
+		// the provenance of my provenance is my provenance
+		for _, prov := range provRepo.Provenance {
 			fullProv[prov.Name] = true
-			provRepo := new(pfs.RepoInfo)
-			if err := repos.Get(prov.Name, provRepo); err != nil {
-				return err
-			}
-			// the provenance of my provenance is my provenance
-			for _, prov := range provRepo.Provenance {
-				fullProv[prov.Name] = true
-			}
 		}
+	}
 
-		var fullProvRepos []*pfs.Repo
-		for prov := range fullProv {
-			fullProvRepos = append(fullProvRepos, &pfs.Repo{prov})
-			if err := repoRefCounts.Increment(prov); err != nil {
-				return err
-			}
-		}
-		if err := repoRefCounts.Create(repo.Name, 0); err != nil {
+	var fullProvRepos []*pfs.Repo
+	for prov := range fullProv {
+		fullProvRepos = append(fullProvRepos, &pfs.Repo{prov})
+		if err := repoRefCounts.Increment(prov); err != nil {
 			return err
 		}
-		repoInfo := &pfs.RepoInfo{
-			Repo:        repo,
-			Created:     now(),
-			Provenance:  fullProvRepos,
-			Description: description,
-		}
-		return repos.Create(repo.Name, repoInfo)
-	})
-	return err
+	}
+	if err := repoRefCounts.Create(repo.Name, 0); err != nil {
+		return err
+	}
+	repoInfo := &pfs.RepoInfo{
+		Repo:        repo,
+		Created:     now(),
+		Provenance:  fullProvRepos,
+		Description: description,
+	}
+	return repos.Create(repo.Name, repoInfo)
 }
 
 func (d *driver) updateRepo(ctx context.Context, repo *pfs.Repo, provenance []*pfs.Repo, description string) error {
@@ -391,6 +423,242 @@ func (d *driver) inspectRepo(ctx context.Context, repo *pfs.Repo, includeAuth bo
 	return result, nil
 }
 
+// setQuota sets (or, if 'quota' is empty, removes) the size limits of 'repo',
+// or of 'branch' in 'repo' if it's set.
+func (d *driver) setQuota(ctx context.Context, repo *pfs.Repo, branch string, quota *pfs.Quota) error {
+	d.initializePachConn()
+	if me, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{}); err == nil {
+		if !me.IsAdmin {
+			return fmt.Errorf("not authorized to set the quota of \"%s\", must be a cluster admin", repo.Name)
+		}
+	} else if !auth.IsNotActivatedError(err) {
+		return fmt.Errorf("could not verify that caller is admin: %v", grpcutil.ScrubGRPC(err))
+	}
+	if quota != nil {
+		if quota.SizeBytes > 0 && quota.SoftSizeBytes > quota.SizeBytes {
+			return fmt.Errorf("soft size limit (%d) cannot be larger than the hard limit (%d)", quota.SoftSizeBytes, quota.SizeBytes)
+		}
+		if quota.FileCount > 0 && quota.SoftFileCount > quota.FileCount {
+			return fmt.Errorf("soft file count limit (%d) cannot be larger than the hard limit (%d)", quota.SoftFileCount, quota.FileCount)
+		}
+		if *quota == (pfs.Quota{}) {
+			quota = nil
+		}
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		if branch != "" {
+			branches := d.branches(repo.Name).ReadWrite(stm)
+			branchInfo := &pfs.BranchInfo{}
+			if err := branches.Get(branch, branchInfo); err != nil {
+				return err
+			}
+			branchInfo.Quota = quota
+			return branches.Put(branch, branchInfo)
+		}
+		repos := d.repos.ReadWrite(stm)
+		repoInfo := &pfs.RepoInfo{}
+		if err := repos.Get(repo.Name, repoInfo); err != nil {
+			return err
+		}
+		repoInfo.Quota = quota
+		return repos.Put(repo.Name, repoInfo)
+	})
+	return err
+}
+
+// quotaWarnings returns a warning for each of the soft limits that 'repoInfo'
+// or its branches are over. It's only called by InspectRepo, as checking the
+// branches' limits may require reading their trees.
+func (d *driver) quotaWarnings(ctx context.Context, repoInfo *pfs.RepoInfo) ([]string, error) {
+	var warnings []string
+	if quota := repoInfo.Quota; quota != nil {
+		if quota.SoftSizeBytes > 0 && repoInfo.TreeSizeBytes > quota.SoftSizeBytes {
+			warnings = append(warnings, fmt.Sprintf("size (%d bytes) is over the soft limit of %d bytes", repoInfo.TreeSizeBytes, quota.SoftSizeBytes))
+		}
+		if quota.SoftFileCount > 0 && repoInfo.FileCount > quota.SoftFileCount {
+			warnings = append(warnings, fmt.Sprintf("file count (%d) is over the soft limit of %d", repoInfo.FileCount, quota.SoftFileCount))
+		}
+	}
+	// The branches are read directly, rather than through listBranch, as
+	// InspectRepo doesn't require read access to the repo
+	iter, err := d.branches(repoInfo.Repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var branchName string
+		branchInfo := &pfs.BranchInfo{}
+		ok, err := iter.Next(&branchName, branchInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		branchInfo.Name = path.Base(branchName)
+		quota := branchInfo.Quota
+		if quota == nil || (quota.SoftSizeBytes == 0 && quota.SoftFileCount == 0) || branchInfo.Head == nil {
+			continue
+		}
+		// The branch's size is that of its most recently finished commit
+		commits := d.commits(repoInfo.Repo.Name).ReadOnly(ctx)
+		headInfo := &pfs.CommitInfo{}
+		if err := commits.Get(branchInfo.Head.ID, headInfo); err != nil {
+			return nil, err
+		}
+		if headInfo.Finished == nil {
+			if headInfo.ParentCommit == nil {
+				continue
+			}
+			if err := commits.Get(headInfo.ParentCommit.ID, headInfo); err != nil {
+				return nil, err
+			}
+		}
+		if quota.SoftSizeBytes > 0 && headInfo.SizeBytes > quota.SoftSizeBytes {
+			warnings = append(warnings, fmt.Sprintf("size of branch %s (%d bytes) is over its soft limit of %d bytes", branchInfo.Name, headInfo.SizeBytes, quota.SoftSizeBytes))
+		}
+		if quota.SoftFileCount > 0 && headInfo.Tree != nil {
+			tree, err := d.getTreeForObject(headInfo.Tree)
+			if err != nil {
+				return nil, err
+			}
+			fileCount, err := countFiles(tree)
+			if err != nil {
+				return nil, err
+			}
+			if fileCount > quota.SoftFileCount {
+				warnings = append(warnings, fmt.Sprintf("file count of branch %s (%d) is over its soft limit of %d", branchInfo.Name, fileCount, quota.SoftFileCount))
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// putFileQuotaReader returns 'reader', limited to the number of bytes that
+// can be written to 'repo' before it goes over its size limit. Reading past
+// the limit returns a quota exceeded error, so that PutFile stops uploading
+// data that would be rejected, and an error is returned right away if the
+// repo is already at its limit. Any objects that were uploaded before the
+// limit was reached aren't referenced by a commit, and are removed by garbage
+// collection. This is only an early check, the quotas of repos and branches
+// are enforced when commits are finished (see checkQuota).
+func (d *driver) putFileQuotaReader(ctx context.Context, repo *pfs.Repo, reader io.Reader) (io.Reader, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo.Name, repoInfo); err != nil {
+		return nil, err
+	}
+	quota := repoInfo.Quota
+	if quota == nil || quota.SizeBytes == 0 {
+		return reader, nil
+	}
+	if repoInfo.TreeSizeBytes >= quota.SizeBytes {
+		return nil, pfsserver.NewQuotaExceededError(repo, "", "size", repoInfo.TreeSizeBytes, quota.SizeBytes)
+	}
+	return &quotaReader{
+		r:     reader,
+		limit: quota.SizeBytes - repoInfo.TreeSizeBytes,
+		exceeded: func(read uint64) error {
+			return pfsserver.NewQuotaExceededError(repo, "", "size", repoInfo.TreeSizeBytes+read, quota.SizeBytes)
+		},
+	}, nil
+}
+
+// quotaReader is an io.Reader that fails with the error returned by
+// 'exceeded' once more than 'limit' bytes have been read from 'r'.
+type quotaReader struct {
+	r        io.Reader
+	limit    uint64
+	read     uint64
+	exceeded func(read uint64) error
+}
+
+func (r *quotaReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.read += uint64(n)
+	if r.read > r.limit {
+		return 0, r.exceeded(r.read)
+	}
+	return n, err
+}
+
+// checkQuota returns a quota exceeded error if 'tree', the tree of a newly
+// finished commit in 'repo' (or, if it's set, in 'branch' of 'repo'), is over
+// one of the hard limits in 'quota'. A limit is only enforced on commits that
+// grow the size (or file count) of their parent's tree, 'parentTree', so
+// that a repo can always be brought back under its quota by deleting files.
+func checkQuota(quota *pfs.Quota, repo *pfs.Repo, branch string, tree hashtree.HashTree, parentTree hashtree.HashTree) error {
+	if quota == nil {
+		return nil
+	}
+	if quota.SizeBytes > 0 {
+		size := uint64(tree.FSSize())
+		if size > quota.SizeBytes && size > treeSize(parentTree) {
+			return pfsserver.NewQuotaExceededError(repo, branch, "size", size, quota.SizeBytes)
+		}
+	}
+	if quota.FileCount > 0 {
+		fileCount, err := countFiles(tree)
+		if err != nil {
+			return err
+		}
+		if fileCount > quota.FileCount {
+			parentFileCount, err := countFiles(parentTree)
+			if err != nil {
+				return err
+			}
+			if fileCount > parentFileCount {
+				return pfsserver.NewQuotaExceededError(repo, branch, "file count", fileCount, quota.FileCount)
+			}
+		}
+	}
+	return nil
+}
+
+// updateRepoSize adds the size of the files added by a newly finished commit
+// (whose tree is 'tree', and whose parent's tree is 'parentTree') to the size
+// of 'repoInfo', and records the size and number of files in 'tree'. The
+// latter are recorded whether or not the repo has a quota, so that they're
+// correct if a quota is set later. A quota exceeded error is returned if
+// 'tree' is over one of the repo's hard limits (see checkQuota).
+func updateRepoSize(repoInfo *pfs.RepoInfo, tree hashtree.HashTree, parentTree hashtree.HashTree) error {
+	if err := checkQuota(repoInfo.Quota, repoInfo.Repo, "", tree, parentTree); err != nil {
+		return err
+	}
+	repoInfo.SizeBytes += sizeChange(tree, parentTree)
+	repoInfo.TreeSizeBytes = uint64(tree.FSSize())
+	fileCount, err := countFiles(tree)
+	if err != nil {
+		return err
+	}
+	repoInfo.FileCount = fileCount
+	return nil
+}
+
+// treeSize returns the size of the files in 'tree', which may be nil.
+func treeSize(tree hashtree.HashTree) uint64 {
+	if tree == nil {
+		return 0
+	}
+	return uint64(tree.FSSize())
+}
+
+// countFiles returns the number of files in 'tree', which may be nil.
+func countFiles(tree hashtree.HashTree) (uint64, error) {
+	if tree == nil {
+		return 0, nil
+	}
+	var result uint64
+	if err := tree.Walk("/", func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode != nil {
+			result++
+		}
+		return nil
+	}); err != nil && hashtree.Code(err) != hashtree.PathNotFound {
+		return 0, err
+	}
+	return result, nil
+}
+
 func (d *driver) getAccessLevel(ctx context.Context, repo *pfs.Repo) (auth.Scope, error) {
 	who, err := d.pachClient.AuthAPIClient.WhoAmI(auth.In2Out(ctx),
 		&auth.WhoAmIRequest{})
@@ -514,6 +782,40 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 			}
 		}
 
+		// Detach this repo's branches from branches in other repos, so that PFS
+		// stops propagating commits into (or out of) this repo
+		branchInfos, err := d.listBranchInSTM(stm, repo)
+		if err != nil {
+			return err
+		}
+		for _, branchInfo := range branchInfos {
+			branch := &pfs.Branch{Repo: repo, Name: branchInfo.Name}
+			for _, subvBranch := range branchInfo.Subvenance {
+				if subvBranch.Repo.Name == repo.Name {
+					continue
+				}
+				if !force {
+					return fmt.Errorf("cannot delete \"%s\" as branch \"%s\" is in the provenance of %s",
+						repo.Name, branchInfo.Name, branchKey(subvBranch))
+				}
+				if err := d.updateBranchInfo(stm, subvBranch, func(subvBranchInfo *pfs.BranchInfo) {
+					subvBranchInfo.Provenance = removeBranch(subvBranchInfo.Provenance, branch)
+				}); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+			}
+			for _, provBranch := range branchInfo.Provenance {
+				if provBranch.Repo.Name == repo.Name {
+					continue
+				}
+				if err := d.updateBranchInfo(stm, provBranch, func(provBranchInfo *pfs.BranchInfo) {
+					provBranchInfo.Subvenance = removeBranch(provBranchInfo.Subvenance, branch)
+				}); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+			}
+		}
+
 		if err := repos.Delete(repo.Name); err != nil {
 			return err
 		}
@@ -537,6 +839,29 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 }
 
 func (d *driver) startCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, description string) (*pfs.Commit, error) {
+	if txnID := transactionID(ctx); txnID != "" {
+		if err := d.checkIsAuthorized(ctx, parent.Repo, auth.Scope_WRITER); err != nil {
+			return nil, err
+		}
+		// The commit's ID is allocated now, so that the caller can refer to it
+		// in the rest of the transaction
+		commit := &pfs.Commit{
+			Repo: parent.Repo,
+			ID:   uuid.NewWithoutDashes(),
+		}
+		if err := d.addToTransaction(ctx, txnID, &pfs.TransactionRequest{
+			StartCommit: &pfs.StartCommitRequest{
+				Parent:      parent,
+				Branch:      branch,
+				Provenance:  provenance,
+				Description: description,
+			},
+			Commit: commit,
+		}); err != nil {
+			return nil, err
+		}
+		return commit, nil
+	}
 	return d.makeCommit(ctx, parent, branch, provenance, nil, description)
 }
 
@@ -557,110 +882,150 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 	}
 	var tree hashtree.HashTree
 	if treeRef != nil {
-		var buf bytes.Buffer
-		if err := d.pachClient.GetObject(treeRef.Hash, &buf); err != nil {
-			return nil, err
-		}
-		_tree, err := hashtree.Deserialize(buf.Bytes())
+		var err error
+		tree, err = d.getTreeForObject(treeRef)
 		if err != nil {
 			return nil, err
 		}
-		tree = _tree
 	}
-	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		repos := d.repos.ReadWrite(stm)
-		commits := d.commits(parent.Repo.Name).ReadWrite(stm)
-		branches := d.branches(parent.Repo.Name).ReadWrite(stm)
+	if err := d.newSTMWithTrees(ctx, func(stm col.STM, trees *treeWriter) error {
+		return d.makeCommitInSTM(ctx, stm, trees, commit, parent, branch, provenance, tree, description)
+	}); err != nil {
+		return nil, err
+	}
 
-		// Check if repo exists
-		repoInfo := new(pfs.RepoInfo)
-		if err := repos.Get(parent.Repo.Name, repoInfo); err != nil {
-			return err
-		}
+	return commit, nil
+}
 
-		commitInfo := &pfs.CommitInfo{
-			Commit:      commit,
-			Started:     now(),
-			Description: description,
-		}
+// makeCommitInSTM creates 'commit' as part of 'stm', which may also contain
+// the other requests of a transaction. If 'tree' is set, the new commit is
+// finished immediately, with 'tree' as its contents, and its final tree is
+// added to 'trees'.
+func (d *driver) makeCommitInSTM(ctx context.Context, stm col.STM, trees *treeWriter, commit *pfs.Commit, parent *pfs.Commit, branch string, provenance []*pfs.Commit, tree hashtree.HashTree, description string) error {
+	repos := d.repos.ReadWrite(stm)
+	commits := d.commits(parent.Repo.Name).ReadWrite(stm)
+	branches := d.branches(parent.Repo.Name).ReadWrite(stm)
+
+	// Check if repo exists
+	repoInfo := new(pfs.RepoInfo)
+	if err := repos.Get(parent.Repo.Name, repoInfo); err != nil {
+		return err
+	}
 
-		// Use a map to de-dup provenance
-		provenanceMap := make(map[string]*pfs.Commit)
-		// Build the full provenance; my provenance's provenance is
-		// my provenance
-		for _, prov := range provenance {
-			provCommits := d.commits(prov.Repo.Name).ReadWrite(stm)
-			provCommitInfo := new(pfs.CommitInfo)
-			if err := provCommits.Get(prov.ID, provCommitInfo); err != nil {
-				return err
-			}
-			for _, c := range provCommitInfo.Provenance {
-				provenanceMap[c.ID] = c
-			}
+	commitInfo := &pfs.CommitInfo{
+		Commit:      commit,
+		Started:     now(),
+		Description: description,
+	}
+
+	// Use a map to de-dup provenance
+	provenanceMap := make(map[string]*pfs.Commit)
+	// Build the full provenance; my provenance's provenance is
+	// my provenance
+	for _, prov := range provenance {
+		provCommits := d.commits(prov.Repo.Name).ReadWrite(stm)
+		provCommitInfo := new(pfs.CommitInfo)
+		if err := provCommits.Get(prov.ID, provCommitInfo); err != nil {
+			return err
 		}
-		// finally include the given provenance
-		for _, c := range provenance {
+		for _, c := range provCommitInfo.Provenance {
 			provenanceMap[c.ID] = c
 		}
+	}
+	// finally include the given provenance
+	for _, c := range provenance {
+		provenanceMap[c.ID] = c
+	}
 
-		for _, c := range provenanceMap {
-			commitInfo.Provenance = append(commitInfo.Provenance, c)
-		}
+	for _, c := range provenanceMap {
+		commitInfo.Provenance = append(commitInfo.Provenance, c)
+	}
 
-		if branch != "" {
-			// If we don't have an explicit parent we use the previous head of
-			// branch as the parent, if it exists.
-			if parent.ID == "" {
-				head := new(pfs.Commit)
-				if err := branches.Get(branch, head); err != nil {
-					if _, ok := err.(col.ErrNotFound); !ok {
-						return err
-					}
-				} else {
-					parent.ID = head.ID
-				}
-			}
-			// Make commit the new head of the branch
-			if err := branches.Put(branch, commit); err != nil {
+	var branchQuota *pfs.Quota
+	if branch != "" {
+		branchInfo := &pfs.BranchInfo{}
+		if err := branches.Get(branch, branchInfo); err != nil {
+			if _, ok := err.(col.ErrNotFound); !ok {
 				return err
 			}
-		}
-		if parent.ID != "" {
-			parentCommitInfo, err := d.inspectCommit(ctx, parent)
-			if err != nil {
+			branchInfo.Name = branch
+			if err := d.touchRepo(stm, parent.Repo); err != nil {
 				return err
 			}
-			// fail if the parent commit has not been finished
-			if parentCommitInfo.Finished == nil {
-				return fmt.Errorf("parent commit %s has not been finished", parent.ID)
-			}
-			commitInfo.ParentCommit = parent
+		} else if parent.ID == "" && branchInfo.Head != nil {
+			// If we don't have an explicit parent we use the previous head of
+			// branch as the parent, if it exists.
+			parent.ID = branchInfo.Head.ID
 		}
+		branchQuota = branchInfo.Quota
+		// Make commit the new head of the branch
+		branchInfo.Head = commit
+		if err := branches.Put(branch, branchInfo); err != nil {
+			return err
+		}
+	}
+	if parent.ID != "" {
+		parentCommitInfo, err := d.resolveCommitInSTM(stm, parent)
+		if err != nil {
+			return err
+		}
+		// fail if the parent commit has not been finished
+		if parentCommitInfo.Finished == nil {
+			return fmt.Errorf("parent commit %s has not been finished", parent.ID)
+		}
+		commitInfo.ParentCommit = parent
+	}
+	if tree != nil {
 		parentTree, err := d.getTreeForCommit(ctx, parent)
 		if err != nil {
 			return err
 		}
-		if treeRef != nil {
-			commitInfo.Tree = treeRef
-			commitInfo.SizeBytes = uint64(tree.FSSize())
-			commitInfo.Finished = now()
-			repoInfo.SizeBytes += sizeChange(tree, parentTree)
-			repos.Put(parent.Repo.Name, repoInfo)
-		} else {
-			d.openCommits.ReadWrite(stm).Put(commit.ID, commit)
+		// Record which of the tree's nodes were modified by the new commit
+		finishedTree, err := d.setCommitTree(trees, commitInfo, tree.Open(), parentTree)
+		if err != nil {
+			return err
 		}
-		return commits.Create(commit.ID, commitInfo)
-	}); err != nil {
-		return nil, err
+		if err := updateRepoSize(repoInfo, finishedTree, parentTree); err != nil {
+			return err
+		}
+		if err := checkQuota(branchQuota, parent.Repo, branch, finishedTree, parentTree); err != nil {
+			return err
+		}
+		repos.Put(parent.Repo.Name, repoInfo)
+	} else {
+		d.openCommits.ReadWrite(stm).Put(commit.ID, commit)
 	}
-
-	return commit, nil
+	if err := commits.Create(commit.ID, commitInfo); err != nil {
+		return err
+	}
+	// A built commit is finished immediately, so the branch's head has
+	// moved to a finished commit
+	if tree != nil && branch != "" {
+		return d.propagateCommit(stm, &pfs.Branch{Repo: parent.Repo, Name: branch})
+	}
+	return nil
 }
 
-func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, description string) error {
+// finishCommit finishes 'commit'. Its contents are the files put into it,
+// unless 'treeRef' is set, in which case they're the hashtree 'treeRef'.
+func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, description string, treeRef *pfs.Object, empty bool) error {
 	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
+	if treeRef != nil && empty {
+		return fmt.Errorf("a commit can't be finished with both a tree and no files")
+	}
+	if txnID := transactionID(ctx); txnID != "" {
+		if treeRef != nil || empty {
+			return fmt.Errorf("a commit can't be finished with a tree (or no files) in a transaction")
+		}
+		return d.addToTransaction(ctx, txnID, &pfs.TransactionRequest{
+			FinishCommit: &pfs.FinishCommitRequest{
+				Commit:      commit,
+				Description: description,
+			},
+		})
+	}
 	commitInfo, err := d.inspectCommit(ctx, commit)
 	if err != nil {
 		return err
@@ -681,51 +1046,37 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, descripti
 	if err != nil {
 		return err
 	}
-	finishedTree, err := d.getTreeForPrefix(ctx, prefix, parentTree)
-	if err != nil {
-		return err
-	}
-	// Serialize the tree
-	data, err := hashtree.Serialize(finishedTree)
-	if err != nil {
-		return err
-	}
-
-	if len(data) > 0 {
-		// Put the tree into the blob store
-		obj, _, err := d.pachClient.PutObject(bytes.NewReader(data))
+	var tree hashtree.OpenHashTree
+	if treeRef != nil {
+		t, err := d.getTreeForObject(treeRef)
+		if err != nil {
+			return err
+		}
+		tree = t.Open()
+	} else if empty {
+		tree = hashtree.NewHashTree()
+	} else {
+		tree, err = d.openTreeForPrefix(ctx, prefix, parentTree)
 		if err != nil {
 			return err
 		}
-
-		commitInfo.Tree = obj
 	}
-
-	commitInfo.SizeBytes = uint64(finishedTree.FSSize())
-	commitInfo.Finished = now()
-
-	sizeChange := sizeChange(finishedTree, parentTree)
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
-		repos := d.repos.ReadWrite(stm)
-
-		commits.Put(commit.ID, commitInfo)
-		if err := d.openCommits.ReadWrite(stm).Delete(commit.ID); err != nil {
-			return fmt.Errorf("could not confirm that commit %s is open; this is likely a bug. err: %v", commit.ID, err)
+	// The tree is only written to the object store once the commit passes
+	// its quotas (which are checked by writeFinishedCommit). If it doesn't,
+	// the commit is left open, so that files can be deleted from it.
+	if err := d.newSTMWithTrees(ctx, func(stm col.STM, trees *treeWriter) error {
+		finishedTree, err := d.setCommitTree(trees, commitInfo, tree, parentTree)
+		if err != nil {
+			return err
 		}
-		// update repo size
-		repoInfo := new(pfs.RepoInfo)
-		if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
+		// Find the branches whose head is this commit, as their subvenance
+		// needs to be updated once the commit is finished
+		headOf, err := d.branchesWithHead(stm, commit)
+		if err != nil {
 			return err
 		}
-
-		// Increment the repo sizes by the sizes of the files that have
-		// been added in this commit.
-		repoInfo.SizeBytes += sizeChange
-		repos.Put(commit.Repo.Name, repoInfo)
-		return nil
-	})
-	if err != nil {
+		return d.writeFinishedCommit(stm, commitInfo, finishedTree, parentTree, headOf)
+	}); err != nil {
 		return err
 	}
 	// Delete the scratch space for this commit
@@ -733,6 +1084,167 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, descripti
 	return err
 }
 
+// setCommitTree finishes 'tree' and marks 'commitInfo' as finished with
+// 'tree' as its contents. The nodes of 'tree' that differ from 'parentTree'
+// are recorded as modified by the commit. The tree isn't written to the
+// object store here: it's added to 'trees', which writes it before the
+// commit is (see newSTMWithTrees).
+func (d *driver) setCommitTree(trees *treeWriter, commitInfo *pfs.CommitInfo, tree hashtree.OpenHashTree, parentTree hashtree.HashTree) (hashtree.HashTree, error) {
+	commitInfo.Finished = trees.finished
+	tree.SetModified(parentTree, commitInfo.Commit.ID, commitInfo.Finished)
+	finishedTree, err := tree.Finish()
+	if err != nil {
+		return nil, err
+	}
+	// Serialize the tree
+	data, err := hashtree.Serialize(finishedTree)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > 0 {
+		commitInfo.Tree = trees.add(data)
+	}
+
+	commitInfo.SizeBytes = uint64(finishedTree.FSSize())
+	return finishedTree, nil
+}
+
+// treeWriter collects the serialized trees of the commits that are finished
+// in an STM. Writing a tree to the object store is a side effect that would
+// be repeated every time the STM is retried, so the trees are written
+// outside of it, before the STM is allowed to commit.
+type treeWriter struct {
+	// finished is the time at which the commits are finished. It's the same
+	// in every attempt of the STM, so that the trees (which record it) are
+	// too, and a tree written by an earlier attempt can be reused.
+	finished *types.Timestamp
+	// written is the set of trees (keyed by object hash) that are already in
+	// the object store
+	written map[string]bool
+	// pending holds the trees that still have to be written, keyed by the
+	// hash they'll be written under
+	pending map[string][]byte
+}
+
+func newTreeWriter() *treeWriter {
+	return &treeWriter{
+		finished: now(),
+		written:  make(map[string]bool),
+		pending:  make(map[string][]byte),
+	}
+}
+
+// add returns the object that the serialized tree 'data' is written as,
+// recording it as pending if it hasn't been written yet.
+func (t *treeWriter) add(data []byte) *pfs.Object {
+	hash := pfs.NewHash()
+	hash.Write(data)
+	obj := &pfs.Object{Hash: pfs.EncodeHash(hash.Sum(nil))}
+	if !t.written[obj.Hash] {
+		t.pending[obj.Hash] = data
+	}
+	return obj
+}
+
+// write writes the pending trees to the object store.
+func (t *treeWriter) write(pachClient *client.APIClient) error {
+	for hash, data := range t.pending {
+		obj, _, err := pachClient.PutObject(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if obj.Hash != hash {
+			return fmt.Errorf("tree was written as object %s rather than %s; this is likely a bug", obj.Hash, hash)
+		}
+		t.written[hash] = true
+	}
+	t.pending = make(map[string][]byte)
+	return nil
+}
+
+// errTreesNotWritten aborts an attempt of an STM run by newSTMWithTrees that
+// finished commits with trees that aren't in the object store yet.
+var errTreesNotWritten = errors.New("trees not written")
+
+// newSTMWithTrees runs 'apply' in an STM, like col.NewSTM. The trees of the
+// commits that 'apply' finishes are written to the object store before the
+// STM commits, but outside of it: if an attempt of the STM produces trees
+// that haven't been written yet, it's aborted, the trees are written and the
+// STM is run again.
+func (d *driver) newSTMWithTrees(ctx context.Context, apply func(col.STM, *treeWriter) error) error {
+	trees := newTreeWriter()
+	for {
+		_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+			trees.pending = make(map[string][]byte)
+			if err := apply(stm, trees); err != nil {
+				return err
+			}
+			if len(trees.pending) > 0 {
+				return errTreesNotWritten
+			}
+			return nil
+		})
+		if err != errTreesNotWritten {
+			return err
+		}
+		if err := trees.write(d.pachClient); err != nil {
+			return err
+		}
+	}
+}
+
+// writeFinishedCommit writes the finished 'commitInfo' (whose tree is 'tree',
+// and whose parent's tree is 'parentTree') as part of 'stm', updates the size
+// of its repo and opens new commits downstream of the branches in 'headOf'
+// (the branches whose head is the finished commit). It returns a quota
+// exceeded error if the commit is over the quota of its repo or one of those
+// branches.
+func (d *driver) writeFinishedCommit(stm col.STM, commitInfo *pfs.CommitInfo, tree hashtree.HashTree, parentTree hashtree.HashTree, headOf []*pfs.Branch) error {
+	commit := commitInfo.Commit
+	commits := d.commits(commit.Repo.Name).ReadWrite(stm)
+	repos := d.repos.ReadWrite(stm)
+
+	commits.Put(commit.ID, commitInfo)
+	if err := d.openCommits.ReadWrite(stm).Delete(commit.ID); err != nil {
+		return fmt.Errorf("could not confirm that commit %s is open; this is likely a bug. err: %v", commit.ID, err)
+	}
+	// update repo size
+	repoInfo := new(pfs.RepoInfo)
+	if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
+		return err
+	}
+
+	// Increment the repo sizes by the sizes of the files that have
+	// been added in this commit.
+	if err := updateRepoSize(repoInfo, tree, parentTree); err != nil {
+		return err
+	}
+	repos.Put(commit.Repo.Name, repoInfo)
+
+	branches := d.branches(commit.Repo.Name).ReadWrite(stm)
+	for _, branch := range headOf {
+		branchInfo := &pfs.BranchInfo{}
+		if err := branches.Get(branch.Name, branchInfo); err != nil {
+			return err
+		}
+		if err := checkQuota(branchInfo.Quota, commit.Repo, branch.Name, tree, parentTree); err != nil {
+			return err
+		}
+	}
+	for _, branch := range headOf {
+		// The branch itself may have fallen behind its provenance while this
+		// commit was open, so we check it before its subvenance
+		if err := d.propagateBranch(stm, branch); err != nil {
+			return err
+		}
+		if err := d.propagateCommit(stm, branch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func sizeChange(tree hashtree.HashTree, parentTree hashtree.HashTree) uint64 {
 	if parentTree == nil {
 		return uint64(tree.FSSize())
@@ -765,16 +1277,19 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Co
 	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
 
-		head := new(pfs.Commit)
+		branchInfo := new(pfs.BranchInfo)
 		// See if we are given a branch
-		if err := branches.Get(commitID, head); err != nil {
+		if err := branches.Get(commitID, branchInfo); err != nil {
 			if _, ok := err.(col.ErrNotFound); !ok {
 				return err
 			}
 			// If it's not a branch, use it as it is
 			return nil
 		}
-		commitID = head.ID
+		if branchInfo.Head == nil {
+			return pfsserver.ErrCommitNotFound{commit}
+		}
+		commitID = branchInfo.Head.ID
 		return nil
 	})
 	if err != nil {
@@ -802,6 +1317,49 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Co
 	return commitInfo, nil
 }
 
+// resolveCommitInSTM is like inspectCommit, but reads the commit (and the
+// branch it may refer to) through 'stm', so that it sees commits and branches
+// that were created earlier in the same transaction. Like inspectCommit, it
+// replaces the ID in the given commit with the real commit ID.
+func (d *driver) resolveCommitInSTM(stm col.STM, commit *pfs.Commit) (*pfs.CommitInfo, error) {
+	if commit == nil {
+		return nil, fmt.Errorf("cannot inspect nil commit")
+	}
+	commitID, ancestryLength := parseCommitID(commit.ID)
+
+	// Check if the commitID is a branch name
+	branchInfo := new(pfs.BranchInfo)
+	if err := d.branches(commit.Repo.Name).ReadWrite(stm).Get(commitID, branchInfo); err != nil {
+		if _, ok := err.(col.ErrNotFound); !ok {
+			return nil, err
+		}
+	} else {
+		if branchInfo.Head == nil {
+			return nil, pfsserver.ErrCommitNotFound{commit}
+		}
+		commitID = branchInfo.Head.ID
+	}
+
+	var commitInfo *pfs.CommitInfo
+	nextCommit := &pfs.Commit{
+		Repo: commit.Repo,
+		ID:   commitID,
+	}
+	for i := 0; i <= ancestryLength; i++ {
+		if nextCommit == nil {
+			return nil, pfsserver.ErrCommitNotFound{commit}
+		}
+		commitInfo = new(pfs.CommitInfo)
+		if err := d.commits(commit.Repo.Name).ReadWrite(stm).Get(nextCommit.ID, commitInfo); err != nil {
+			return nil, pfsserver.ErrCommitNotFound{nextCommit}
+		}
+		nextCommit = commitInfo.ParentCommit
+	}
+
+	commit.ID = commitInfo.Commit.ID
+	return commitInfo, nil
+}
+
 // parseCommitID accepts a commit ID that might contain the Git ancestry
 // syntax, such as "master^2", "master~~", "master^^", "master~5", etc.
 // It then returns the ID component such as "master" and the depth of the
@@ -924,7 +1482,8 @@ func (c *commitStream) Close() {
 	close(c.done)
 }
 
-func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch string, from *pfs.Commit) (CommitStream, error) {
+// subscribeCommit returns the commits on 'branch', as they reach 'state'.
+func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch string, from *pfs.Commit, state pfs.CommitState) (CommitStream, error) {
 	d.initializePachConn()
 	if from != nil && from.Repo.Name != repo.Name {
 		return nil, fmt.Errorf("the `from` commit needs to be from repo %s", repo.Name)
@@ -958,7 +1517,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 		// keep track of the commits that have been sent
 		seen := make(map[string]bool)
 		// include all commits that are currently on the given branch,
-		// but only the ones that have been finished
+		// but only the ones that have reached 'state'
 		commitInfos, err := d.listCommit(ctx, repo, &pfs.Commit{
 			Repo: repo,
 			ID:   branch,
@@ -976,7 +1535,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 		// order, so we reverse the order.
 		for i := range commitInfos {
 			commitInfo := commitInfos[len(commitInfos)-i-1]
-			if commitInfo.Finished != nil {
+			if commitInfo.Finished != nil || state == pfs.CommitState_STARTED {
 				select {
 				case stream <- CommitEvent{
 					Value: commitInfo,
@@ -990,7 +1549,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 
 		for {
 			var branchName string
-			commit := new(pfs.Commit)
+			var commit *pfs.Commit
 			for {
 				var event *watch.Event
 				var ok bool
@@ -1006,10 +1565,16 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 				case watch.EventError:
 					return event.Err
 				case watch.EventPut:
-					event.Unmarshal(&branchName, commit)
+					branchInfo := new(pfs.BranchInfo)
+					event.Unmarshal(&branchName, branchInfo)
+					commit = branchInfo.Head
 				case watch.EventDelete:
 					continue
 				}
+				// A branch may not have a head yet
+				if commit == nil {
+					continue
+				}
 
 				// We don't want to include the `from` commit itself
 
@@ -1022,7 +1587,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 					break
 				}
 			}
-			// Now we watch the CommitInfo until the commit has been finished
+			// Now we watch the CommitInfo until the commit has reached 'state'
 			commits := d.commits(commit.Repo.Name).ReadOnly(ctx)
 			// closure for defer
 			if err := func() error {
@@ -1046,7 +1611,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 						// to get a new commit
 						return nil
 					}
-					if commitInfo.Finished != nil {
+					if commitInfo.Finished != nil || state == pfs.CommitState_STARTED {
 						select {
 						case stream <- CommitEvent{
 							Value: commitInfo,
@@ -1277,11 +1842,21 @@ func (d *driver) deleteCommit(ctx context.Context, commit *pfs.Commit) error {
 	}
 
 	for _, branch := range branches {
-		if branch.Head.ID == commitInfo.Commit.ID {
+		if branch.Head != nil && branch.Head.ID == commitInfo.Commit.ID {
 			if commitInfo.ParentCommit != nil {
 				if err := d.setBranch(ctx, commitInfo.ParentCommit, branch.Name); err != nil {
 					return err
 				}
+			} else if len(branch.Provenance) > 0 || len(branch.Subvenance) > 0 {
+				// If this commit doesn't have a parent, but the branch is part
+				// of a provenance relationship, keep the branch without a head
+				if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+					return d.updateBranchInfo(stm, &pfs.Branch{Repo: commit.Repo, Name: branch.Name}, func(branchInfo *pfs.BranchInfo) {
+						branchInfo.Head = nil
+					})
+				}); err != nil {
+					return err
+				}
 			} else {
 				// If this commit doesn't have a parent, delete the branch
 				if err := d.deleteBranch(ctx, commit.Repo, branch.Name); err != nil {
@@ -1309,66 +1884,1061 @@ func (d *driver) deleteCommit(ctx context.Context, commit *pfs.Commit) error {
 	return err
 }
 
-func (d *driver) listBranch(ctx context.Context, repo *pfs.Repo) ([]*pfs.BranchInfo, error) {
-	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_READER); err != nil {
-		return nil, err
+// squashCommit removes the finished commit 'commit' from its repo's history.
+// Since every commit's tree is a full snapshot of its repo, the commit's
+// children already contain its changes, so they're simply re-parented onto
+// the commit's parent (and inherit the commit's provenance). Commits that
+// merged 'commit' get its parent as their merge parent instead, the files
+// last modified by 'commit' are recorded as modified by the child that now
+// contains its changes, and downstream commits that have 'commit' in their
+// provenance no longer refer to it.
+func (d *driver) squashCommit(ctx context.Context, commit *pfs.Commit) error {
+	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
+		return err
 	}
-	branches := d.branches(repo.Name).ReadOnly(ctx)
-	iterator, err := branches.List()
+	commitInfo, err := d.inspectCommit(ctx, commit)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if commitInfo.Finished == nil {
+		return fmt.Errorf("cannot squash open commit %s; use delete-commit instead", commit.FullID())
+	}
+	downstream, err := d.downstreamCommits(ctx, commit)
+	if err != nil {
+		return err
 	}
 
-	var res []*pfs.BranchInfo
+	// Work out the updates to the repo's commits, and rewrite the trees of
+	// the commit's descendants, outside of the STM: writing the trees to the
+	// object store mustn't be repeated when the STM is retried. The STM then
+	// only swaps the commits' pointers, as long as none of the commits read
+	// here has changed in the meantime.
+	iter, err := d.commits(commit.Repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	commitInfos, err := readCommitInfos(iter)
+	if err != nil {
+		return err
+	}
+	// original holds the commits as they were read, to detect concurrent
+	// changes to them
+	original := make(map[string]*pfs.CommitInfo)
+	children := make(map[string][]*pfs.CommitInfo)
+	for _, ci := range commitInfos {
+		original[ci.Commit.ID] = proto.Clone(ci).(*pfs.CommitInfo)
+		if ci.ParentCommit != nil {
+			children[ci.ParentCommit.ID] = append(children[ci.ParentCommit.ID], ci)
+		}
+	}
+	if len(children[commit.ID]) == 0 {
+		return fmt.Errorf("cannot squash commit %s as it has no children", commit.FullID())
+	}
+
+	updated := make(map[string]*pfs.CommitInfo)
+	for _, ci := range commitInfos {
+		if ci.MergeParent != nil && ci.MergeParent.ID == commit.ID {
+			ci.MergeParent = commitInfo.ParentCommit
+			updated[ci.Commit.ID] = ci
+		}
+	}
+	// descendants is the set of commits built on top of 'commit', whose trees
+	// may record it as the modifier of some of their files
+	descendants := map[string]bool{commit.ID: true}
+	trees := newTreeWriter()
+	for _, childInfo := range children[commit.ID] {
+		// An open child's tree is built on top of its parent's tree when
+		// it's finished, so it can't be re-parented yet
+		if childInfo.Finished == nil {
+			return fmt.Errorf("cannot squash commit %s into open commit %s", commit.FullID(), childInfo.Commit.FullID())
+		}
+		childInfo.ParentCommit = commitInfo.ParentCommit
+		childInfo.Provenance = addCommits(childInfo.Provenance, commitInfo.Provenance)
+		updated[childInfo.Commit.ID] = childInfo
+		// The child and its descendants inherited the modification
+		// stamps of the files changed by 'commit' from its tree
+		queue := []*pfs.CommitInfo{childInfo}
+		for len(queue) > 0 {
+			ci := queue[0]
+			queue = queue[1:]
+			queue = append(queue, children[ci.Commit.ID]...)
+			descendants[ci.Commit.ID] = true
+			if ci.Tree == nil {
+				continue
+			}
+			tree, err := d.getTreeForObject(ci.Tree)
+			if err != nil {
+				return err
+			}
+			renamed, err := hashtree.RenameModifiedCommit(tree, commit.ID, childInfo.Commit.ID)
+			if err != nil {
+				return err
+			}
+			if renamed == nil {
+				continue
+			}
+			data, err := hashtree.Serialize(renamed)
+			if err != nil {
+				return err
+			}
+			ci.Tree = trees.add(data)
+			updated[ci.Commit.ID] = ci
+		}
+	}
+	if err := trees.write(d.pachClient); err != nil {
+		return err
+	}
+
+	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		branchInfos, err := d.listBranchInSTM(stm, commit.Repo)
+		if err != nil {
+			return err
+		}
+		for _, branchInfo := range branchInfos {
+			if branchInfo.Head != nil && branchInfo.Head.ID == commit.ID {
+				return fmt.Errorf("cannot squash commit %s as it's the head of branch %s", commit.FullID(), branchInfo.Name)
+			}
+		}
+		// Check that the commits that are rewritten (or whose trees were
+		// read) haven't changed, and that no commit has been made on top of
+		// them, since they were read
+		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
+		iter, err := commits.ListPrefix("")
+		if err != nil {
+			return err
+		}
+		currentInfos, err := readCommitInfos(iter)
+		if err != nil {
+			return err
+		}
+		for _, ci := range currentInfos {
+			id := ci.Commit.ID
+			if _, ok := original[id]; !ok {
+				if (ci.ParentCommit != nil && descendants[ci.ParentCommit.ID]) || (ci.MergeParent != nil && ci.MergeParent.ID == commit.ID) {
+					return fmt.Errorf("commit %s was made on top of commit %s while it was being squashed; try again", ci.Commit.FullID(), commit.FullID())
+				}
+				continue
+			}
+			if (descendants[id] || updated[id] != nil) && !proto.Equal(ci, original[id]) {
+				return fmt.Errorf("commit %s was modified while commit %s was being squashed; try again", ci.Commit.FullID(), commit.FullID())
+			}
+		}
+		for commitID, ci := range updated {
+			if err := commits.Put(commitID, ci); err != nil {
+				return err
+			}
+		}
+
+		for _, downstreamCommit := range downstream {
+			downstreamCommits := d.commits(downstreamCommit.Repo.Name).ReadWrite(stm)
+			downstreamInfo := new(pfs.CommitInfo)
+			if err := downstreamCommits.Get(downstreamCommit.ID, downstreamInfo); err != nil {
+				return err
+			}
+			if downstreamInfo.Finished == nil {
+				return fmt.Errorf("cannot squash commit %s as it's in the provenance of open commit %s", commit.FullID(), downstreamCommit.FullID())
+			}
+			downstreamInfo.Provenance = removeCommit(downstreamInfo.Provenance, commit)
+			if err := downstreamCommits.Put(downstreamCommit.ID, downstreamInfo); err != nil {
+				return err
+			}
+		}
+		return commits.Delete(commit.ID)
+	})
+	return err
+}
+
+// readCommitInfos reads all of the commits from 'iter'.
+func readCommitInfos(iter col.Iterator) ([]*pfs.CommitInfo, error) {
+	var commitInfos []*pfs.CommitInfo
 	for {
-		var branchName string
-		head := new(pfs.Commit)
-		ok, err := iterator.Next(&branchName, head)
+		var commitID string
+		commitInfo := new(pfs.CommitInfo)
+		ok, err := iter.Next(&commitID, commitInfo)
 		if err != nil {
 			return nil, err
 		}
 		if !ok {
-			break
+			return commitInfos, nil
 		}
-		res = append(res, &pfs.BranchInfo{
-			Name: path.Base(branchName),
-			Head: head,
-		})
+		commitInfos = append(commitInfos, commitInfo)
 	}
-	return res, nil
 }
 
-func (d *driver) setBranch(ctx context.Context, commit *pfs.Commit, name string) error {
+// revertCommit adds a new commit to 'branch' whose tree is the tree of the
+// branch's head with the changes made by 'commit' undone. An error is
+// returned if any of the files changed by 'commit' has been modified since.
+func (d *driver) revertCommit(ctx context.Context, commit *pfs.Commit, branch string) (*pfs.Commit, error) {
 	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
-		return err
+		return nil, err
 	}
-	if _, err := d.inspectCommit(ctx, commit); err != nil {
-		return err
+	if branch == "" {
+		return nil, fmt.Errorf("a branch must be given to revert commit %s on", commit.FullID())
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
-		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
+	commitInfo, err := d.inspectCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Finished == nil {
+		return nil, fmt.Errorf("cannot revert open commit %s", commit.FullID())
+	}
+	head := &pfs.Commit{Repo: commit.Repo, ID: branch}
+	headInfo, err := d.inspectCommit(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	if headInfo.Finished == nil {
+		return nil, fmt.Errorf("cannot revert commit %s on branch %s as its head is open", commit.FullID(), branch)
+	}
+
+	commitTree, err := d.getTreeForCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	parentTree, err := d.getTreeForCommit(ctx, commitInfo.ParentCommit)
+	if err != nil {
+		return nil, err
+	}
+	headTree, err := d.getTreeForCommit(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the files that 'commit' added (or modified), and their previous
+	// versions
+	added := make(map[string]*hashtree.NodeProto)
+	removed := make(map[string]*hashtree.NodeProto)
+	if err := commitTree.Diff(parentTree, "", "", -1, func(path string, node *hashtree.NodeProto, new bool) error {
+		if node.DirNode != nil {
+			return nil
+		}
+		if new {
+			added[path] = node
+		} else {
+			removed[path] = node
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	tree := headTree.Open()
+	for path, node := range added {
+		headNode, err := headTree.Get(path)
+		if err != nil {
+			if hashtree.Code(err) == hashtree.PathNotFound {
+				return nil, fmt.Errorf("cannot revert commit %s as %s has been deleted since", commit.FullID(), path)
+			}
+			return nil, err
+		}
+		if !bytes.Equal(headNode.Hash, node.Hash) {
+			return nil, fmt.Errorf("cannot revert commit %s as %s has been modified since", commit.FullID(), path)
+		}
+		if err := tree.DeleteFile(path); err != nil {
+			return nil, err
+		}
+	}
+	for path, node := range removed {
+		if _, ok := added[path]; !ok {
+			if _, err := headTree.Get(path); err == nil {
+				return nil, fmt.Errorf("cannot revert commit %s as %s has been re-created since", commit.FullID(), path)
+			}
+		}
+		if err := putFileNode(tree, path, node); err != nil {
+			return nil, err
+		}
+	}
+	finishedTree, err := tree.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	// The new commit is only made if the branch's head is still the commit
+	// that its tree was computed from. This also keeps the commit's final
+	// tree the same in every attempt of the STM, so it's only written once.
+	revert := &pfs.Commit{
+		Repo: commit.Repo,
+		ID:   uuid.NewWithoutDashes(),
+	}
+	if err := d.newSTMWithTrees(ctx, func(stm col.STM, trees *treeWriter) error {
+		if err := d.checkBranchHead(stm, &pfs.Branch{Repo: commit.Repo, Name: branch}, headInfo.Commit); err != nil {
+			return err
+		}
+		parent := &pfs.Commit{Repo: commit.Repo, ID: headInfo.Commit.ID}
+		return d.makeCommitInSTM(ctx, stm, trees, revert, parent, branch, nil, finishedTree, fmt.Sprintf("Revert commit %s", commit.ID))
+	}); err != nil {
+		return nil, err
+	}
+	return revert, nil
+}
+
+// checkBranchHead returns an error if the head of 'branch' has moved on from
+// 'head', which a new commit on the branch was computed from. Making the
+// commit anyway would drop the commits made on the branch in the meantime.
+func (d *driver) checkBranchHead(stm col.STM, branch *pfs.Branch, head *pfs.Commit) error {
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches(branch.Repo.Name).ReadWrite(stm).Get(branch.Name, branchInfo); err != nil {
+		return err
+	}
+	if branchInfo.Head == nil || branchInfo.Head.ID != head.ID {
+		return fmt.Errorf("branch %s has moved on from commit %s; try again", branch.Name, head.ID)
+	}
+	return nil
+}
+
+// mergeBranch does a three-way merge of 'fromBranch' into 'toBranch', using
+// the closest common ancestor of their heads as the merge base. If the same
+// path was changed differently on both branches, no commit is made and the
+// conflicting paths are returned instead.
+func (d *driver) mergeBranch(ctx context.Context, repo *pfs.Repo, fromBranch string, toBranch string, description string) (*pfs.MergeBranchResponse, error) {
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_WRITER); err != nil {
+		return nil, err
+	}
+	fromInfo, err := d.inspectCommit(ctx, &pfs.Commit{Repo: repo, ID: fromBranch})
+	if err != nil {
+		return nil, err
+	}
+	toInfo, err := d.inspectCommit(ctx, &pfs.Commit{Repo: repo, ID: toBranch})
+	if err != nil {
+		return nil, err
+	}
+	if fromInfo.Finished == nil || toInfo.Finished == nil {
+		return nil, fmt.Errorf("cannot merge %s into %s while either of their heads is open", fromBranch, toBranch)
+	}
+
+	base, err := d.mergeBase(ctx, fromInfo.Commit, toInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if base != nil && base.ID == fromInfo.Commit.ID {
+		// fromBranch has already been merged, there's nothing to do
+		return &pfs.MergeBranchResponse{Commit: toInfo.Commit}, nil
+	}
+	if base != nil && base.ID == toInfo.Commit.ID {
+		// toBranch can be fast-forwarded to the head of fromBranch, as long as
+		// nothing has been committed to it since it was read
+		if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+			if err := d.checkBranchHead(stm, &pfs.Branch{Repo: repo, Name: toBranch}, toInfo.Commit); err != nil {
+				return err
+			}
+			return d.setBranchInSTM(stm, fromInfo.Commit, toBranch)
+		}); err != nil {
+			return nil, err
+		}
+		return &pfs.MergeBranchResponse{Commit: fromInfo.Commit}, nil
+	}
+
+	baseTree, err := d.getTreeForCommit(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	fromTree, err := d.getTreeForCommit(ctx, fromInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	toTree, err := d.getTreeForCommit(ctx, toInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	fromChanges, err := changedFiles(fromTree, baseTree)
+	if err != nil {
+		return nil, err
+	}
+	toChanges, err := changedFiles(toTree, baseTree)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the conflicts, and apply the changes on fromBranch that aren't on
+	// toBranch yet. A change that can't be applied (e.g. a file put under a
+	// path that's a file on toBranch) is a conflict too.
+	var conflicts []*pfs.MergeConflict
+	tree := toTree.Open()
+	var paths []string
+	for path := range fromChanges {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fromNode := fromChanges[path]
+		if toNode, ok := toChanges[path]; ok {
+			switch {
+			case fromNode == nil && toNode == nil:
+				// deleted on both branches
+			case fromNode != nil && toNode != nil && bytes.Equal(fromNode.Hash, toNode.Hash):
+				// changed in the same way on both branches
+			case fromNode == nil:
+				conflicts = append(conflicts, &pfs.MergeConflict{Path: path, Reason: fmt.Sprintf("deleted on %s and modified on %s", fromBranch, toBranch)})
+			case toNode == nil:
+				conflicts = append(conflicts, &pfs.MergeConflict{Path: path, Reason: fmt.Sprintf("modified on %s and deleted on %s", fromBranch, toBranch)})
+			default:
+				conflicts = append(conflicts, &pfs.MergeConflict{Path: path, Reason: "modified on both branches"})
+			}
+			continue
+		}
+		if err := tree.DeleteFile(path); err != nil && hashtree.Code(err) != hashtree.PathNotFound {
+			return nil, err
+		}
+		if fromNode != nil {
+			if err := putFileNode(tree, path, fromNode); err != nil {
+				if hashtree.Code(err) == hashtree.PathConflict {
+					conflicts = append(conflicts, &pfs.MergeConflict{Path: path, Reason: err.Error()})
+					continue
+				}
+				return nil, err
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return &pfs.MergeBranchResponse{Conflicts: conflicts}, nil
+	}
+	finishedTree, err := tree.Finish()
+	if err != nil {
+		return nil, err
+	}
+	if description == "" {
+		description = fmt.Sprintf("Merge branch %s into %s", fromBranch, toBranch)
+	}
+
+	// The merge commit is only made if toBranch's head is still the commit
+	// that the merge was computed from, so that commits made on toBranch in
+	// the meantime aren't lost. This also keeps the commit's final tree the
+	// same in every attempt of the STM, so it's only written once.
+	commit := &pfs.Commit{
+		Repo: repo,
+		ID:   uuid.NewWithoutDashes(),
+	}
+	provenance := addCommits(toInfo.Provenance, fromInfo.Provenance)
+	if err := d.newSTMWithTrees(ctx, func(stm col.STM, trees *treeWriter) error {
+		if err := d.checkBranchHead(stm, &pfs.Branch{Repo: repo, Name: toBranch}, toInfo.Commit); err != nil {
+			return err
+		}
+		parent := &pfs.Commit{Repo: repo, ID: toInfo.Commit.ID}
+		if err := d.makeCommitInSTM(ctx, stm, trees, commit, parent, toBranch, provenance, finishedTree, description); err != nil {
+			return err
+		}
+		commits := d.commits(repo.Name).ReadWrite(stm)
+		commitInfo := new(pfs.CommitInfo)
+		if err := commits.Get(commit.ID, commitInfo); err != nil {
+			return err
+		}
+		commitInfo.MergeParent = fromInfo.Commit
+		return commits.Put(commit.ID, commitInfo)
+	}); err != nil {
+		return nil, err
+	}
+	return &pfs.MergeBranchResponse{Commit: commit}, nil
+}
+
+// mergeBase returns the closest common ancestor of 'from' and 'to' (following
+// both the parents and the merge parents of commits), or nil if they have no
+// common ancestor.
+func (d *driver) mergeBase(ctx context.Context, from *pfs.Commit, to *pfs.Commit) (*pfs.Commit, error) {
+	// Read the repo's commits in one pass, rather than inspecting each
+	// ancestor in turn
+	iter, err := d.commits(from.Repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	commitInfoList, err := readCommitInfos(iter)
+	if err != nil {
+		return nil, err
+	}
+	commitInfos := make(map[string]*pfs.CommitInfo)
+	for _, commitInfo := range commitInfoList {
+		commitInfos[commitInfo.Commit.ID] = commitInfo
+	}
+
+	ancestors := make(map[string]bool)
+	if err := walkAncestors(commitInfos, to, func(commit *pfs.Commit) bool {
+		ancestors[commit.ID] = true
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	var base *pfs.Commit
+	if err := walkAncestors(commitInfos, from, func(commit *pfs.Commit) bool {
+		if ancestors[commit.ID] {
+			base = commit
+			return false
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return base, nil
+}
+
+// walkAncestors calls 'f' on 'commit' and its ancestors, closest first,
+// until 'f' returns false. 'commitInfos' holds the commits of the repo,
+// keyed by ID.
+func walkAncestors(commitInfos map[string]*pfs.CommitInfo, commit *pfs.Commit, f func(*pfs.Commit) bool) error {
+	visited := make(map[string]bool)
+	queue := []*pfs.Commit{commit}
+	for len(queue) > 0 {
+		commit := queue[0]
+		queue = queue[1:]
+		if visited[commit.ID] {
+			continue
+		}
+		visited[commit.ID] = true
+		if !f(commit) {
+			return nil
+		}
+		commitInfo, ok := commitInfos[commit.ID]
+		if !ok {
+			return fmt.Errorf("commit %s not found", commit.FullID())
+		}
+		if commitInfo.ParentCommit != nil {
+			queue = append(queue, commitInfo.ParentCommit)
+		}
+		if commitInfo.MergeParent != nil {
+			queue = append(queue, commitInfo.MergeParent)
+		}
+	}
+	return nil
+}
+
+// changedFiles returns the files that differ between 'tree' and 'baseTree',
+// mapped to their node in 'tree' (or nil if they were deleted).
+func changedFiles(tree hashtree.HashTree, baseTree hashtree.HashTree) (map[string]*hashtree.NodeProto, error) {
+	result := make(map[string]*hashtree.NodeProto)
+	if err := tree.Diff(baseTree, "", "", -1, func(filePath string, node *hashtree.NodeProto, new bool) error {
+		if node.DirNode != nil {
+			return nil
+		}
+		path := path.Join("/", filePath)
+		if new {
+			result[path] = node
+		} else if _, ok := result[path]; !ok {
+			result[path] = nil
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// putFileNode writes the file 'node' (its content and its metadata, or its
+// target if it's a symlink) to 'tree' at 'path'.
+func putFileNode(tree hashtree.OpenHashTree, path string, node *hashtree.NodeProto) error {
+	if node.SymlinkNode != nil {
+		return tree.PutSymlink(path, node.SymlinkNode.Target)
+	}
+	if err := tree.PutFile(path, node.FileNode.Objects, node.SubtreeSize); err != nil {
+		return err
+	}
+	if len(node.FileNode.Metadata) > 0 {
+		return tree.PutFileMetadata(path, node.FileNode.Metadata)
+	}
+	return nil
+}
+
+// downstreamCommits returns the commits that have 'commit' in their
+// provenance.
+func (d *driver) downstreamCommits(ctx context.Context, commit *pfs.Commit) ([]*pfs.Commit, error) {
+	repoInfos, err := d.flushRepo(ctx, commit.Repo)
+	if err != nil {
+		return nil, err
+	}
+	var result []*pfs.Commit
+	for _, repoInfo := range repoInfos {
+		iter, err := d.commits(repoInfo.Repo.Name).ReadOnly(ctx).GetByIndex(pfsdb.ProvenanceIndex, commit)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var commitID string
+			commitInfo := new(pfs.CommitInfo)
+			ok, err := iter.Next(&commitID, commitInfo)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			result = append(result, commitInfo.Commit)
+		}
+	}
+	return result, nil
+}
+
+// addCommits returns the union of 'commits' and 'newCommits'.
+func addCommits(commits []*pfs.Commit, newCommits []*pfs.Commit) []*pfs.Commit {
+	result := commits
+	for _, newCommit := range newCommits {
+		found := false
+		for _, c := range commits {
+			if c.ID == newCommit.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, newCommit)
+		}
+	}
+	return result
+}
+
+// removeCommit returns 'commits' without 'commit'.
+func removeCommit(commits []*pfs.Commit, commit *pfs.Commit) []*pfs.Commit {
+	var result []*pfs.Commit
+	for _, c := range commits {
+		if c.ID != commit.ID {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func (d *driver) listBranch(ctx context.Context, repo *pfs.Repo) ([]*pfs.BranchInfo, error) {
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	branches := d.branches(repo.Name).ReadOnly(ctx)
+	iterator, err := branches.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*pfs.BranchInfo
+	for {
+		var branchName string
+		branchInfo := new(pfs.BranchInfo)
+		ok, err := iterator.Next(&branchName, branchInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		branchInfo.Name = path.Base(branchName)
+		res = append(res, branchInfo)
+	}
+	return res, nil
+}
+
+func (d *driver) inspectBranch(ctx context.Context, branch *pfs.Branch) (*pfs.BranchInfo, error) {
+	if err := d.checkIsAuthorized(ctx, branch.Repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	branchInfo := new(pfs.BranchInfo)
+	if err := d.branches(branch.Repo.Name).ReadOnly(ctx).Get(branch.Name, branchInfo); err != nil {
+		return nil, err
+	}
+	branchInfo.Name = branch.Name
+	return branchInfo, nil
+}
+
+// listBranchInSTM is like listBranch, but reads the branches through 'stm',
+// so that 'stm' conflicts with any concurrent change to them. Callers that
+// depend on the set of branches being complete must also read the RepoInfo of
+// 'repo' in 'stm' (see touchRepo).
+func (d *driver) listBranchInSTM(stm col.STM, repo *pfs.Repo) ([]*pfs.BranchInfo, error) {
+	iterator, err := d.branches(repo.Name).ReadWrite(stm).ListPrefix("")
+	if err != nil {
+		return nil, err
+	}
+	var res []*pfs.BranchInfo
+	for {
+		var branchName string
+		branchInfo := new(pfs.BranchInfo)
+		ok, err := iterator.Next(&branchName, branchInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		branchInfo.Name = path.Base(branchName)
+		res = append(res, branchInfo)
+	}
+	return res, nil
+}
+
+// touchRepo rewrites the RepoInfo of 'repo' unchanged. It's called by every
+// STM that creates a branch: etcd can't detect that a key was added to a range
+// that another STM has read, so STMs that list a repo's branches read its
+// RepoInfo as well and conflict with the creation that way.
+func (d *driver) touchRepo(stm col.STM, repo *pfs.Repo) error {
+	repos := d.repos.ReadWrite(stm)
+	repoInfo := new(pfs.RepoInfo)
+	if err := repos.Get(repo.Name, repoInfo); err != nil {
+		return err
+	}
+	return repos.Put(repo.Name, repoInfo)
+}
+
+// branchesWithHead returns the branches in commit's repo whose head is commit.
+func (d *driver) branchesWithHead(stm col.STM, commit *pfs.Commit) ([]*pfs.Branch, error) {
+	branchInfos, err := d.listBranchInSTM(stm, commit.Repo)
+	if err != nil {
+		return nil, err
+	}
+	var result []*pfs.Branch
+	for _, branchInfo := range branchInfos {
+		if branchInfo.Head != nil && branchInfo.Head.ID == commit.ID {
+			result = append(result, &pfs.Branch{Repo: commit.Repo, Name: branchInfo.Name})
+		}
+	}
+	return result, nil
+}
+
+func (d *driver) setBranch(ctx context.Context, commit *pfs.Commit, name string) error {
+	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	if txnID := transactionID(ctx); txnID != "" {
+		return d.addToTransaction(ctx, txnID, &pfs.TransactionRequest{
+			SetBranch: &pfs.SetBranchRequest{
+				Commit: commit,
+				Branch: name,
+			},
+		})
+	}
+	if _, err := d.inspectCommit(ctx, commit); err != nil {
+		return err
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		return d.setBranchInSTM(stm, commit, name)
+	})
+	return err
+}
+
+// setBranchInSTM points the branch 'name' at 'commit' as part of 'stm', which
+// may also contain the other requests of a transaction. 'commit' must have
+// been resolved to a commit ID (rather than a branch name).
+func (d *driver) setBranchInSTM(stm col.STM, commit *pfs.Commit, name string) error {
+	commits := d.commits(commit.Repo.Name).ReadWrite(stm)
+	branches := d.branches(commit.Repo.Name).ReadWrite(stm)
+
+	// Make sure that the commit exists
+	var commitInfo pfs.CommitInfo
+	if err := commits.Get(commit.ID, &commitInfo); err != nil {
+		return err
+	}
+
+	branchInfo := &pfs.BranchInfo{}
+	if err := branches.Get(name, branchInfo); err != nil {
+		if _, ok := err.(col.ErrNotFound); !ok {
+			return err
+		}
+		branchInfo.Name = name
+		if err := d.touchRepo(stm, commit.Repo); err != nil {
+			return err
+		}
+	}
+	branchInfo.Head = commit
+	if err := branches.Put(name, branchInfo); err != nil {
+		return err
+	}
+	if commitInfo.Finished != nil {
+		return d.propagateCommit(stm, &pfs.Branch{Repo: commit.Repo, Name: name})
+	}
+	return nil
+}
+
+func (d *driver) deleteBranch(ctx context.Context, repo *pfs.Repo, name string) error {
+	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		branches := d.branches(repo.Name).ReadWrite(stm)
+		branch := &pfs.Branch{Repo: repo, Name: name}
+		branchInfo := new(pfs.BranchInfo)
+		if err := branches.Get(name, branchInfo); err != nil {
+			return err
+		}
+		if len(branchInfo.Subvenance) > 0 {
+			return fmt.Errorf("cannot delete branch %s as it's in the provenance of %s",
+				branchKey(branch), branchKey(branchInfo.Subvenance[0]))
+		}
+		for _, provBranch := range branchInfo.Provenance {
+			if err := d.updateBranchInfo(stm, provBranch, func(provBranchInfo *pfs.BranchInfo) {
+				provBranchInfo.Subvenance = removeBranch(provBranchInfo.Subvenance, branch)
+			}); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		return branches.Delete(name)
+	})
+	return err
+}
+
+// createBranch creates 'branch', or updates it if it exists. If 'provenance'
+// is empty, an existing branch keeps its provenance, unless 'clearProvenance'
+// is set.
+func (d *driver) createBranch(ctx context.Context, branch *pfs.Branch, head *pfs.Commit, provenance []*pfs.Branch, clearProvenance bool) error {
+	if branch == nil || branch.Repo == nil {
+		return fmt.Errorf("branch and its repo must be set")
+	}
+	if clearProvenance && len(provenance) > 0 {
+		return fmt.Errorf("a branch's provenance can't be both set and cleared")
+	}
+	if err := d.checkIsAuthorized(ctx, branch.Repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	if head != nil {
+		// The head commit may leave its repo unset, in which case it's taken
+		// to be in the branch's repo
+		if head.Repo == nil {
+			head.Repo = branch.Repo
+		} else if head.Repo.Name != branch.Repo.Name {
+			return fmt.Errorf("head commit %s/%s is not in the branch's repo %s", head.Repo.Name, head.ID, branch.Repo.Name)
+		}
+	}
+	for _, provBranch := range provenance {
+		if provBranch == nil || provBranch.Repo == nil {
+			return fmt.Errorf("provenance branches and their repos must be set")
+		}
+		if err := d.checkIsAuthorized(ctx, provBranch.Repo, auth.Scope_READER); err != nil {
+			return err
+		}
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		// Make sure that the repo exists
+		if err := d.repos.ReadWrite(stm).Get(branch.Repo.Name, &pfs.RepoInfo{}); err != nil {
+			return err
+		}
+		branches := d.branches(branch.Repo.Name).ReadWrite(stm)
+		branchInfo := &pfs.BranchInfo{}
+		if err := branches.Get(branch.Name, branchInfo); err != nil {
+			if _, ok := err.(col.ErrNotFound); !ok {
+				return err
+			}
+			if err := d.touchRepo(stm, branch.Repo); err != nil {
+				return err
+			}
+		}
+		branchInfo.Name = branch.Name
+		provenance := provenance
+		if len(provenance) == 0 && !clearProvenance {
+			provenance = branchInfo.Provenance
+		}
+
+		// Make sure that the provenance exists and doesn't contain a cycle
+		for _, provBranch := range provenance {
+			if branchKey(provBranch) == branchKey(branch) {
+				return fmt.Errorf("branch %s cannot be in its own provenance", branchKey(branch))
+			}
+			provBranchInfo := &pfs.BranchInfo{}
+			if err := d.branches(provBranch.Repo.Name).ReadWrite(stm).Get(provBranch.Name, provBranchInfo); err != nil {
+				return fmt.Errorf("could not read provenance branch %s: %v", branchKey(provBranch), err)
+			}
+			if err := d.checkNotInProvenance(stm, branch, provBranchInfo.Provenance); err != nil {
+				return err
+			}
+		}
+
+		// Update the subvenance of the branches that were added to (or removed
+		// from) this branch's provenance
+		for _, oldProvBranch := range branchInfo.Provenance {
+			if !hasBranch(provenance, oldProvBranch) {
+				if err := d.updateBranchInfo(stm, oldProvBranch, func(provBranchInfo *pfs.BranchInfo) {
+					provBranchInfo.Subvenance = removeBranch(provBranchInfo.Subvenance, branch)
+				}); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+			}
+		}
+		branchInfo.Provenance = nil
+		for _, provBranch := range provenance {
+			branchInfo.Provenance = addBranch(branchInfo.Provenance, provBranch)
+			if err := d.updateBranchInfo(stm, provBranch, func(provBranchInfo *pfs.BranchInfo) {
+				provBranchInfo.Subvenance = addBranch(provBranchInfo.Subvenance, branch)
+			}); err != nil {
+				return err
+			}
+		}
+
+		headFinished := false
+		if head != nil {
+			// Make sure that the commit exists
+			commitInfo := &pfs.CommitInfo{}
+			if err := d.commits(head.Repo.Name).ReadWrite(stm).Get(head.ID, commitInfo); err != nil {
+				return err
+			}
+			branchInfo.Head = head
+			headFinished = commitInfo.Finished != nil
+		}
+		if err := branches.Put(branch.Name, branchInfo); err != nil {
+			return err
+		}
+
+		// The branch may need a new commit if its provenance has moved on
+		if err := d.propagateBranch(stm, branch); err != nil {
+			return err
+		}
+		if headFinished {
+			return d.propagateCommit(stm, branch)
+		}
+		return nil
+	})
+	return err
+}
+
+// checkNotInProvenance returns an error if branch is reachable from
+// provenance, as making those branches the provenance of branch would create
+// a cycle.
+func (d *driver) checkNotInProvenance(stm col.STM, branch *pfs.Branch, provenance []*pfs.Branch) error {
+	for _, provBranch := range provenance {
+		if branchKey(provBranch) == branchKey(branch) {
+			return fmt.Errorf("branch %s cannot be in its own provenance", branchKey(branch))
+		}
+		provBranchInfo := &pfs.BranchInfo{}
+		if err := d.branches(provBranch.Repo.Name).ReadWrite(stm).Get(provBranch.Name, provBranchInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err := d.checkNotInProvenance(stm, branch, provBranchInfo.Provenance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateBranchInfo reads the BranchInfo of branch, applies f to it and writes
+// it back.
+func (d *driver) updateBranchInfo(stm col.STM, branch *pfs.Branch, f func(*pfs.BranchInfo)) error {
+	branches := d.branches(branch.Repo.Name).ReadWrite(stm)
+	branchInfo := &pfs.BranchInfo{}
+	if err := branches.Get(branch.Name, branchInfo); err != nil {
+		return err
+	}
+	f(branchInfo)
+	return branches.Put(branch.Name, branchInfo)
+}
+
+// propagateCommit is called when the head of branch moves to a finished
+// commit. It opens a new commit on every branch that has branch in its
+// provenance (if that branch is ready for one).
+func (d *driver) propagateCommit(stm col.STM, branch *pfs.Branch) error {
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches(branch.Repo.Name).ReadWrite(stm).Get(branch.Name, branchInfo); err != nil {
+		return err
+	}
+	for _, subvBranch := range branchInfo.Subvenance {
+		if err := d.propagateBranch(stm, subvBranch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// propagateBranch opens a new commit on branch if the heads of its provenance
+// have moved past the provenance of its current head. No commit is opened if
+// some of the provenance has no finished head yet, or if the branch's head is
+// still open (the new commit is opened once the head is finished).
+func (d *driver) propagateBranch(stm col.STM, branch *pfs.Branch) error {
+	branches := d.branches(branch.Repo.Name).ReadWrite(stm)
+	branchInfo := &pfs.BranchInfo{}
+	if err := branches.Get(branch.Name, branchInfo); err != nil {
+		return err
+	}
+	if len(branchInfo.Provenance) == 0 {
+		return nil
+	}
+
+	// Collect the heads of the provenance, along with their own provenance
+	provenanceMap := make(map[string]*pfs.Commit)
+	for _, provBranch := range branchInfo.Provenance {
+		provBranchInfo := &pfs.BranchInfo{}
+		if err := d.branches(provBranch.Repo.Name).ReadWrite(stm).Get(provBranch.Name, provBranchInfo); err != nil {
+			return err
+		}
+		if provBranchInfo.Head == nil {
+			return nil
+		}
+		provCommitInfo := &pfs.CommitInfo{}
+		if err := d.commits(provBranch.Repo.Name).ReadWrite(stm).Get(provBranchInfo.Head.ID, provCommitInfo); err != nil {
+			return err
+		}
+		if provCommitInfo.Finished == nil {
+			return nil
+		}
+		provenanceMap[provCommitInfo.Commit.ID] = provCommitInfo.Commit
+		for _, c := range provCommitInfo.Provenance {
+			provenanceMap[c.ID] = c
+		}
+	}
+
+	commits := d.commits(branch.Repo.Name).ReadWrite(stm)
+	if branchInfo.Head != nil {
+		headInfo := &pfs.CommitInfo{}
+		if err := commits.Get(branchInfo.Head.ID, headInfo); err != nil {
+			return err
+		}
+		if headInfo.Finished == nil {
+			return nil
+		}
+		// If the head already has this provenance, there's nothing to do
+		if len(headInfo.Provenance) == len(provenanceMap) {
+			upToDate := true
+			for _, c := range headInfo.Provenance {
+				if _, ok := provenanceMap[c.ID]; !ok {
+					upToDate = false
+					break
+				}
+			}
+			if upToDate {
+				return nil
+			}
+		}
+	}
+
+	commit := &pfs.Commit{
+		Repo: branch.Repo,
+		ID:   uuid.NewWithoutDashes(),
+	}
+	commitInfo := &pfs.CommitInfo{
+		Commit:       commit,
+		Started:      now(),
+		ParentCommit: branchInfo.Head,
+	}
+	for _, c := range provenanceMap {
+		commitInfo.Provenance = append(commitInfo.Provenance, c)
+	}
+	if err := commits.Create(commit.ID, commitInfo); err != nil {
+		return err
+	}
+	d.openCommits.ReadWrite(stm).Put(commit.ID, commit)
+	branchInfo.Head = commit
+	return branches.Put(branch.Name, branchInfo)
+}
 
-		// Make sure that the commit exists
-		var commitInfo pfs.CommitInfo
-		if err := commits.Get(commit.ID, &commitInfo); err != nil {
-			return err
+// branchKey returns a human-readable name for branch, of the form repo/branch.
+func branchKey(branch *pfs.Branch) string {
+	return path.Join(branch.Repo.Name, branch.Name)
+}
+
+func hasBranch(branches []*pfs.Branch, branch *pfs.Branch) bool {
+	for _, b := range branches {
+		if branchKey(b) == branchKey(branch) {
+			return true
 		}
+	}
+	return false
+}
 
-		return branches.Put(name, commit)
-	})
-	return err
+func addBranch(branches []*pfs.Branch, branch *pfs.Branch) []*pfs.Branch {
+	if hasBranch(branches, branch) {
+		return branches
+	}
+	return append(branches, branch)
 }
 
-func (d *driver) deleteBranch(ctx context.Context, repo *pfs.Repo, name string) error {
-	if err := d.checkIsAuthorized(ctx, repo, auth.Scope_WRITER); err != nil {
-		return err
+func removeBranch(branches []*pfs.Branch, branch *pfs.Branch) []*pfs.Branch {
+	var result []*pfs.Branch
+	for _, b := range branches {
+		if branchKey(b) != branchKey(branch) {
+			result = append(result, b)
+		}
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		branches := d.branches(repo.Name).ReadWrite(stm)
-		return branches.Delete(name)
-	})
-	return err
+	return result
 }
 
 func (d *driver) scratchPrefix() string {
@@ -1412,7 +2982,16 @@ func validatePath(path string) error {
 }
 
 func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Delimiter,
-	targetFileDatums int64, targetFileBytes int64, overwriteIndex *pfs.OverwriteIndex, reader io.Reader) error {
+	targetFileDatums int64, targetFileBytes int64, overwriteIndex *pfs.OverwriteIndex,
+	metadata map[string]string, reader io.Reader) (retErr error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "pfs.driver.putFile",
+		"repo", file.Commit.Repo.Name, "commit", file.Commit.ID, "path", file.Path)
+	defer func() {
+		tracing.SetError(span, retErr)
+		span.Finish()
+	}()
+	// pachClient propagates the trace in ctx to the object API
+	pachClient := d.pachClient.WithCtx(ctx)
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
@@ -1421,7 +3000,9 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 	// and is open.
 	// Since we use UUIDv4 for commit IDs, the 13th character would be 4 if
 	// this is a commit ID.
-	if len(file.Commit.ID) != uuid.UUIDWithoutDashesLength || file.Commit.ID[12] != '4' {
+	// In a transaction, the commit is resolved when the transaction is
+	// finished, as the branch may be moved by an earlier request.
+	if transactionID(ctx) == "" && (len(file.Commit.ID) != uuid.UUIDWithoutDashesLength || file.Commit.ID[12] != '4') {
 		commitInfo, err := d.inspectCommit(ctx, file.Commit)
 		if err != nil {
 			return err
@@ -1435,13 +3016,17 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 		}
 	}
 
-	records := &pfs.PutFileRecords{}
+	records := &pfs.PutFileRecords{Metadata: metadata}
 	if err := validatePath(file.Path); err != nil {
 		return err
 	}
+	reader, err := d.putFileQuotaReader(ctx, file.Commit.Repo, reader)
+	if err != nil {
+		return err
+	}
 
 	if delimiter == pfs.Delimiter_NONE {
-		objects, size, err := d.pachClient.PutObjectSplit(reader)
+		objects, size, err := pachClient.PutObjectSplit(reader)
 		if err != nil {
 			return err
 		}
@@ -1512,7 +3097,7 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 			_buffer := buffer
 			index := filesPut
 			eg.Go(func() error {
-				object, size, err := d.pachClient.PutObject(_buffer)
+				object, size, err := pachClient.PutObject(_buffer)
 				if err != nil {
 					return err
 				}
@@ -1579,7 +3164,7 @@ func (d *driver) copyFile(ctx context.Context, src *pfs.File, dst *pfs.File, ove
 	}
 	var eg errgroup.Group
 	if err := srcTree.Walk(src.Path, func(walkPath string, node *hashtree.NodeProto) error {
-		if node.FileNode == nil {
+		if node.FileNode == nil && node.SymlinkNode == nil {
 			return nil
 		}
 		eg.Go(func() error {
@@ -1588,22 +3173,8 @@ func (d *driver) copyFile(ctx context.Context, src *pfs.File, dst *pfs.File, ove
 				// This shouldn't be possible
 				return fmt.Errorf("error from filepath.Rel: %+v (this is likely a bug)", err)
 			}
-			records := &pfs.PutFileRecords{}
 			file := client.NewFile(dst.Commit.Repo.Name, dst.Commit.ID, path.Clean(path.Join(dst.Path, relPath)))
-			if err != nil {
-				return err
-			}
-			for i, object := range node.FileNode.Objects {
-				var size int64
-				if i == 0 {
-					size = node.SubtreeSize
-				}
-				records.Records = append(records.Records, &pfs.PutFileRecord{
-					SizeBytes:  size,
-					ObjectHash: object.Hash,
-				})
-			}
-			return d.upsertPutFileRecords(ctx, file, records)
+			return d.upsertPutFileRecords(ctx, file, fileNodeRecords(node))
 		})
 		return nil
 	}); err != nil {
@@ -1612,6 +3183,125 @@ func (d *driver) copyFile(ctx context.Context, src *pfs.File, dst *pfs.File, ove
 	return eg.Wait()
 }
 
+// fileNodeRecords returns the PutFile records that recreate the file (or
+// symlink) 'node', referencing the same objects.
+func fileNodeRecords(node *hashtree.NodeProto) *pfs.PutFileRecords {
+	if node.SymlinkNode != nil {
+		return &pfs.PutFileRecords{SymlinkTarget: node.SymlinkNode.Target}
+	}
+	records := &pfs.PutFileRecords{Metadata: node.FileNode.Metadata}
+	for i, object := range node.FileNode.Objects {
+		var size int64
+		if i == 0 {
+			size = node.SubtreeSize
+		}
+		records.Records = append(records.Records, &pfs.PutFileRecord{
+			SizeBytes:  size,
+			ObjectHash: object.Hash,
+		})
+	}
+	return records
+}
+
+// moveFile moves the file or directory at 'src' to 'dst', which must be in
+// the same open commit. The moved files keep referencing the same objects, so
+// no data is copied.
+func (d *driver) moveFile(ctx context.Context, src *pfs.File, dst *pfs.File) error {
+	if err := d.checkIsAuthorized(ctx, src.Commit.Repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	if err := validatePath(dst.Path); err != nil {
+		return err
+	}
+	srcInfo, err := d.inspectCommit(ctx, src.Commit)
+	if err != nil {
+		return err
+	}
+	dstInfo, err := d.inspectCommit(ctx, dst.Commit)
+	if err != nil {
+		return err
+	}
+	if srcInfo.Commit.Repo.Name != dstInfo.Commit.Repo.Name || srcInfo.Commit.ID != dstInfo.Commit.ID {
+		return fmt.Errorf("cannot move files between commits, use copy-file instead")
+	}
+	if srcInfo.Finished != nil {
+		return pfsserver.ErrCommitFinished{srcInfo.Commit}
+	}
+	src = &pfs.File{Commit: srcInfo.Commit, Path: path.Join("/", src.Path)}
+	dst = &pfs.File{Commit: srcInfo.Commit, Path: path.Join("/", dst.Path)}
+	if src.Path == "/" || dst.Path == "/" {
+		return fmt.Errorf("cannot move the root directory")
+	}
+	if src.Path == dst.Path || strings.HasPrefix(dst.Path, src.Path+"/") || strings.HasPrefix(src.Path, dst.Path+"/") {
+		return fmt.Errorf("cannot move %s to %s, as one contains the other", src.Path, dst.Path)
+	}
+
+	// Read 'src' and 'dst' as they are in the open commit
+	parentTree, err := d.getTreeForCommit(ctx, srcInfo.ParentCommit)
+	if err != nil {
+		return err
+	}
+	srcTree, err := d.openTreeForFile(ctx, src, parentTree)
+	if err != nil {
+		return err
+	}
+	if _, err := srcTree.GetOpen(src.Path); err != nil {
+		return pfsserver.ErrFileNotFound{src}
+	}
+	dstTree, err := d.openTreeForFile(ctx, dst, parentTree)
+	if err != nil {
+		return err
+	}
+	if _, err := dstTree.GetOpen(dst.Path); err == nil {
+		return fmt.Errorf("cannot move %s to %s, as %s already exists", src.Path, dst.Path, dst.Path)
+	}
+	finishedSrcTree, err := srcTree.Finish()
+	if err != nil {
+		return err
+	}
+
+	// Record the move as a put of each of the files under 'src' at 'dst'
+	// (referencing the same objects), followed by a delete of 'src'
+	if err := finishedSrcTree.Walk(src.Path, func(walkPath string, node *hashtree.NodeProto) error {
+		if node.FileNode == nil && node.SymlinkNode == nil {
+			return nil
+		}
+		relPath, err := filepath.Rel(src.Path, walkPath)
+		if err != nil {
+			// This shouldn't be possible
+			return fmt.Errorf("error from filepath.Rel: %+v (this is likely a bug)", err)
+		}
+		file := &pfs.File{Commit: dst.Commit, Path: path.Join(dst.Path, relPath)}
+		return d.upsertPutFileRecords(ctx, file, fileNodeRecords(node))
+	}); err != nil {
+		return err
+	}
+	return d.upsertPutFileRecords(ctx, src, &pfs.PutFileRecords{Tombstone: true})
+}
+
+// createSymlink creates a symlink at 'file' pointing to 'target', replacing
+// anything that was previously at that path.
+func (d *driver) createSymlink(ctx context.Context, file *pfs.File, target string) error {
+	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	if err := validatePath(file.Path); err != nil {
+		return err
+	}
+	if _, err := pfs.ResolveSymlink(file, target); err != nil {
+		return err
+	}
+	// See putFile for why branch names are resolved here
+	if transactionID(ctx) == "" && (len(file.Commit.ID) != uuid.UUIDWithoutDashesLength || file.Commit.ID[12] != '4') {
+		commitInfo, err := d.inspectCommit(ctx, file.Commit)
+		if err != nil {
+			return err
+		}
+		file.Commit = commitInfo.Commit
+	}
+	return d.upsertPutFileRecords(ctx, file, &pfs.PutFileRecords{SymlinkTarget: target})
+}
+
 func (d *driver) getTreeForCommit(ctx context.Context, commit *pfs.Commit) (hashtree.HashTree, error) {
 	if commit == nil || commit.ID == "" {
 		t, err := hashtree.NewHashTree().Finish()
@@ -1668,6 +3358,15 @@ func (d *driver) getTreeForCommit(ctx context.Context, commit *pfs.Commit) (hash
 	return h, nil
 }
 
+// getTreeForObject reads the hashtree stored in the object 'treeRef'.
+func (d *driver) getTreeForObject(treeRef *pfs.Object) (hashtree.HashTree, error) {
+	var buf bytes.Buffer
+	if err := d.pachClient.GetObject(treeRef.Hash, &buf); err != nil {
+		return nil, err
+	}
+	return hashtree.Deserialize(buf.Bytes())
+}
+
 // getTreeForFile is like getTreeForCommit except that it can handle open commits.
 // It takes a file instead of a commit so that it can apply the changes for
 // that path to the tree before it returns it.
@@ -1690,21 +3389,86 @@ func (d *driver) getTreeForFile(ctx context.Context, file *pfs.File) (hashtree.H
 		}
 		return tree, nil
 	}
-	prefix, err := d.scratchFilePrefix(ctx, file)
+	parentTree, err := d.getTreeForCommit(ctx, commitInfo.ParentCommit)
 	if err != nil {
 		return nil, err
 	}
-	parentTree, err := d.getTreeForCommit(ctx, commitInfo.ParentCommit)
+	tree, err := d.openTreeForFile(ctx, file, parentTree)
 	if err != nil {
 		return nil, err
 	}
-	return d.getTreeForPrefix(ctx, prefix, parentTree)
+	return tree.Finish()
 }
 
 func (d *driver) getTreeForPrefix(ctx context.Context, prefix string, parentTree hashtree.HashTree) (hashtree.HashTree, error) {
-	var finishedTree hashtree.HashTree
+	tree, err := d.openTreeForPrefix(ctx, prefix, parentTree)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Finish()
+}
+
+// openTreeForFile applies the PutFile records that affect 'file' in its
+// (open) commit to 'parentTree', and returns the resulting (open) tree. These
+// are the records under the file's path, and the records of its parent
+// directories, which may delete or replace it.
+func (d *driver) openTreeForFile(ctx context.Context, file *pfs.File, parentTree hashtree.HashTree) (hashtree.OpenHashTree, error) {
+	// The parent directories' records are applied first, closest to the root
+	// first, as a record that deletes or replaces a directory removes the
+	// records under it
+	var dirs []string
+	for dir := path.Join("/", file.Path); dir != "/"; {
+		dir = path.Dir(dir)
+		dirs = append([]string{dir}, dirs...)
+	}
+	tree := parentTree.Open()
+	recordsCol := d.putFileRecords.ReadOnly(ctx)
+	for _, dir := range dirs {
+		key, err := d.scratchFilePrefix(ctx, &pfs.File{Commit: file.Commit, Path: dir})
+		if err != nil {
+			return nil, err
+		}
+		putFileRecords := &pfs.PutFileRecords{}
+		if err := recordsCol.Get(key, putFileRecords); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if err := d.applyWrite(d.putFileRecords.Path(key), putFileRecords, tree); err != nil {
+			return nil, err
+		}
+	}
+	prefix, err := d.scratchFilePrefix(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := recordsCol.ListPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		putFileRecords := &pfs.PutFileRecords{}
+		var key string
+		ok, err := iter.Next(&key, putFileRecords)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return tree, nil
+		}
+		if err := d.applyWrite(key, putFileRecords, tree); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// openTreeForPrefix applies the PutFile records under 'prefix' to
+// 'parentTree', and returns the resulting (open) tree.
+func (d *driver) openTreeForPrefix(ctx context.Context, prefix string, parentTree hashtree.HashTree) (hashtree.OpenHashTree, error) {
+	var tree hashtree.OpenHashTree
 	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		tree := parentTree.Open()
+		tree = parentTree.Open()
 
 		recordsCol := d.putFileRecords.ReadOnly(ctx)
 		iter, err := recordsCol.ListPrefix(prefix)
@@ -1726,32 +3490,23 @@ func (d *driver) getTreeForPrefix(ctx context.Context, prefix string, parentTree
 				return err
 			}
 		}
-		finishedTree, err = tree.Finish()
-		if err != nil {
-			return err
-		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return finishedTree, nil
+	return tree, nil
 }
 
 func (d *driver) getFile(ctx context.Context, file *pfs.File, offset int64, size int64) (io.Reader, error) {
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
-	tree, err := d.getTreeForFile(ctx, file)
+	_, _, node, err := d.resolveFile(ctx, file, true)
 	if err != nil {
 		return nil, err
 	}
 
-	node, err := tree.Get(file.Path)
-	if err != nil {
-		return nil, pfsserver.ErrFileNotFound{file}
-	}
-
 	if node.FileNode == nil {
 		return nil, fmt.Errorf("%s is a directory", file.Path)
 	}
@@ -1780,9 +3535,17 @@ func nodeToFileInfo(commit *pfs.Commit, path string, node *hashtree.NodeProto, f
 		},
 		SizeBytes: uint64(node.SubtreeSize),
 		Hash:      node.Hash,
+		Modified:  node.Modified,
+	}
+	if node.ModifiedCommit != "" {
+		fileInfo.ModifiedCommit = &pfs.Commit{
+			Repo: commit.Repo,
+			ID:   node.ModifiedCommit,
+		}
 	}
 	if node.FileNode != nil {
 		fileInfo.FileType = pfs.FileType_FILE
+		fileInfo.Metadata = node.FileNode.Metadata
 		if full {
 			fileInfo.Objects = node.FileNode.Objects
 		}
@@ -1791,6 +3554,9 @@ func nodeToFileInfo(commit *pfs.Commit, path string, node *hashtree.NodeProto, f
 		if full {
 			fileInfo.Children = node.DirNode.Children
 		}
+	} else if node.SymlinkNode != nil {
+		fileInfo.FileType = pfs.FileType_SYMLINK
+		fileInfo.SymlinkTarget = node.SymlinkNode.Target
 	}
 	return fileInfo
 }
@@ -1799,16 +3565,12 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
-	tree, err := d.getTreeForFile(ctx, file)
+	// Like lstat, a symlink at 'file' itself is returned rather than followed
+	_, _, node, err := d.resolveFile(ctx, file, false)
 	if err != nil {
 		return nil, err
 	}
 
-	node, err := tree.Get(file.Path)
-	if err != nil {
-		return nil, pfsserver.ErrFileNotFound{file}
-	}
-
 	return nodeToFileInfo(file.Commit, file.Path, node, true), nil
 }
 
@@ -1816,12 +3578,12 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, full bool) ([]*pf
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
-	tree, err := d.getTreeForFile(ctx, file)
+	target, tree, _, err := d.resolveFile(ctx, file, true)
 	if err != nil {
 		return nil, err
 	}
 
-	nodes, err := tree.List(file.Path)
+	nodes, err := tree.List(target.Path)
 	if err != nil {
 		return nil, err
 	}
@@ -1833,7 +3595,79 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, full bool) ([]*pf
 	return fileInfos, nil
 }
 
-func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, pattern string) ([]*pfs.FileInfo, error) {
+// resolveFile follows the symlinks on the way to 'file' and returns the file
+// it resolves to, along with its tree and node. A symlink at 'file' itself is
+// only followed if 'followLast' is set.
+func (d *driver) resolveFile(ctx context.Context, file *pfs.File, followLast bool) (*pfs.File, hashtree.HashTree, *hashtree.NodeProto, error) {
+	original := file
+	for depth := 0; ; depth++ {
+		if depth > pfs.MaxSymlinkDepth {
+			return nil, nil, nil, fmt.Errorf("too many levels of symbolic links resolving %s", original.Path)
+		}
+		if depth > 0 {
+			if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		tree, err := d.getTreeForFile(ctx, file)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		node, err := tree.Get(file.Path)
+		if err == nil {
+			if node.SymlinkNode == nil || !followLast {
+				return file, tree, node, nil
+			}
+			file, err = pfs.ResolveSymlink(file, node.SymlinkNode.Target)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			continue
+		}
+		// The file doesn't exist, but one of its ancestors may be a symlink
+		link, target, err := d.symlinkAncestor(ctx, file)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if link == "" {
+			return nil, nil, nil, pfsserver.ErrFileNotFound{original}
+		}
+		relPath := strings.TrimPrefix(file.Path, link)
+		file, err = pfs.ResolveSymlink(&pfs.File{Commit: file.Commit, Path: link}, target)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		file = &pfs.File{Commit: file.Commit, Path: path.Join(file.Path, relPath)}
+	}
+}
+
+// symlinkAncestor returns the path and target of the symlink among the
+// ancestors of 'file', or "" if the closest existing ancestor isn't a
+// symlink.
+func (d *driver) symlinkAncestor(ctx context.Context, file *pfs.File) (string, string, error) {
+	for dir := path.Dir(path.Join("/", file.Path)); dir != "/"; dir = path.Dir(dir) {
+		// Open commits only apply the records under the requested path, so
+		// each ancestor gets its own tree
+		tree, err := d.getTreeForFile(ctx, &pfs.File{Commit: file.Commit, Path: dir})
+		if err != nil {
+			return "", "", err
+		}
+		node, err := tree.Get(dir)
+		if err != nil {
+			continue
+		}
+		if node.SymlinkNode == nil {
+			return "", "", nil
+		}
+		return dir, node.SymlinkNode.Target, nil
+	}
+	return "", "", nil
+}
+
+// globFile returns the files and directories matching 'pattern'. If
+// 'metadata' is non-empty, only files whose metadata contains all of its
+// key/value pairs are returned.
+func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, pattern string, metadata map[string]string) ([]*pfs.FileInfo, error) {
 	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_READER); err != nil {
 		return nil, err
 	}
@@ -1849,11 +3683,28 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, pattern strin
 
 	var fileInfos []*pfs.FileInfo
 	for _, node := range nodes {
+		if len(metadata) > 0 && !matchMetadata(node, metadata) {
+			continue
+		}
 		fileInfos = append(fileInfos, nodeToFileInfo(commit, node.Name, node, false))
 	}
 	return fileInfos, nil
 }
 
+// matchMetadata returns true if 'node' is a file whose metadata contains all
+// of the key/value pairs in 'metadata'.
+func matchMetadata(node *hashtree.NodeProto, metadata map[string]string) bool {
+	if node.FileNode == nil {
+		return false
+	}
+	for key, value := range metadata {
+		if v, ok := node.FileNode.Metadata[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
 func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.File, shallow bool) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
 	// Do READER authorization check for both newFile and oldFile
 	if oldFile != nil && oldFile.Commit != nil {
@@ -1907,10 +3758,132 @@ func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.F
 	return newFileInfos, oldFileInfos, nil
 }
 
+// listFileHistory walks back from file's commit along the first-parent
+// chain and returns the commits in which the hash of file's node changed.
+// Open commits are skipped, as their trees aren't final. The commits in
+// which the file didn't change are skipped using the modification stamps in
+// the trees, so that their trees don't have to be read.
+func (d *driver) listFileHistory(ctx context.Context, file *pfs.File, number uint64) ([]*pfs.FileHistory, error) {
+	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	commitInfo, err := d.inspectCommit(ctx, file.Commit)
+	if err != nil {
+		return nil, err
+	}
+	for commitInfo.Finished == nil {
+		if commitInfo.ParentCommit == nil {
+			return nil, nil
+		}
+		if commitInfo, err = d.inspectCommit(ctx, commitInfo.ParentCommit); err != nil {
+			return nil, err
+		}
+	}
+	tree, err := d.getTreeForCommit(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	var result []*pfs.FileHistory
+	for number == 0 || uint64(len(result)) < number {
+		// Nothing has changed the file since the commit that last modified
+		// it, so we skip straight to that commit
+		modified, err := lastModifiedCommit(tree, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		if modified != "" && modified != commitInfo.Commit.ID {
+			if commitInfo, err = d.inspectCommit(ctx, &pfs.Commit{Repo: file.Commit.Repo, ID: modified}); err != nil {
+				return nil, err
+			}
+		}
+		node, err := getNode(tree, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		var parentInfo *pfs.CommitInfo
+		var parentTree hashtree.HashTree
+		var parentNode *hashtree.NodeProto
+		if commitInfo.ParentCommit != nil {
+			if parentInfo, err = d.inspectCommit(ctx, commitInfo.ParentCommit); err != nil {
+				return nil, err
+			}
+			if parentTree, err = d.getTreeForCommit(ctx, parentInfo.Commit); err != nil {
+				return nil, err
+			}
+			if parentNode, err = getNode(parentTree, file.Path); err != nil {
+				return nil, err
+			}
+		}
+		if node != nil || parentNode != nil {
+			if node == nil || parentNode == nil || !bytes.Equal(node.Hash, parentNode.Hash) {
+				history := &pfs.FileHistory{
+					Commit:   commitInfo.Commit,
+					Finished: commitInfo.Finished,
+				}
+				if node != nil {
+					history.FileInfo = nodeToFileInfo(commitInfo.Commit, file.Path, node, false)
+					history.SizeDelta += node.SubtreeSize
+				}
+				if parentNode != nil {
+					history.SizeDelta -= parentNode.SubtreeSize
+				}
+				result = append(result, history)
+			}
+		}
+		if parentInfo == nil {
+			break
+		}
+		commitInfo, tree = parentInfo, parentTree
+	}
+	return result, nil
+}
+
+// lastModifiedCommit returns the ID of the commit that last modified the node
+// at 'p' in 'tree'. If there's no such node, it returns the commit that last
+// modified the node's closest existing parent directory instead, which
+// changes whenever the node is created or deleted. It returns "" if 'tree'
+// doesn't record it.
+func lastModifiedCommit(tree hashtree.HashTree, p string) (string, error) {
+	p = path.Join("/", p)
+	for {
+		node, err := tree.Get(p)
+		if err == nil {
+			return node.ModifiedCommit, nil
+		}
+		if hashtree.Code(err) != hashtree.PathNotFound {
+			return "", err
+		}
+		if p == "/" {
+			return "", nil
+		}
+		p = path.Dir(p)
+	}
+}
+
+// getNode returns the node at 'path' in 'tree', or nil if there is no such
+// node.
+func getNode(tree hashtree.HashTree, path string) (*hashtree.NodeProto, error) {
+	node, err := tree.Get(path)
+	if err != nil {
+		if hashtree.Code(err) == hashtree.PathNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return node, nil
+}
+
 func (d *driver) deleteFile(ctx context.Context, file *pfs.File) error {
 	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return err
 	}
+	if txnID := transactionID(ctx); txnID != "" {
+		return d.addToTransaction(ctx, txnID, &pfs.TransactionRequest{
+			DeleteFile: &pfs.DeleteFileRequest{
+				File: file,
+			},
+		})
+	}
 	commitInfo, err := d.inspectCommit(ctx, file.Commit)
 	if err != nil {
 		return err
@@ -1941,13 +3914,21 @@ func (d *driver) deleteAll(ctx context.Context) error {
 // To check that a key exists in etcd, we assert that its CreateRevision
 // is greater than zero.
 func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRecords *pfs.PutFileRecords) error {
+	// In a transaction, the file's data is already in the object store, but
+	// the records are only written when the transaction is finished
+	if txnID := transactionID(ctx); txnID != "" {
+		return d.addToTransaction(ctx, txnID, &pfs.TransactionRequest{
+			PutFile:        file,
+			PutFileRecords: newRecords,
+		})
+	}
 	prefix, err := d.scratchFilePrefix(ctx, file)
 	if err != nil {
 		return err
 	}
 
 	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		commitsCol := d.openCommits.ReadOnly(ctx)
+		commitsCol := d.openCommits.ReadWrite(stm)
 		var commit pfs.Commit
 		err := commitsCol.Get(file.Commit.ID, &commit)
 		if err != nil {
@@ -1958,6 +3939,13 @@ func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRe
 		if commit.ID != file.Commit.ID {
 			return fmt.Errorf("commit %v is not open", file.Commit.ID)
 		}
+		// Rewrite the open commit, so that an STM that has listed the
+		// commit's records (e.g. a transaction finishing the commit) conflicts
+		// with this one, even though the records it lists don't include the
+		// new key
+		if err := commitsCol.Put(file.Commit.ID, &commit); err != nil {
+			return err
+		}
 		recordsCol := d.putFileRecords.ReadWrite(stm)
 
 		var existingRecords pfs.PutFileRecords
@@ -1965,13 +3953,7 @@ func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRe
 		if err != nil && !col.IsErrNotFound(err) {
 			return err
 		}
-		if newRecords.Tombstone {
-			existingRecords.Tombstone = true
-			existingRecords.Records = nil
-		} else {
-			existingRecords.Split = newRecords.Split
-			existingRecords.Records = append(existingRecords.Records, newRecords.Records...)
-		}
+		upsertRecords(&existingRecords, newRecords)
 		recordsCol.Put(prefix, &existingRecords)
 		return nil
 	})
@@ -1980,7 +3962,8 @@ func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRe
 	}
 	// If there is a tombstone, remove any records for children under this directory
 	// This allows us to support deleting dirs / adding children properly, e.g. `TestDeleteDir`
-	if newRecords.Tombstone {
+	// Symlinks replace the directory in the same way.
+	if replacesFile(newRecords) {
 		_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 			revision := stm.Rev(d.openCommits.Path(file.Commit.ID))
 			if revision == 0 {
@@ -1995,6 +3978,35 @@ func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRe
 	return err
 }
 
+// replacesFile returns true if 'records' replace whatever was previously
+// at their path, i.e. they're a tombstone or a symlink.
+func replacesFile(records *pfs.PutFileRecords) bool {
+	return records.Tombstone || records.SymlinkTarget != ""
+}
+
+// upsertRecords applies 'newRecords' to 'existingRecords'. A tombstone or a
+// symlink discards everything written so far, otherwise the new records are
+// appended and the new metadata keys are set.
+func upsertRecords(existingRecords *pfs.PutFileRecords, newRecords *pfs.PutFileRecords) {
+	if replacesFile(newRecords) {
+		existingRecords.Tombstone = true
+		existingRecords.Records = nil
+		existingRecords.Metadata = nil
+		existingRecords.SymlinkTarget = newRecords.SymlinkTarget
+		return
+	}
+	// Writing to a symlink replaces it with a regular file
+	existingRecords.SymlinkTarget = ""
+	existingRecords.Split = newRecords.Split
+	existingRecords.Records = append(existingRecords.Records, newRecords.Records...)
+	for key, value := range newRecords.Metadata {
+		if existingRecords.Metadata == nil {
+			existingRecords.Metadata = make(map[string]string)
+		}
+		existingRecords.Metadata[key] = value
+	}
+}
+
 func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtree.OpenHashTree) error {
 	// a map that keeps track of the sizes of objects
 	sizeMap := make(map[string]int64)
@@ -2015,6 +4027,17 @@ func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtr
 			}
 		}
 	}
+	if records.SymlinkTarget != "" {
+		return tree.PutSymlink(filePath, records.SymlinkTarget)
+	}
+	if len(records.Records) > 0 {
+		// Writing to a symlink replaces it with a regular file
+		if node, err := tree.GetOpen(filePath); err == nil && node.SymlinkNode != nil {
+			if err := tree.DeleteFile(filePath); err != nil {
+				return err
+			}
+		}
+	}
 	if !records.Split {
 		if len(records.Records) == 0 {
 			return nil
@@ -2041,6 +4064,11 @@ func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtr
 				}
 			}
 		}
+		if len(records.Metadata) > 0 {
+			if err := tree.PutFileMetadata(filePath, records.Metadata); err != nil {
+				return err
+			}
+		}
 	} else {
 		nodes, err := tree.List(filePath)
 		if err != nil && hashtree.Code(err) != hashtree.PathNotFound {
@@ -2057,9 +4085,15 @@ func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtr
 			indexOffset++ // start writing to the file after the last file
 		}
 		for i, record := range records.Records {
-			if err := tree.PutFile(path.Join(filePath, fmt.Sprintf(splitSuffixFmt, i+int(indexOffset))), []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
+			splitPath := path.Join(filePath, fmt.Sprintf(splitSuffixFmt, i+int(indexOffset)))
+			if err := tree.PutFile(splitPath, []*pfs.Object{{Hash: record.ObjectHash}}, record.SizeBytes); err != nil {
 				return err
 			}
+			if len(records.Metadata) > 0 {
+				if err := tree.PutFileMetadata(splitPath, records.Metadata); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
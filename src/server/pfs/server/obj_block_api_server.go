@@ -16,6 +16,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/groupcache"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
@@ -73,7 +74,7 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 	s := &objBlockAPIServer{
 		Logger:           log.NewLogger("pfs.BlockAPI.Obj"),
 		dir:              dir,
-		objClient:        objClient,
+		objClient:        obj.NewMeteredClient(objClient),
 		objectIndexes:    make(map[string]*pfsclient.ObjectIndex),
 		objectCacheBytes: oneCacheShare * objectCacheShares,
 	}
@@ -91,8 +92,10 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 	s.objectCache = groupcache.NewGroup(objectGroupName, oneCacheShare*objectCacheShares, groupcache.GetterFunc(s.objectGetter))
 	s.tagCache = groupcache.NewGroup(tagGroupName, oneCacheShare*tagCacheShares, groupcache.GetterFunc(s.tagGetter))
 	s.objectInfoCache = groupcache.NewGroup(objectInfoGroupName, oneCacheShare*objectInfoCacheShares, groupcache.GetterFunc(s.objectInfoGetter))
+	for _, group := range []*groupcache.Group{s.objectCache, s.tagCache, s.objectInfoCache} {
+		registerCacheMetrics(group)
+	}
 	// Periodically print cache stats for debugging purposes
-	// TODO: make the stats accessible via HTTP or gRPC.
 	go func() {
 		ticker := time.NewTicker(time.Minute)
 		for {
@@ -106,6 +109,43 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 	return s, nil
 }
 
+// registerCacheMetrics exports the hit rate of a groupcache group as
+// Prometheus metrics labelled with the group's name.
+func registerCacheMetrics(group *groupcache.Group) {
+	constLabels := map[string]string{"cache": group.Name()}
+	prometheus.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name:        "pachyderm_block_cache_gets_total",
+			Help:        "Total number of gets from the block server's caches.",
+			ConstLabels: constLabels,
+		}, func() float64 { return float64(group.Stats.Gets.Get()) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name:        "pachyderm_block_cache_hits_total",
+			Help:        "Total number of gets from the block server's caches that were served from the cache.",
+			ConstLabels: constLabels,
+		}, func() float64 { return float64(group.Stats.CacheHits.Get()) }),
+	)
+}
+
+// tracedObjClient returns s.objClient, with its operations recorded as
+// children of the span in ctx.
+func (s *objBlockAPIServer) tracedObjClient(ctx context.Context) obj.Client {
+	return obj.NewTracingClient(ctx, s.objClient)
+}
+
+// CheckObjectStorage checks that s can reach its object store by reading an
+// object that doesn't exist, which should fail with a NotExist error.
+func (s *objBlockAPIServer) CheckObjectStorage(ctx context.Context) error {
+	r, err := s.objClient.Reader(uuid.NewWithoutDashes(), 0, 0)
+	if err == nil {
+		return r.Close()
+	}
+	if s.objClient.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
 // watchGC watches for GC runs and invalidate all cache when GC happens.
 func (s *objBlockAPIServer) watchGC(etcdAddress string) {
 	b := backoff.NewInfiniteBackOff()
@@ -253,7 +293,7 @@ func (s *objBlockAPIServer) putObject(ctx context.Context, dataReader io.Reader,
 	var size int64
 	if err := func() (retErr error) {
 		blockPath := s.blockPath(block)
-		w, err := s.objClient.Writer(blockPath)
+		w, err := s.tracedObjClient(ctx).Writer(blockPath)
 		if err != nil {
 			return err
 		}
@@ -336,7 +376,7 @@ func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer
 		// The object is a substantial portion of the available cache space so
 		// we bypass the cache and stream it directly out of the underlying store.
 		blockPath := s.blockPath(objectInfo.BlockRef.Block)
-		r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower, objectSize)
+		r, err := s.tracedObjClient(getObjectServer.Context()).Reader(blockPath, objectInfo.BlockRef.Range.Lower, objectSize)
 		if err != nil {
 			return err
 		}
@@ -383,7 +423,7 @@ func (s *objBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, get
 		}
 		if request.TotalSize >= uint64(s.objectCacheBytes/maxCachedObjectDenom) {
 			blockPath := s.blockPath(objectInfo.BlockRef.Block)
-			r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower+offset, readSize)
+			r, err := s.tracedObjClient(getObjectsServer.Context()).Reader(blockPath, objectInfo.BlockRef.Range.Lower+offset, readSize)
 			if err != nil {
 				return err
 			}
@@ -804,7 +844,7 @@ func (s *objBlockAPIServer) writeProto(path string, pb proto.Marshaler) (retErr
 }
 
 func (s *objBlockAPIServer) blockGetter(ctx groupcache.Context, key string, dest groupcache.Sink) (retErr error) {
-	return s.readObj(s.blockPath(client.NewBlock(key)), 0, 0, dest)
+	return s.readObj(getterContext(ctx), s.blockPath(client.NewBlock(key)), 0, 0, dest)
 }
 
 func (s *objBlockAPIServer) objectGetter(ctx groupcache.Context, key string, dest groupcache.Sink) error {
@@ -813,7 +853,7 @@ func (s *objBlockAPIServer) objectGetter(ctx groupcache.Context, key string, des
 	if err := s.objectInfoCache.Get(ctx, key, sink); err != nil {
 		return err
 	}
-	return s.readBlockRef(objectInfo.BlockRef, dest)
+	return s.readBlockRef(getterContext(ctx), objectInfo.BlockRef, dest)
 }
 
 func (s *objBlockAPIServer) tagGetter(ctx groupcache.Context, key string, dest groupcache.Sink) error {
@@ -917,11 +957,21 @@ func (s *objBlockAPIServer) objectInfoGetter(ctx groupcache.Context, key string,
 	return fmt.Errorf("objectInfoGetter: object %s not found", object.Hash)
 }
 
-func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, dest groupcache.Sink) (retErr error) {
+// getterContext returns the context passed to the groupcache Get that called
+// a getter. It's nil if the Get came from a peer.
+func getterContext(ctx groupcache.Context) context.Context {
+	if ctx, ok := ctx.(context.Context); ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+func (s *objBlockAPIServer) readObj(ctx context.Context, path string, offset uint64, size uint64, dest groupcache.Sink) (retErr error) {
+	objClient := s.tracedObjClient(ctx)
 	var reader io.ReadCloser
 	var err error
 	backoff.RetryNotify(func() error {
-		reader, err = s.objClient.Reader(path, offset, size)
+		reader, err = objClient.Reader(path, offset, size)
 		if err != nil && obj.IsRetryable(s.objClient, err) {
 			return err
 		}
@@ -948,8 +998,8 @@ func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, des
 	return dest.SetBytes(data)
 }
 
-func (s *objBlockAPIServer) readBlockRef(blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
-	return s.readObj(s.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
+func (s *objBlockAPIServer) readBlockRef(ctx context.Context, blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
+	return s.readObj(ctx, s.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
 }
 
 func (s *objBlockAPIServer) getObjectIndex(prefix string) (*pfsclient.ObjectIndex, bool) {
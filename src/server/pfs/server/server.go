@@ -2,6 +2,7 @@ package server
 
 import (
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	"golang.org/x/net/context"
 )
 
 // Valid object storage backends
@@ -21,6 +22,9 @@ type APIServer interface {
 // BlockAPIServer combines BlockAPIServer and ObjectAPIServer.
 type BlockAPIServer interface {
 	pfsclient.ObjectAPIServer
+	// CheckObjectStorage returns an error if the object store can't be
+	// reached.
+	CheckObjectStorage(ctx context.Context) error
 }
 
 // NewAPIServer creates an APIServer.
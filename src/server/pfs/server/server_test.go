@@ -24,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	authtesting "github.com/pachyderm/pachyderm/src/server/auth/testing"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	pfssync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
@@ -2018,6 +2019,119 @@ func TestInspectRepoComplex(t *testing.T) {
 	require.Equal(t, int(info.SizeBytes), totalSize)
 }
 
+func TestQuota(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	require.YesError(t, c.SetQuota(repo, &pfs.Quota{SizeBytes: 10, SoftSizeBytes: 20}))
+	require.NoError(t, c.SetQuota(repo, &pfs.Quota{
+		SizeBytes:     10,
+		SoftSizeBytes: 4,
+		FileCount:     3,
+		SoftFileCount: 1,
+	}))
+
+	_, err := c.PutFile(repo, "master", "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	repoInfo, err := c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), repoInfo.SizeBytes)
+	require.Equal(t, uint64(8), repoInfo.TreeSizeBytes)
+	require.Equal(t, uint64(2), repoInfo.FileCount)
+	require.Equal(t, 2, len(repoInfo.QuotaWarnings))
+
+	// PutFile fails once the repo would go over its size limit
+	_, err = c.PutFile(repo, "master", "buzz", strings.NewReader("buzz\n"))
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsQuotaExceededErr(err))
+
+	// Finishing a commit fails once the repo would go over its file limit
+	require.NoError(t, c.SetQuota(repo, &pfs.Quota{FileCount: 3}))
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "a", strings.NewReader("a"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "b", strings.NewReader("b"))
+	require.NoError(t, err)
+	err = c.FinishCommit(repo, commit.ID)
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsQuotaExceededErr(err))
+	// Deleting files brings the repo back under its quota
+	require.NoError(t, c.DeleteFile(repo, commit.ID, "foo"))
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+	repoInfo, err = c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), repoInfo.FileCount)
+	require.Equal(t, 0, len(repoInfo.QuotaWarnings))
+
+	// Removing the quota lifts the limits
+	require.NoError(t, c.SetQuota(repo, nil))
+	_, err = c.PutFile(repo, "master", "buzz", strings.NewReader("buzz\n"))
+	require.NoError(t, err)
+
+	// A repo that's over its size limit can't grow, but can shrink
+	require.NoError(t, c.SetQuota(repo, &pfs.Quota{SizeBytes: 10}))
+	commit, err = c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "c", strings.NewReader("c"))
+	require.NoError(t, err)
+	err = c.FinishCommit(repo, commit.ID)
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsQuotaExceededErr(err))
+	require.NoError(t, c.DeleteFile(repo, commit.ID, "a"))
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+	repoInfo, err = c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), repoInfo.TreeSizeBytes)
+
+	// Branch quotas only limit the branch's head
+	require.NoError(t, c.SetQuota(repo, nil))
+	require.NoError(t, c.SetBranchQuota(repo, "master", &pfs.Quota{FileCount: 4, SoftFileCount: 3}))
+	repoInfo, err = c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoInfo.QuotaWarnings))
+	require.NoError(t, c.SetBranch(repo, "master", "other"))
+	_, err = c.PutFile(repo, "other", "d", strings.NewReader("d"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "d", strings.NewReader("d"))
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsQuotaExceededErr(err))
+}
+
+func TestQuotaSetLater(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := uniqueString("TestQuotaSetLater")
+	require.NoError(t, c.CreateRepo(repo))
+	_, err := c.PutFile(repo, "master", "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+
+	// The size of the repo is tracked before it has a quota...
+	repoInfo, err := c.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), repoInfo.TreeSizeBytes)
+	require.Equal(t, uint64(2), repoInfo.FileCount)
+
+	// ...so a quota set later is enforced from the start
+	require.NoError(t, c.SetQuota(repo, &pfs.Quota{SizeBytes: 10}))
+	_, err = c.PutFile(repo, "master", "buzz", strings.NewReader("buzz\n"))
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsQuotaExceededErr(err))
+	_, err = c.PutFile(repo, "master", "a", strings.NewReader("a\n"))
+	require.NoError(t, err)
+	// Once the repo is at its limit, nothing more can be put into it
+	_, err = c.PutFile(repo, "master", "b", strings.NewReader("b"))
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsQuotaExceededErr(err))
+}
+
 func TestCreate(t *testing.T) {
 	t.Parallel()
 	client := getClient(t)
@@ -2227,6 +2341,536 @@ func TestSetBranchTwice(t *testing.T) {
 	require.Equal(t, commit2.ID, branches[0].Head.ID)
 }
 
+func TestBranchProvenance(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	require.NoError(t, c.CreateRepo("A"))
+	require.NoError(t, c.CreateRepo("B"))
+	require.NoError(t, c.CreateRepo("C"))
+	require.NoError(t, c.CreateBranch("A", "master", "", nil))
+	require.NoError(t, c.CreateBranch("B", "master", "", []*pfs.Branch{pclient.NewBranch("A", "master")}))
+	require.NoError(t, c.CreateBranch("C", "master", "", []*pfs.Branch{pclient.NewBranch("B", "master")}))
+
+	// A cycle should be rejected
+	require.YesError(t, c.CreateBranch("A", "master", "", []*pfs.Branch{pclient.NewBranch("C", "master")}))
+
+	branchInfo, err := c.InspectBranch("A", "master")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(branchInfo.Subvenance))
+	require.Equal(t, "B", branchInfo.Subvenance[0].Repo.Name)
+	branchInfo, err = c.InspectBranch("B", "master")
+	require.NoError(t, err)
+	require.Nil(t, branchInfo.Head)
+
+	// Finishing a commit in A opens a commit in B
+	commitA, err := c.StartCommit("A", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit("A", commitA.ID))
+	commitInfoB, err := c.InspectCommit("B", "master")
+	require.NoError(t, err)
+	require.Nil(t, commitInfoB.Finished)
+	require.Equal(t, 1, len(commitInfoB.Provenance))
+	require.Equal(t, commitA.ID, commitInfoB.Provenance[0].ID)
+
+	// ...and finishing that commit opens a commit in C
+	require.NoError(t, c.FinishCommit("B", "master"))
+	commitInfoC, err := c.InspectCommit("C", "master")
+	require.NoError(t, err)
+	require.Nil(t, commitInfoC.Finished)
+	require.Equal(t, 2, len(commitInfoC.Provenance))
+
+	// B can't be deleted while it's in the provenance of C
+	require.YesError(t, c.DeleteBranch("B", "master"))
+}
+
+func TestCreateBranchKeepsProvenance(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	input := uniqueString("TestCreateBranchKeepsProvenanceInput")
+	output := uniqueString("TestCreateBranchKeepsProvenanceOutput")
+	require.NoError(t, c.CreateRepo(input))
+	require.NoError(t, c.CreateRepo(output))
+	require.NoError(t, c.CreateBranch(input, "master", "", nil))
+	require.NoError(t, c.CreateBranch(output, "master", "", []*pfs.Branch{pclient.NewBranch(input, "master")}))
+	commit, err := c.StartCommit(output, "")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(output, commit.ID))
+
+	// Moving the branch without giving its provenance keeps the provenance
+	require.NoError(t, c.CreateBranch(output, "master", commit.ID, nil))
+	branchInfo, err := c.InspectBranch(output, "master")
+	require.NoError(t, err)
+	require.Equal(t, commit.ID, branchInfo.Head.ID)
+	require.Equal(t, 1, len(branchInfo.Provenance))
+	require.Equal(t, input, branchInfo.Provenance[0].Repo.Name)
+
+	// The provenance can be cleared explicitly, but not set at the same time
+	_, err = c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{
+		Branch:          pclient.NewBranch(output, "master"),
+		Provenance:      []*pfs.Branch{pclient.NewBranch(input, "master")},
+		ClearProvenance: true,
+	})
+	require.YesError(t, err)
+	require.NoError(t, c.ClearBranchProvenance(output, "master"))
+	branchInfo, err = c.InspectBranch(output, "master")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(branchInfo.Provenance))
+	branchInfo, err = c.InspectBranch(input, "master")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(branchInfo.Subvenance))
+}
+
+func TestCreateBranchHeadWithoutRepo(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	require.NoError(t, c.CreateRepo("test"))
+	commit, err := c.StartCommit("test", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit("test", commit.ID))
+
+	// A head without a repo is taken to be in the branch's repo
+	_, err = c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{
+		Branch: pclient.NewBranch("test", "other"),
+		Head:   &pfs.Commit{ID: commit.ID},
+	})
+	require.NoError(t, err)
+	branchInfo, err := c.InspectBranch("test", "other")
+	require.NoError(t, err)
+	require.Equal(t, commit.ID, branchInfo.Head.ID)
+
+	// A request without a branch repo is rejected rather than crashing pachd
+	_, err = c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{
+		Branch: &pfs.Branch{Name: "other"},
+		Head:   &pfs.Commit{ID: commit.ID},
+	})
+	require.YesError(t, err)
+}
+
+func TestTransaction(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	require.NoError(t, c.CreateRepo("A"))
+	txn, err := c.StartTransaction()
+	require.NoError(t, err)
+	txnClient := c.WithTransaction(txn)
+
+	require.NoError(t, txnClient.CreateRepo("B"))
+	commitA, err := txnClient.StartCommit("A", "master")
+	require.NoError(t, err)
+	commitB, err := txnClient.StartCommit("B", "master")
+	require.NoError(t, err)
+	_, err = txnClient.PutFile("A", commitA.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = txnClient.PutFile("B", "master", "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, txnClient.FinishCommit("A", commitA.ID))
+	require.NoError(t, txnClient.FinishCommit("B", "master"))
+
+	// Nothing is visible until the transaction is finished
+	_, err = c.InspectRepo("B")
+	require.YesError(t, err)
+	_, err = c.InspectCommit("A", "master")
+	require.YesError(t, err)
+
+	txnInfo, err := c.FinishTransaction(txn)
+	require.NoError(t, err)
+	require.Equal(t, 7, len(txnInfo.Requests))
+
+	commitInfo, err := c.InspectCommit("A", "master")
+	require.NoError(t, err)
+	require.Equal(t, commitA.ID, commitInfo.Commit.ID)
+	require.NotNil(t, commitInfo.Finished)
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile("A", "master", "foo", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+	commitInfo, err = c.InspectCommit("B", "master")
+	require.NoError(t, err)
+	require.Equal(t, commitB.ID, commitInfo.Commit.ID)
+	buffer.Reset()
+	require.NoError(t, c.GetFile("B", "master", "bar", 0, 0, &buffer))
+	require.Equal(t, "bar\n", buffer.String())
+
+	// A transaction with a failing request is not applied at all
+	txn, err = c.StartTransaction()
+	require.NoError(t, err)
+	txnClient = c.WithTransaction(txn)
+	_, err = txnClient.StartCommit("A", "master")
+	require.NoError(t, err)
+	require.NoError(t, txnClient.FinishCommit("A", commitA.ID))
+	_, err = c.FinishTransaction(txn)
+	require.YesError(t, err)
+	commitInfo, err = c.InspectCommit("A", "master")
+	require.NoError(t, err)
+	require.Equal(t, commitA.ID, commitInfo.Commit.ID)
+}
+
+func TestSquashAndRevertCommit(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	var commits []*pfs.Commit
+	for i := 0; i < 3; i++ {
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		commits = append(commits, commit)
+	}
+
+	// The head of a branch can't be squashed
+	require.YesError(t, c.SquashCommit(repo, commits[2].ID))
+	require.NoError(t, c.SquashCommit(repo, commits[1].ID))
+	commitInfos, err := c.ListCommit(repo, "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	require.Equal(t, commits[0].ID, commitInfos[0].ParentCommit.ID)
+	// The squashed commit's changes are still there
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(repo, "master", "file1", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+
+	revert, err := c.RevertCommit(repo, commits[0].ID, "master")
+	require.NoError(t, err)
+	commitInfo, err := c.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, revert.ID, commitInfo.Commit.ID)
+	fileInfos, err := c.ListFile(repo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	_, err = c.InspectFile(repo, "master", "file0")
+	require.YesError(t, err)
+}
+
+func TestMergeBranch(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	putFile := func(branch string, path string, content string) *pfs.Commit {
+		commit, err := c.StartCommit(repo, branch)
+		require.NoError(t, err)
+		require.NoError(t, c.DeleteFile(repo, commit.ID, path))
+		_, err = c.PutFile(repo, commit.ID, path, strings.NewReader(content))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		return commit
+	}
+	base := putFile("master", "shared", "foo\n")
+	require.NoError(t, c.SetBranch(repo, base.ID, "feature"))
+
+	// Merging a branch that's behind is a fast-forward
+	feature := putFile("feature", "feature", "bar\n")
+	response, err := c.MergeBranch(repo, "feature", "master", "")
+	require.NoError(t, err)
+	require.Equal(t, feature.ID, response.Commit.ID)
+
+	// Non-conflicting changes on both branches are merged
+	master := putFile("master", "master", "buzz\n")
+	feature = putFile("feature", "shared", "fizz\n")
+	response, err = c.MergeBranch(repo, "feature", "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(response.Conflicts))
+	commitInfo, err := c.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, response.Commit.ID, commitInfo.Commit.ID)
+	require.Equal(t, master.ID, commitInfo.ParentCommit.ID)
+	require.Equal(t, feature.ID, commitInfo.MergeParent.ID)
+	fileInfos, err := c.ListFile(repo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(fileInfos))
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(repo, "master", "shared", 0, 0, &buffer))
+	require.Equal(t, "fizz\n", buffer.String())
+
+	// Changing the same file on both branches is a conflict
+	putFile("master", "master", "master\n")
+	putFile("feature", "master", "feature\n")
+	response, err = c.MergeBranch(repo, "feature", "master", "")
+	require.NoError(t, err)
+	require.Nil(t, response.Commit)
+	require.Equal(t, 1, len(response.Conflicts))
+	require.Equal(t, "/master", response.Conflicts[0].Path)
+}
+
+func TestMergeBranchPathConflict(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	putFile := func(branch string, path string) {
+		commit, err := c.StartCommit(repo, branch)
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit.ID, path, strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+	}
+	putFile("master", "base")
+	require.NoError(t, c.SetBranch(repo, "master", "feature"))
+
+	// A file put under a path that's a file on the other branch can't be
+	// merged, and the conflict names the file
+	putFile("master", "dir")
+	putFile("feature", "dir/file")
+	response, err := c.MergeBranch(repo, "feature", "master", "")
+	require.NoError(t, err)
+	require.Nil(t, response.Commit)
+	require.Equal(t, 1, len(response.Conflicts))
+	require.Equal(t, "/dir/file", response.Conflicts[0].Path)
+}
+
+func TestSquashMergeParent(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	putFile := func(branch string, path string) *pfs.Commit {
+		commit, err := c.StartCommit(repo, branch)
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit.ID, path, strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		return commit
+	}
+	base := putFile("master", "base")
+	require.NoError(t, c.SetBranch(repo, base.ID, "feature"))
+	feature := putFile("feature", "feature")
+	putFile("master", "master")
+	response, err := c.MergeBranch(repo, "feature", "master", "")
+	require.NoError(t, err)
+	merge := response.Commit
+	next := putFile("feature", "next")
+
+	// Squashing the merge parent makes its parent the merge parent, and
+	// its child the commit that modified its files
+	require.NoError(t, c.SquashCommit(repo, feature.ID))
+	commitInfo, err := c.InspectCommit(repo, merge.ID)
+	require.NoError(t, err)
+	require.Equal(t, base.ID, commitInfo.MergeParent.ID)
+	fileInfo, err := c.InspectFile(repo, "feature", "feature")
+	require.NoError(t, err)
+	require.Equal(t, next.ID, fileInfo.ModifiedCommit.ID)
+	_, err = c.InspectCommit(repo, fileInfo.ModifiedCommit.ID)
+	require.NoError(t, err)
+
+	// The history can still be walked to merge the branches again
+	response, err = c.MergeBranch(repo, "feature", "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(response.Conflicts))
+	fileInfos, err := c.ListFile(repo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 4, len(fileInfos))
+}
+
+func TestListFileHistory(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	var commits []*pfs.Commit
+	commit := func(f func(commitID string)) {
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		f(commit.ID)
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		commits = append(commits, commit)
+	}
+	commit(func(commitID string) {
+		_, err := c.PutFile(repo, commitID, "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+	})
+	commit(func(commitID string) {
+		_, err := c.PutFile(repo, commitID, "other", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+	})
+	commit(func(commitID string) {
+		_, err := c.PutFile(repo, commitID, "file", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+	})
+	commit(func(commitID string) {
+		require.NoError(t, c.DeleteFile(repo, commitID, "file"))
+	})
+
+	history, err := c.ListFileHistory(repo, "master", "file", 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(history))
+	require.Equal(t, commits[3].ID, history[0].Commit.ID)
+	require.Nil(t, history[0].FileInfo)
+	require.Equal(t, int64(-8), history[0].SizeDelta)
+	require.Equal(t, commits[2].ID, history[1].Commit.ID)
+	require.Equal(t, uint64(8), history[1].FileInfo.SizeBytes)
+	require.Equal(t, int64(4), history[1].SizeDelta)
+	require.Equal(t, commits[0].ID, history[2].Commit.ID)
+	require.Equal(t, int64(4), history[2].SizeDelta)
+
+	history, err = c.ListFileHistory(repo, "master", "file", 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+}
+
+func TestFileMetadata(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFileWithMetadata(repo, commit.ID, "cats.csv", strings.NewReader("foo\n"), false, map[string]string{"content-type": "text/csv", "status": "labelled"})
+	require.NoError(t, err)
+	_, err = c.PutFileWithMetadata(repo, commit.ID, "dogs.csv", strings.NewReader("foo\n"), false, map[string]string{"content-type": "text/csv"})
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "README", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+
+	fileInfo, err := c.InspectFile(repo, "master", "cats.csv")
+	require.NoError(t, err)
+	require.Equal(t, "text/csv", fileInfo.Metadata["content-type"])
+	require.Equal(t, "labelled", fileInfo.Metadata["status"])
+	fileInfos, err := c.ListFile(repo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(fileInfos))
+
+	fileInfos, err = c.GlobFileWithMetadata(repo, "master", "*", map[string]string{"content-type": "text/csv"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	fileInfos, err = c.GlobFileWithMetadata(repo, "master", "*", map[string]string{"content-type": "text/csv", "status": "labelled"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfos))
+	require.Equal(t, "/cats.csv", fileInfos[0].File.Path)
+
+	// Metadata is kept when more data is appended to a file, and replaced when
+	// the file is overwritten
+	commit, err = c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "cats.csv", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	_, err = c.PutFileWithMetadata(repo, commit.ID, "dogs.csv", strings.NewReader("bar\n"), true, map[string]string{"status": "unlabelled"})
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+	fileInfo, err = c.InspectFile(repo, "master", "cats.csv")
+	require.NoError(t, err)
+	require.Equal(t, "labelled", fileInfo.Metadata["status"])
+	fileInfo, err = c.InspectFile(repo, "master", "dogs.csv")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfo.Metadata))
+	require.Equal(t, "unlabelled", fileInfo.Metadata["status"])
+}
+
+func TestFileModified(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	require.NoError(t, c.CreateRepo(repo))
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit1.ID, "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit1.ID, "dir/bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit1.ID))
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit2.ID, "dir/buzz", strings.NewReader("buzz\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit2.ID))
+
+	commitInfo1, err := c.InspectCommit(repo, commit1.ID)
+	require.NoError(t, err)
+	commitInfo2, err := c.InspectCommit(repo, commit2.ID)
+	require.NoError(t, err)
+	fileInfo, err := c.InspectFile(repo, "master", "foo")
+	require.NoError(t, err)
+	require.Equal(t, commit1.ID, fileInfo.ModifiedCommit.ID)
+	require.Equal(t, commitInfo1.Finished, fileInfo.Modified)
+	fileInfos, err := c.ListFile(repo, "master", "dir")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	require.Equal(t, commit1.ID, fileInfos[0].ModifiedCommit.ID)
+	require.Equal(t, commit2.ID, fileInfos[1].ModifiedCommit.ID)
+	fileInfos, err = c.GlobFile(repo, "master", "*")
+	require.NoError(t, err)
+	for _, fileInfo := range fileInfos {
+		if fileInfo.File.Path == "/dir" {
+			require.Equal(t, commit2.ID, fileInfo.ModifiedCommit.ID)
+			require.Equal(t, commitInfo2.Finished, fileInfo.Modified)
+		}
+	}
+}
+
+func TestSymlink(t *testing.T) {
+	t.Parallel()
+	c := getClient(t)
+
+	repo := "test"
+	other := "other"
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.CreateRepo(other))
+	_, err := c.PutFile(other, "master", "lib/baz", strings.NewReader("baz\n"))
+	require.NoError(t, err)
+
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "dir/foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.CreateSymlink(repo, commit.ID, "dir/link", "foo"))
+	require.NoError(t, c.CreateSymlink(repo, commit.ID, "dirlink", "/dir"))
+	require.NoError(t, c.CreateSymlink(repo, commit.ID, "lib", "pfs://other/master/lib"))
+	require.NoError(t, c.CreateSymlink(repo, commit.ID, "loop", "loop"))
+	require.YesError(t, c.CreateSymlink(repo, commit.ID, "bad", "pfs://other"))
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+
+	// InspectFile returns the link itself
+	fileInfo, err := c.InspectFile(repo, commit.ID, "dir/link")
+	require.NoError(t, err)
+	require.Equal(t, pfs.FileType_SYMLINK, fileInfo.FileType)
+	require.Equal(t, "foo", fileInfo.SymlinkTarget)
+
+	// GetFile follows links, including through directories and across repos
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(repo, commit.ID, "dir/link", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(repo, commit.ID, "dirlink/link", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(repo, commit.ID, "lib/baz", 0, 0, &buffer))
+	require.Equal(t, "baz\n", buffer.String())
+	require.YesError(t, c.GetFile(repo, commit.ID, "loop", 0, 0, &buffer))
+
+	// ListFile lists the target of a link to a directory
+	fileInfos, err := c.ListFile(repo, commit.ID, "dirlink")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	require.Equal(t, "/dirlink/foo", fileInfos[0].File.Path)
+	require.Equal(t, pfs.FileType_SYMLINK, fileInfos[1].FileType)
+
+	// Writing to a link replaces it
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit2.ID, "dir/link", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit2.ID))
+	fileInfo, err = c.InspectFile(repo, commit2.ID, "dir/link")
+	require.NoError(t, err)
+	require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
+	buffer.Reset()
+	require.NoError(t, c.GetFile(repo, commit2.ID, "dir/link", 0, 0, &buffer))
+	require.Equal(t, "bar\n", buffer.String())
+}
+
 func TestSyncPullPush(t *testing.T) {
 	t.Parallel()
 	client := getClient(t)
@@ -3116,6 +3760,67 @@ func TestCopyFile(t *testing.T) {
 	require.Equal(t, "foo 0\n", b.String())
 }
 
+func TestMoveFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestMoveFile")
+	require.NoError(t, c.CreateRepo(repo))
+	_, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	numFiles := 5
+	for i := 0; i < numFiles; i++ {
+		_, err = c.PutFile(repo, "master", fmt.Sprintf("files/%d", i), strings.NewReader(fmt.Sprintf("foo %d\n", i)))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(repo, "master"))
+
+	_, err = c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	// Files written in the open commit are moved too
+	_, err = c.PutFile(repo, "master", "files/new", strings.NewReader("new\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.MoveFile(repo, "master", "files", "archive/files"))
+	require.NoError(t, c.MoveFile(repo, "master", "archive/files/0", "file0"))
+	require.YesError(t, c.MoveFile(repo, "master", "file0", "archive/files/1"))
+	require.YesError(t, c.MoveFile(repo, "master", "archive", "archive/sub"))
+	require.YesError(t, c.MoveFile(repo, "master", "nonexistent", "foo"))
+	// The moved files can be read at their new paths (and not their old
+	// ones) before the commit is finished
+	var b bytes.Buffer
+	require.NoError(t, c.GetFile(repo, "master", "archive/files/1", 0, 0, &b))
+	require.Equal(t, "foo 1\n", b.String())
+	_, err = c.InspectFile(repo, "master", "files/1")
+	require.YesError(t, err)
+	// Writes after a move are applied to the moved file
+	_, err = c.PutFile(repo, "master", "file0", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, "master"))
+
+	_, err = c.InspectFile(repo, "master", "files")
+	require.YesError(t, err)
+	fileInfos, err := c.ListFile(repo, "master", "archive/files")
+	require.NoError(t, err)
+	require.Equal(t, numFiles, len(fileInfos))
+	for i := 1; i < numFiles; i++ {
+		var b bytes.Buffer
+		require.NoError(t, c.GetFile(repo, "master", fmt.Sprintf("archive/files/%d", i), 0, 0, &b))
+		require.Equal(t, fmt.Sprintf("foo %d\n", i), b.String())
+	}
+	b.Reset()
+	require.NoError(t, c.GetFile(repo, "master", "archive/files/new", 0, 0, &b))
+	require.Equal(t, "new\n", b.String())
+	b.Reset()
+	require.NoError(t, c.GetFile(repo, "master", "file0", 0, 0, &b))
+	require.Equal(t, "foo 0\nbar\n", b.String())
+
+	// Files can't be moved in a finished commit
+	require.YesError(t, c.MoveFile(repo, "master", "file0", "file1"))
+}
+
 func TestBuildCommit(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -3162,6 +3867,34 @@ func TestBuildCommit(t *testing.T) {
 	require.Equal(t, uint64(fooSize+barSize), commitInfo.SizeBytes)
 }
 
+func TestFinishCommitEmpty(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+
+	c := getClient(t)
+	repo := uniqueString("TestFinishCommitEmpty")
+	require.NoError(t, c.CreateRepo(repo))
+	_, err := c.PutFile(repo, "master", "foo", strings.NewReader("foo\n"))
+	require.NoError(t, err)
+
+	// A commit finished with 'empty' has none of its parent's files, nor the
+	// files put into it
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, commit.ID, "bar", strings.NewReader("bar\n"))
+	require.NoError(t, err)
+	_, err = c.PfsAPIClient.FinishCommit(c.Ctx(), &pfs.FinishCommitRequest{
+		Commit: commit,
+		Empty:  true,
+	})
+	require.NoError(t, err)
+	fileInfos, err := c.ListFile(repo, commit.ID, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(fileInfos))
+}
+
 func uniqueString(prefix string) string {
 	return prefix + "-" + uuid.NewWithoutDashes()[0:12]
 }
@@ -0,0 +1,375 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// transactionID returns the ID of the transaction that the request in 'ctx'
+// is part of, or "" if the request isn't part of a transaction.
+func transactionID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md[pfs.ContextTransactionKey]; len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+func (d *driver) startTransaction(ctx context.Context) (*pfs.Transaction, error) {
+	txn := &pfs.Transaction{ID: uuid.NewWithoutDashes()}
+	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		return d.transactions.ReadWrite(stm).Create(txn.ID, &pfs.TransactionInfo{
+			Transaction: txn,
+			Started:     now(),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// addToTransaction appends 'request' to the transaction 'txnID'. The request
+// is applied when the transaction is finished.
+func (d *driver) addToTransaction(ctx context.Context, txnID string, request *pfs.TransactionRequest) error {
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		transactions := d.transactions.ReadWrite(stm)
+		txnInfo := &pfs.TransactionInfo{}
+		if err := transactions.Get(txnID, txnInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("transaction %s not found", txnID)
+			}
+			return err
+		}
+		if err := d.txnRequests.ReadWrite(stm).Put(txnRequestKey(txnID, txnInfo.NumRequests), request); err != nil {
+			return err
+		}
+		txnInfo.NumRequests++
+		return transactions.Put(txnID, txnInfo)
+	})
+	return err
+}
+
+// txnRequestKey returns the key of the 'i'th request of the transaction
+// 'txnID'. The position is zero-padded, so that listing the transaction's
+// requests returns them in the order in which they were added.
+func txnRequestKey(txnID string, i int64) string {
+	return path.Join(txnID, fmt.Sprintf("%016d", i))
+}
+
+func (d *driver) deleteTransaction(ctx context.Context, txn *pfs.Transaction) error {
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		d.txnRequests.ReadWrite(stm).DeleteAllPrefix(txn.ID)
+		return d.transactions.ReadWrite(stm).Delete(txn.ID)
+	})
+	return err
+}
+
+// finishTransaction applies all of the requests in 'txn', in the order in
+// which they were added, in a single STM. Either all of the requests are
+// applied or (if any of them fails) none of them are.
+func (d *driver) finishTransaction(ctx context.Context, txn *pfs.Transaction) (*pfs.TransactionInfo, error) {
+	d.initializePachConn()
+	var txnInfo *pfs.TransactionInfo
+	var state *txnState
+	if err := d.newSTMWithTrees(ctx, func(stm col.STM, trees *treeWriter) error {
+		transactions := d.transactions.ReadWrite(stm)
+		txnInfo = &pfs.TransactionInfo{}
+		if err := transactions.Get(txn.ID, txnInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("transaction %s not found", txn.ID)
+			}
+			return err
+		}
+		txnRequests := d.txnRequests.ReadWrite(stm)
+		iter, err := txnRequests.ListPrefix(txn.ID)
+		if err != nil {
+			return err
+		}
+		for {
+			var key string
+			request := &pfs.TransactionRequest{}
+			ok, err := iter.Next(&key, request)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			txnInfo.Requests = append(txnInfo.Requests, request)
+		}
+		state = newTxnState(trees)
+		for i, request := range txnInfo.Requests {
+			if err := d.applyTransactionRequest(ctx, stm, state, request); err != nil {
+				// Quota errors are returned as they are, so that they keep
+				// their status code
+				if pfsserver.IsQuotaExceededErr(err) {
+					return err
+				}
+				return fmt.Errorf("error applying request %d of transaction %s: %v", i, txn.ID, err)
+			}
+		}
+		if err := d.writeTxnRecords(stm, state); err != nil {
+			return err
+		}
+		txnRequests.DeleteAllPrefix(txn.ID)
+		return transactions.Delete(txn.ID)
+	}); err != nil {
+		return nil, err
+	}
+	for _, repo := range state.newRepos {
+		if err := d.setRepoOwner(ctx, repo); err != nil {
+			return nil, err
+		}
+	}
+	return txnInfo, nil
+}
+
+// txnState holds the effects of a transaction's requests that aren't
+// written to its STM until the end of the transaction.
+type txnState struct {
+	// records holds the put file records of the commits that the transaction
+	// writes to, keyed by commit ID
+	records map[string]*txnRecords
+	// trees holds the trees of the commits finished in the transaction, keyed
+	// by commit ID
+	trees map[string]hashtree.HashTree
+	// treeWriter collects the serialized trees of the finished commits, which
+	// are written to the object store outside of the STM
+	treeWriter *treeWriter
+	// newRepos holds the repos created by the transaction, whose ACLs are
+	// created once the transaction has been applied
+	newRepos []*pfs.Repo
+}
+
+// txnRecords holds the put file records of a single commit, keyed by their
+// scratch key, as they look after applying the transaction's requests so far.
+type txnRecords struct {
+	commit  *pfs.Commit
+	records map[string]*pfs.PutFileRecords
+	// existing is the set of keys that were in etcd when the transaction
+	// started
+	existing map[string]bool
+	// dirty is the set of keys that the transaction has written to
+	dirty map[string]bool
+}
+
+func newTxnState(trees *treeWriter) *txnState {
+	return &txnState{
+		records:    make(map[string]*txnRecords),
+		trees:      make(map[string]hashtree.HashTree),
+		treeWriter: trees,
+	}
+}
+
+// upsert applies 'newRecords' to the records at 'key', with the same
+// semantics as upsertPutFileRecords.
+func (r *txnRecords) upsert(key string, newRecords *pfs.PutFileRecords) {
+	existingRecords, ok := r.records[key]
+	if !ok {
+		existingRecords = &pfs.PutFileRecords{}
+	}
+	upsertRecords(existingRecords, newRecords)
+	if replacesFile(newRecords) {
+		// Remove any records for children under this directory, like
+		// DeleteAllPrefix does outside of a transaction (the keys here are
+		// relative to the collection's prefix)
+		childPrefix := col.DeleteAllPrefixKey("", key)
+		for childKey := range r.records {
+			if strings.HasPrefix(childKey, childPrefix) {
+				delete(r.records, childKey)
+			}
+		}
+	}
+	r.records[key] = existingRecords
+	r.dirty[key] = true
+}
+
+// commitRecords returns the put file records of 'commit' in the transaction,
+// reading the commit's existing records through 'stm' the first time.
+func (d *driver) commitRecords(stm col.STM, state *txnState, commit *pfs.Commit) (*txnRecords, error) {
+	if records, ok := state.records[commit.ID]; ok {
+		return records, nil
+	}
+	records := &txnRecords{
+		commit:   commit,
+		records:  make(map[string]*pfs.PutFileRecords),
+		existing: make(map[string]bool),
+		dirty:    make(map[string]bool),
+	}
+	// Reading the open commit makes the STM conflict with any concurrent
+	// PutFile into the commit, which rewrites it (see upsertPutFileRecords),
+	// as the STM would otherwise miss records under new keys
+	var openCommit pfs.Commit
+	if err := d.openCommits.ReadWrite(stm).Get(commit.ID, &openCommit); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrCommitFinished{commit}
+		}
+		return nil, err
+	}
+	iter, err := d.putFileRecords.ReadWrite(stm).ListPrefix(path.Join(commit.Repo.Name, commit.ID))
+	if err != nil {
+		return nil, err
+	}
+	collectionPrefix := d.putFileRecords.Path("") + "/"
+	for {
+		var key string
+		putFileRecords := &pfs.PutFileRecords{}
+		ok, err := iter.Next(&key, putFileRecords)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		key = strings.TrimPrefix(key, collectionPrefix)
+		records.records[key] = putFileRecords
+		records.existing[key] = true
+	}
+	state.records[commit.ID] = records
+	return records, nil
+}
+
+func (d *driver) applyTransactionRequest(ctx context.Context, stm col.STM, state *txnState, request *pfs.TransactionRequest) error {
+	switch {
+	case request.CreateRepo != nil:
+		if err := d.createRepoInSTM(ctx, stm, request.CreateRepo.Repo, request.CreateRepo.Provenance, request.CreateRepo.Description); err != nil {
+			return err
+		}
+		state.newRepos = append(state.newRepos, request.CreateRepo.Repo)
+		return nil
+	case request.StartCommit != nil:
+		startCommit := request.StartCommit
+		return d.makeCommitInSTM(ctx, stm, state.treeWriter, request.Commit, startCommit.Parent, startCommit.Branch, startCommit.Provenance, nil, startCommit.Description)
+	case request.FinishCommit != nil:
+		return d.finishCommitInTransaction(ctx, stm, state, request.FinishCommit.Commit, request.FinishCommit.Description)
+	case request.SetBranch != nil:
+		commit := request.SetBranch.Commit
+		if _, err := d.resolveCommitInSTM(stm, commit); err != nil {
+			return err
+		}
+		return d.setBranchInSTM(stm, commit, request.SetBranch.Branch)
+	case request.DeleteFile != nil:
+		return d.upsertPutFileRecordsInTransaction(ctx, stm, state, request.DeleteFile.File, &pfs.PutFileRecords{Tombstone: true})
+	case request.PutFile != nil:
+		return d.upsertPutFileRecordsInTransaction(ctx, stm, state, request.PutFile, request.PutFileRecords)
+	}
+	return fmt.Errorf("empty transaction request")
+}
+
+func (d *driver) upsertPutFileRecordsInTransaction(ctx context.Context, stm col.STM, state *txnState, file *pfs.File, newRecords *pfs.PutFileRecords) error {
+	commitInfo, err := d.resolveCommitInSTM(stm, file.Commit)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished != nil {
+		return pfsserver.ErrCommitFinished{file.Commit}
+	}
+	records, err := d.commitRecords(stm, state, file.Commit)
+	if err != nil {
+		return err
+	}
+	prefix, err := d.scratchFilePrefix(ctx, file)
+	if err != nil {
+		return err
+	}
+	records.upsert(prefix, newRecords)
+	return nil
+}
+
+// finishCommitInTransaction is the counterpart of finishCommit for commits
+// that are finished in a transaction. The commit's tree is built from the
+// put file records in etcd and those written earlier in the transaction.
+func (d *driver) finishCommitInTransaction(ctx context.Context, stm col.STM, state *txnState, commit *pfs.Commit, description string) error {
+	commitInfo, err := d.resolveCommitInSTM(stm, commit)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished != nil {
+		return fmt.Errorf("commit %s has already been finished", commit.FullID())
+	}
+	if description != "" {
+		commitInfo.Description = description
+	}
+
+	var parentTree hashtree.HashTree
+	if commitInfo.ParentCommit != nil {
+		parentTree = state.trees[commitInfo.ParentCommit.ID]
+	}
+	if parentTree == nil {
+		parentTree, err = d.getTreeForCommit(ctx, commitInfo.ParentCommit)
+		if err != nil {
+			return err
+		}
+	}
+	records, err := d.commitRecords(stm, state, commit)
+	if err != nil {
+		return err
+	}
+	// Apply the records in the same (lexicographical) order as
+	// getTreeForPrefix does
+	var keys []string
+	for key := range records.records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	tree := parentTree.Open()
+	for _, key := range keys {
+		if err := d.applyWrite(d.putFileRecords.Path(key), records.records[key], tree); err != nil {
+			return err
+		}
+	}
+	finishedTree, err := d.setCommitTree(state.treeWriter, commitInfo, tree, parentTree)
+	if err != nil {
+		return err
+	}
+	state.trees[commit.ID] = finishedTree
+
+	headOf, err := d.branchesWithHead(stm, commit)
+	if err != nil {
+		return err
+	}
+	return d.writeFinishedCommit(stm, commitInfo, finishedTree, parentTree, headOf)
+}
+
+// writeTxnRecords writes the put file records of the commits that are still
+// open at the end of the transaction, and removes the scratch space of the
+// commits that were finished in it.
+func (d *driver) writeTxnRecords(stm col.STM, state *txnState) error {
+	recordsCol := d.putFileRecords.ReadWrite(stm)
+	for commitID, records := range state.records {
+		if _, ok := state.trees[commitID]; ok {
+			recordsCol.DeleteAllPrefix(path.Join(records.commit.Repo.Name, commitID))
+			continue
+		}
+		for key := range records.dirty {
+			if putFileRecords, ok := records.records[key]; ok {
+				if err := recordsCol.Put(key, putFileRecords); err != nil {
+					return err
+				}
+			}
+		}
+		// Delete the records that were removed by a tombstone
+		for key := range records.existing {
+			if _, ok := records.records[key]; !ok {
+				if err := recordsCol.Delete(key); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Package audit records mutating PFS, PPS and auth API calls, along with the
+// user that made them, in an append-only log.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+)
+
+// maxEntrySize is the largest entry that Read will parse
+const maxEntrySize = 1024 * 1024
+
+// Log is an append-only audit log.
+type Log interface {
+	// Append adds entry to the end of the log.
+	Append(entry *auth.AuditLogEntry) error
+	// Read returns page 'page' (starting from 0) of the entries recorded at or
+	// after since, oldest first, with pageSize entries per page. It also
+	// returns the number of pages of such entries. pageSize must be positive.
+	Read(since time.Time, page, pageSize int64) ([]*auth.AuditLogEntry, int64, error)
+}
+
+type fileLog struct {
+	path string
+	// mu serializes appends, so that concurrent entries aren't interleaved
+	mu sync.Mutex
+	f  *os.File
+}
+
+// NewFileLog returns a Log that appends entries to the file at path, one JSON
+// object per line. The file (and its parent directory) is created if it
+// doesn't exist. path should be on a persistent volume, as the log is only as
+// durable as the file.
+func NewFileLog(path string) (Log, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileLog{path: path, f: f}, nil
+}
+
+func (l *fileLog) Append(entry *auth.AuditLogEntry) error {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, entry); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// Write the entry with a single call, so that a crash can't leave part of
+	// it in the file
+	_, err := l.f.Write(buf.Bytes())
+	return err
+}
+
+func (l *fileLog) Read(since time.Time, page, pageSize int64) (retEntries []*auth.AuditLogEntry, retTotalPages int64, retErr error) {
+	if pageSize <= 0 {
+		return nil, 0, fmt.Errorf("invalid page size %d, must be positive", pageSize)
+	}
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	// Only the entries in the requested page are kept, so that reading a large
+	// log doesn't hold all of it in memory
+	first, last := page*pageSize, (page+1)*pageSize
+	var matched int64
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxEntrySize)
+	for line := 1; scanner.Scan(); line++ {
+		entry := &auth.AuditLogEntry{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), entry); err != nil {
+			return nil, 0, fmt.Errorf("error parsing line %d of %s: %v", line, l.path, err)
+		}
+		t, err := types.TimestampFromProto(entry.Time)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error parsing line %d of %s: %v", line, l.path, err)
+		}
+		if t.Before(since) {
+			continue
+		}
+		if matched >= first && matched < last {
+			retEntries = append(retEntries, entry)
+		}
+		matched++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+	return retEntries, (matched + pageSize - 1) / pageSize, nil
+}
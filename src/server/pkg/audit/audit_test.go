@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func newTestLog(t *testing.T) Log {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	l, err := NewFileLog(filepath.Join(dir, "audit.log"))
+	require.NoError(t, err)
+	return l
+}
+
+func entryAt(t *testing.T, method string, at time.Time) *auth.AuditLogEntry {
+	ts, err := types.TimestampProto(at)
+	require.NoError(t, err)
+	return &auth.AuditLogEntry{Time: ts, Username: "alice", Method: method}
+}
+
+func TestFileLog(t *testing.T) {
+	l := newTestLog(t)
+	defer os.RemoveAll(filepath.Dir(l.(*fileLog).path))
+
+	// An empty log has no entries
+	entries, totalPages, err := l.Read(time.Time{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+	require.Equal(t, int64(0), totalPages)
+
+	now := time.Now()
+	old := entryAt(t, "/pfs.API/CreateRepo", now.Add(-time.Hour))
+	recent := entryAt(t, "/pfs.API/PutFile", now)
+	recent.Repo = "repo"
+	recent.Error = "some error"
+	require.NoError(t, l.Append(old))
+	require.NoError(t, l.Append(recent))
+
+	entries, totalPages, err = l.Read(time.Time{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*auth.AuditLogEntry{old, recent}, entries)
+	require.Equal(t, int64(1), totalPages)
+	entries, _, err = l.Read(now.Add(-time.Minute), 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*auth.AuditLogEntry{recent}, entries)
+
+	// Reopening the log keeps its entries
+	l2, err := NewFileLog(l.(*fileLog).path)
+	require.NoError(t, err)
+	pipeline := entryAt(t, "/pps.API/CreatePipeline", now)
+	require.NoError(t, l2.Append(pipeline))
+	entries, _, err = l2.Read(time.Time{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+
+	// Entries are split into pages
+	entries, totalPages, err = l2.Read(time.Time{}, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*auth.AuditLogEntry{old, recent}, entries)
+	require.Equal(t, int64(2), totalPages)
+	entries, totalPages, err = l2.Read(time.Time{}, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*auth.AuditLogEntry{pipeline}, entries)
+	require.Equal(t, int64(2), totalPages)
+	entries, _, err = l2.Read(time.Time{}, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+	_, _, err = l2.Read(time.Time{}, 0, 0)
+	require.YesError(t, err)
+}
+
+func TestTarget(t *testing.T) {
+	commit := &pfs.Commit{Repo: &pfs.Repo{Name: "repo"}, ID: "master"}
+	for _, c := range []struct {
+		req      interface{}
+		repo     string
+		pipeline string
+	}{
+		{&pfs.CreateRepoRequest{Repo: commit.Repo}, "repo", ""},
+		{&pfs.FinishCommitRequest{Commit: commit}, "repo", ""},
+		{&pfs.StartCommitRequest{Parent: commit}, "repo", ""},
+		{&pfs.PutFileRequest{File: &pfs.File{Commit: commit, Path: "file"}}, "repo", ""},
+		{&pps.CreatePipelineRequest{Pipeline: &pps.Pipeline{Name: "pipeline"}}, "", "pipeline"},
+		{&auth.SetScopeRequest{Repo: "repo"}, "repo", ""},
+		{&auth.ActivateRequest{}, "", ""},
+		{nil, "", ""},
+	} {
+		repo, pipeline := target(c.req)
+		require.Equal(t, c.repo, repo, "%T", c.req)
+		require.Equal(t, c.pipeline, pipeline, "%T", c.req)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l := newTestLog(t)
+	defer os.RemoveAll(filepath.Dir(l.(*fileLog).path))
+	interceptor := UnaryServerInterceptor(l, func(context.Context) string { return "alice" })
+	call := func(method string, req interface{}, err error) {
+		_, retErr := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, err
+			})
+		require.Equal(t, err, retErr)
+	}
+
+	repo := &pfs.Repo{Name: "repo"}
+	call("/pfs.API/CreateRepo", &pfs.CreateRepoRequest{Repo: repo}, nil)
+	call("/pfs.API/InspectRepo", &pfs.InspectRepoRequest{Repo: repo}, nil)
+	call("/pfs.API/DeleteRepo", &pfs.DeleteRepoRequest{Repo: repo}, errors.New("not authorized"))
+	call("/auth.API/GetCapability", &auth.GetCapabilityRequest{}, nil)
+	call("/pfs.ObjectAPI/InspectObject", &pfs.Object{Hash: "hash"}, nil)
+	call("/pfs.ObjectAPI/DeleteObjects", &pfs.DeleteObjectsRequest{}, nil)
+
+	// Only the mutating calls are recorded
+	entries, _, err := l.Read(time.Time{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+	require.Equal(t, "/pfs.API/CreateRepo", entries[0].Method)
+	require.Equal(t, "alice", entries[0].Username)
+	require.Equal(t, "repo", entries[0].Repo)
+	require.Equal(t, "", entries[0].Error)
+	require.Equal(t, "/pfs.API/DeleteRepo", entries[1].Method)
+	require.Equal(t, "not authorized", entries[1].Error)
+	require.Equal(t, "/pfs.ObjectAPI/DeleteObjects", entries[2].Method)
+}
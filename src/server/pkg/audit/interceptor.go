@@ -0,0 +1,158 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// mutatingMethods are the RPCs that are recorded in the audit log. Calls
+// that only read data aren't recorded.
+var mutatingMethods = map[string]bool{
+	"/pfs.API/CreateRepo":        true,
+	"/pfs.API/DeleteRepo":        true,
+	"/pfs.API/SetQuota":          true,
+	"/pfs.API/StartCommit":       true,
+	"/pfs.API/FinishCommit":      true,
+	"/pfs.API/DeleteCommit":      true,
+	"/pfs.API/SquashCommit":      true,
+	"/pfs.API/RevertCommit":      true,
+	"/pfs.API/MergeBranch":       true,
+	"/pfs.API/BuildCommit":       true,
+	"/pfs.API/SetBranch":         true,
+	"/pfs.API/CreateBranch":      true,
+	"/pfs.API/DeleteBranch":      true,
+	"/pfs.API/PutFile":           true,
+	"/pfs.API/CopyFile":          true,
+	"/pfs.API/CreateSymlink":     true,
+	"/pfs.API/MoveFile":          true,
+	"/pfs.API/DeleteFile":        true,
+	"/pfs.API/DeleteAll":         true,
+	"/pfs.API/StartTransaction":  true,
+	"/pfs.API/FinishTransaction": true,
+	"/pfs.API/DeleteTransaction": true,
+
+	"/pfs.ObjectAPI/PutObject":      true,
+	"/pfs.ObjectAPI/PutObjectSplit": true,
+	"/pfs.ObjectAPI/TagObject":      true,
+	"/pfs.ObjectAPI/DeleteObjects":  true,
+	"/pfs.ObjectAPI/DeleteTags":     true,
+	"/pfs.ObjectAPI/Compact":        true,
+
+	"/pps.API/CreateJob":        true,
+	"/pps.API/DeleteJob":        true,
+	"/pps.API/StopJob":          true,
+	"/pps.API/RestartDatum":     true,
+	"/pps.API/CreatePipeline":   true,
+	"/pps.API/DeletePipeline":   true,
+	"/pps.API/StartPipeline":    true,
+	"/pps.API/StopPipeline":     true,
+	"/pps.API/RerunPipeline":    true,
+	"/pps.API/RollbackPipeline": true,
+	"/pps.API/DeleteAll":        true,
+	"/pps.API/GarbageCollect":   true,
+
+	"/auth.API/Activate":        true,
+	"/auth.API/Deactivate":      true,
+	"/auth.API/ModifyAdmins":    true,
+	"/auth.API/Authenticate":    true,
+	"/auth.API/SetScope":        true,
+	"/auth.API/SetACL":          true,
+	"/auth.API/RevokeAuthToken": true,
+}
+
+// UnaryServerInterceptor records mutating unary RPCs in l. username returns
+// the user that made the call in ctx, or "" if it's unknown.
+func UnaryServerInterceptor(l Log, username func(ctx context.Context) string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !mutatingMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		// Look up the user before the call, since it may change who they are
+		// (e.g. Deactivate)
+		entry := newEntry(username(ctx), info.FullMethod)
+		resp, err := handler(ctx, req)
+		appendEntry(l, entry, req, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records mutating streaming RPCs in l. The target of
+// the call is taken from the first message the client sends.
+func StreamServerInterceptor(l Log, username func(ctx context.Context) string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !mutatingMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		entry := newEntry(username(ss.Context()), info.FullMethod)
+		s := &serverStream{ServerStream: ss}
+		err := handler(srv, s)
+		appendEntry(l, entry, s.first, err)
+		return err
+	}
+}
+
+func newEntry(username string, method string) *auth.AuditLogEntry {
+	entry := &auth.AuditLogEntry{
+		Username: username,
+		Method:   method,
+	}
+	entry.Time, _ = types.TimestampProto(time.Now())
+	return entry
+}
+
+// appendEntry fills in the outcome and target of a call and records it. A
+// failure to record the call is logged, rather than failing the call.
+func appendEntry(l Log, entry *auth.AuditLogEntry, req interface{}, err error) {
+	entry.Repo, entry.Pipeline = target(req)
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if err := l.Append(entry); err != nil {
+		log.Errorf("could not write audit log entry %v: %v", entry, err)
+	}
+}
+
+// target returns the repo and pipeline that req acts on, if any.
+func target(req interface{}) (repo string, pipeline string) {
+	switch r := req.(type) {
+	case interface{ GetRepo() *pfs.Repo }:
+		repo = r.GetRepo().GetName()
+	case interface{ GetCommit() *pfs.Commit }:
+		repo = r.GetCommit().GetRepo().GetName()
+	case interface{ GetParent() *pfs.Commit }:
+		repo = r.GetParent().GetRepo().GetName()
+	case interface{ GetBranch() *pfs.Branch }:
+		repo = r.GetBranch().GetRepo().GetName()
+	case interface{ GetFile() *pfs.File }:
+		repo = r.GetFile().GetCommit().GetRepo().GetName()
+	case interface{ GetDst() *pfs.File }:
+		repo = r.GetDst().GetCommit().GetRepo().GetName()
+	case interface{ GetRepo() string }:
+		repo = r.GetRepo()
+	}
+	if r, ok := req.(interface{ GetPipeline() *pps.Pipeline }); ok {
+		pipeline = r.GetPipeline().GetName()
+	}
+	return repo, pipeline
+}
+
+// serverStream saves the first message received on a grpc.ServerStream
+type serverStream struct {
+	grpc.ServerStream
+	first interface{}
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.first == nil {
+		s.first = m
+	}
+	return err
+}
@@ -6,13 +6,16 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	pb "github.com/golang/groupcache/groupcachepb"
+	"github.com/pachyderm/pachyderm/src/client/health"
 	"github.com/pachyderm/pachyderm/src/client/pkg/shard"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cache/groupcachepb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 
 	"github.com/golang/groupcache"
 	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
 )
 
 // CacheServer serves groupcache requests over grpc.
@@ -20,6 +23,9 @@ type CacheServer interface {
 	groupcachepb.GroupCacheServer
 	shard.Frontend
 	shard.Server
+	// CheckPeers returns an error if any of the pachd nodes that serve
+	// cache shards can't be reached.
+	CheckPeers(ctx context.Context) error
 }
 
 // NewCacheServer creates a new CacheServer.
@@ -101,6 +107,22 @@ func (p *protoGetter) Get(ctx groupcache.Context, in *pb.GetRequest, out *pb.Get
 	return nil
 }
 
+func (s *groupCacheServer) CheckPeers(ctx context.Context) error {
+	conns, err := s.router.GetAllClientConns(s.version)
+	if err != nil {
+		return err
+	}
+	var eg errgroup.Group
+	for _, conn := range conns {
+		conn := conn
+		eg.Go(func() error {
+			_, err := health.NewHealthClient(conn).Health(ctx, &types.Empty{})
+			return err
+		})
+	}
+	return eg.Wait()
+}
+
 func (s *groupCacheServer) AddShard(shard uint64) error {
 	s.mu.Lock()
 	s.localShards[shard] = true
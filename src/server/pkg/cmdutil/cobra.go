@@ -83,6 +83,39 @@ func ParseCommits(args []string) ([]*pfs.Commit, error) {
 	return commits, nil
 }
 
+// ParseBranches takes a slice of arguments of the form "repo/branch" and
+// returns a list of *pfs.Branches
+func ParseBranches(args []string) ([]*pfs.Branch, error) {
+	var branches []*pfs.Branch
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid branch \"%s\": must be of the form repo/branch", arg)
+		}
+		branches = append(branches, &pfs.Branch{
+			Repo: &pfs.Repo{
+				Name: parts[0],
+			},
+			Name: parts[1],
+		})
+	}
+	return branches, nil
+}
+
+// ParseKeyValues takes a slice of arguments of the form "key=value" and
+// returns them as a map
+func ParseKeyValues(args []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid argument \"%s\": must be of the form key=value", arg)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
+}
+
 // RepeatedStringArg is an alias for []string
 type RepeatedStringArg []string
 
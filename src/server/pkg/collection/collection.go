@@ -6,6 +6,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -255,7 +256,63 @@ func (c *readWriteCollection) DeleteAll() {
 }
 
 func (c *readWriteCollection) DeleteAllPrefix(prefix string) {
-	c.stm.DelAll(path.Join(c.prefix, prefix) + "/")
+	c.stm.DelAll(DeleteAllPrefixKey(c.prefix, prefix))
+}
+
+// DeleteAllPrefixKey returns the etcd key prefix of the keys that
+// DeleteAllPrefix(prefix) deletes in the collection whose prefix is
+// 'collectionPrefix'. It always ends with a slash, so that only the keys
+// under 'prefix', and not e.g. the keys under "prefixbar", are deleted.
+func DeleteAllPrefixKey(collectionPrefix string, prefix string) string {
+	return path.Join(collectionPrefix, prefix) + "/"
+}
+
+// listPrefixKey returns the etcd key prefix that ListPrefix(prefix) lists in
+// the collection whose prefix is 'collectionPrefix'. A trailing slash in
+// 'prefix' is kept, so that e.g. "foo/" doesn't match the keys under
+// "foobar/".
+func listPrefixKey(collectionPrefix string, prefix string) string {
+	if prefix == "" {
+		// If we always call join, we'll get rid of the trailing slash we need
+		// on the root collectionPrefix
+		return collectionPrefix
+	}
+	result := filepath.Join(collectionPrefix, prefix)
+	if strings.HasSuffix(prefix, "/") {
+		result += "/"
+	}
+	return result
+}
+
+func (c *readWriteCollection) ListPrefix(prefix string) (Iterator, error) {
+	kvs, err := c.stm.GetPrefix(listPrefixKey(c.prefix, prefix))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(kvs))
+	for key := range kvs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return &stmIterator{keys: keys, kvs: kvs}, nil
+}
+
+// stmIterator iterates over the keys read by readWriteCollection.ListPrefix
+type stmIterator struct {
+	keys []string
+	kvs  map[string]string
+}
+
+func (i *stmIterator) Next(key *string, val proto.Message) (ok bool, retErr error) {
+	if len(i.keys) == 0 {
+		return false, nil
+	}
+	*key = i.keys[0]
+	i.keys = i.keys[1:]
+	if err := proto.Unmarshal([]byte(i.kvs[*key]), val); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 type readWriteIntCollection struct {
@@ -414,12 +471,7 @@ func endKeyFromPrefix(prefix string) string {
 // ListPrefix returns lexigraphically sorted (not sorted by create time)
 // results and returns an iterator that is paginated
 func (c *readonlyCollection) ListPrefix(prefix string) (Iterator, error) {
-	queryPrefix := c.prefix
-	if prefix != "" {
-		// If we always call join, we'll get rid of the trailing slash we need
-		// on the root c.prefix
-		queryPrefix = filepath.Join(c.prefix, prefix)
-	}
+	queryPrefix := listPrefixKey(c.prefix, prefix)
 	// omit sort so that we get results lexigraphically ordered, so that we can paginate properly
 	resp, err := c.etcdClient.Get(c.ctx, queryPrefix, etcd.WithPrefix(), etcd.WithLimit(QueryPaginationLimit))
 	if err != nil {
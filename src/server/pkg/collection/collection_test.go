@@ -295,6 +295,46 @@ func TestMultiIndex(t *testing.T) {
 	require.Equal(t, r2, repo)
 }
 
+func TestListPrefixInSTM(t *testing.T) {
+	etcdClient, err := getEtcdClient()
+	require.NoError(t, err)
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, nil, &pps.JobInfo{}, nil)
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		jobInfos := jobInfos.ReadWrite(stm)
+		for _, id := range []string{"j1", "j2", "j3"} {
+			jobInfos.Put(id, &pps.JobInfo{Job: &pps.Job{id}})
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The STM's own writes are visible to ListPrefix
+	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		rw := jobInfos.ReadWrite(stm)
+		require.NoError(t, rw.Delete("j2"))
+		rw.Put("j4", &pps.JobInfo{Job: &pps.Job{"j4"}})
+		iter, err := rw.ListPrefix("")
+		require.NoError(t, err)
+		var IDs []string
+		var key string
+		jobInfo := new(pps.JobInfo)
+		for {
+			ok, err := iter.Next(&key, jobInfo)
+			require.NoError(t, err)
+			if !ok {
+				break
+			}
+			require.Equal(t, jobInfos.Path(jobInfo.Job.ID), key)
+			IDs = append(IDs, jobInfo.Job.ID)
+		}
+		require.Equal(t, []string{"j1", "j3", "j4"}, IDs)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func getEtcdClient() (*etcd.Client, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   []string{"localhost:32379"},
@@ -18,15 +18,41 @@ package collection
 // not have the DelAll method, which we need.
 
 import (
+	"fmt"
+	"strings"
+
 	v3 "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/context"
 )
 
+var (
+	stmTransactions = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "pachyderm_etcd_stm_transactions_total",
+		Help: "Total number of etcd STM transactions run.",
+	})
+	stmRetries = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "pachyderm_etcd_stm_retries_total",
+		Help: "Total number of etcd STM transaction attempts that conflicted and were retried.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(stmTransactions, stmRetries)
+}
+
 // STM is an interface for software transactional memory.
 type STM interface {
 	// Get returns the value for a key and inserts the key in the txn's read set.
 	// If Get fails, it aborts the transaction with an error, never returning.
 	Get(key string) (string, error)
+	// GetPrefix returns the keys with the given prefix and their values, and
+	// inserts the keys in the txn's read set. Note that only the keys that
+	// are read are guarded against conflicting updates; a key that is
+	// created under the prefix by another txn isn't detected as a conflict.
+	GetPrefix(prefix string) (map[string]string, error)
 	// Put adds a value for a key to the write set.
 	Put(key, val string, opts ...v3.OpOption)
 	// Rev returns the revision of a key in the read set.
@@ -82,6 +108,9 @@ type stmResponse struct {
 }
 
 func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
+	stmTransactions.Inc()
+	span, _ := tracing.StartSpanFromContext(s.Context(), "etcd.STM")
+	defer span.Finish()
 	outc := make(chan stmResponse, 1)
 	go func() {
 		defer func() {
@@ -95,18 +124,21 @@ func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
 			}
 		}()
 		var out stmResponse
-		for {
+		for retries := 0; ; retries++ {
 			s.reset()
 			if out.err = apply(s); out.err != nil {
 				break
 			}
 			if out.resp = s.commit(); out.resp != nil {
+				span.SetTag("retries", fmt.Sprint(retries))
 				break
 			}
+			stmRetries.Inc()
 		}
 		outc <- out
 	}()
 	r := <-outc
+	tracing.SetError(span, r.err)
 	return r.resp, r.err
 }
 
@@ -125,6 +157,10 @@ type stm struct {
 type stmPut struct {
 	val string
 	op  v3.Op
+	// del is set if the key is deleted, and delAll if every key with the
+	// key as a prefix is deleted
+	del    bool
+	delAll bool
 }
 
 func (s *stm) Context() context.Context {
@@ -138,13 +174,67 @@ func (s *stm) Get(key string) (string, error) {
 	return respToValue(key, s.fetch(key))
 }
 
+func (s *stm) GetPrefix(prefix string) (map[string]string, error) {
+	resp, err := s.client.Get(s.ctx, prefix, append([]v3.OpOption{v3.WithPrefix()}, s.getOpts...)...)
+	if err != nil {
+		panic(stmError{err})
+	}
+	return s.addPrefix(prefix, resp), nil
+}
+
+// addPrefix inserts the keys in 'resp' (the result of reading 'prefix') in
+// the read set, and returns them and their values, along with the txn's own
+// writes under 'prefix'.
+func (s *stm) addPrefix(prefix string, resp *v3.GetResponse) map[string]string {
+	result := make(map[string]string)
+	for _, kv := range resp.Kvs {
+		key := string(kv.Key)
+		if _, ok := s.rset[key]; !ok {
+			s.rset[key] = &v3.GetResponse{
+				Header: resp.Header,
+				Kvs:    []*mvccpb.KeyValue{kv},
+				Count:  1,
+			}
+		}
+		// If the key was read earlier, keep returning what was read then
+		if r := s.rset[key]; len(r.Kvs) != 0 {
+			result[key] = string(r.Kvs[0].Value)
+		}
+	}
+	for key, wv := range s.wset {
+		switch {
+		case wv.delAll && strings.HasPrefix(key, prefix):
+			for k := range result {
+				if strings.HasPrefix(k, key) {
+					delete(result, k)
+				}
+			}
+		case wv.delAll && strings.HasPrefix(prefix, key):
+			result = make(map[string]string)
+		}
+	}
+	for key, wv := range s.wset {
+		if !strings.HasPrefix(key, prefix) || wv.delAll {
+			continue
+		}
+		if wv.del {
+			delete(result, key)
+		} else {
+			result[key] = wv.val
+		}
+	}
+	return result
+}
+
 func (s *stm) Put(key, val string, opts ...v3.OpOption) {
-	s.wset[key] = stmPut{val, v3.OpPut(key, val, opts...)}
+	s.wset[key] = stmPut{val: val, op: v3.OpPut(key, val, opts...)}
 }
 
-func (s *stm) Del(key string) { s.wset[key] = stmPut{"", v3.OpDelete(key)} }
+func (s *stm) Del(key string) { s.wset[key] = stmPut{op: v3.OpDelete(key), del: true} }
 
-func (s *stm) DelAll(key string) { s.wset[key] = stmPut{"", v3.OpDelete(key, v3.WithPrefix())} }
+func (s *stm) DelAll(key string) {
+	s.wset[key] = stmPut{op: v3.OpDelete(key, v3.WithPrefix()), delAll: true}
+}
 
 func (s *stm) Rev(key string) int64 {
 	if resp := s.fetch(key); resp != nil && len(resp.Kvs) != 0 {
@@ -224,6 +314,29 @@ func (s *stmSerializable) Get(key string) (string, error) {
 	return respToValue(key, resp)
 }
 
+func (s *stmSerializable) GetPrefix(prefix string) (map[string]string, error) {
+	firstRead := len(s.rset) == 0
+	// Keys read under the prefix come from this read, rather than from the
+	// prefetched values of the previous attempt
+	for key := range s.prefetch {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.prefetch, key)
+		}
+	}
+	resp, err := s.client.Get(s.ctx, prefix, append([]v3.OpOption{v3.WithPrefix()}, s.getOpts...)...)
+	if err != nil {
+		panic(stmError{err})
+	}
+	if firstRead {
+		// txn's base revision is defined by the first read
+		s.getOpts = []v3.OpOption{
+			v3.WithRev(resp.Header.Revision),
+			v3.WithSerializable(),
+		}
+	}
+	return s.addPrefix(prefix, resp), nil
+}
+
 func (s *stmSerializable) Rev(key string) int64 {
 	s.Get(key)
 	return s.stm.Rev(key)
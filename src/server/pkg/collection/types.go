@@ -70,6 +70,11 @@ type ReadWriteCollection interface {
 	Delete(key string) error
 	DeleteAll()
 	DeleteAllPrefix(prefix string)
+	// ListPrefix returns an iterator over the objects whose keys start with
+	// prefix, in lexicographical order. Like the readonly ListPrefix, the
+	// iterator returns fully qualified keys. The objects are read through the
+	// STM, so updates to them conflict with the transaction.
+	ListPrefix(prefix string) (Iterator, error)
 }
 
 // ReadWriteIntCollection is a ReadonlyCollection interface specifically for ints.
@@ -1,5 +1,9 @@
 package dag
 
+import (
+	"sort"
+)
+
 // DAG represents a directected acyclic graph
 type DAG struct {
 	parents  map[string][]string
@@ -32,11 +36,18 @@ func (d *DAG) NewNode(id string, parents []string) {
 	}
 }
 
-// Sorted returns all nodes in a topologically sorted order
+// Sorted returns all nodes in a topologically sorted order. Nodes that
+// aren't ordered by the DAG are visited in lexicographic order, so the result
+// is deterministic.
 func (d *DAG) Sorted() []string {
+	var ids []string
+	for id := range d.parents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
 	seen := make(map[string]bool)
 	var result []string
-	for id := range d.parents {
+	for _, id := range ids {
 		result = append(result, dfs(id, d.parents, seen)...)
 	}
 	return result
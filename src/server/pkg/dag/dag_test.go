@@ -83,3 +83,15 @@ func TestGhosts(t *testing.T) {
 		d.Ghosts(),
 	)
 }
+
+func TestSortedIsDeterministic(t *testing.T) {
+	d := NewDAG(map[string][]string{
+		"c": {},
+		"b": {},
+		"a": {"c"},
+		"d": {"b", "a"},
+	})
+	for i := 0; i < 10; i++ {
+		require.Equal(t, []string{"c", "a", "b", "d"}, d.Sorted())
+	}
+}
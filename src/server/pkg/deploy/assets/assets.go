@@ -48,6 +48,9 @@ var (
 	etcdStorageClassName    = "etcd-storage-class"
 	grpcProxyName           = "grpc-proxy"
 	pachdName               = "pachd"
+	auditLogVolumeName      = "audit-log"
+	auditLogVolumeClaimName = "pachd-audit-log"
+	auditLogMountPath       = "/audit-log"
 
 	trueVal = true
 )
@@ -121,6 +124,10 @@ type AssetOpts struct {
 
 	// NoRBAC, if true, will disable creation of RBAC assets.
 	NoRBAC bool
+
+	// AuditLogVolumeSize is the size of the persistent volume that pachd keeps
+	// its audit log on. If empty, assets.go will choose a default size.
+	AuditLogVolumeSize string
 }
 
 // replicas lets us create a pointer to a non-zero int32 in-line. This is
@@ -135,6 +142,7 @@ func replicas(r int32) *int32 {
 //   opts.PachdCPURequest
 //   opts.EtcdCPURequest
 //   opts.EtcdMemRequest
+//   opts.AuditLogVolumeSize
 // that are unset in 'opts' to the appropriate default ('persistentDiskBackend'
 // just used to determine if this is a local deployment, and if so, make the
 // resource requests smaller)
@@ -177,6 +185,9 @@ func fillDefaultResourceRequests(opts *AssetOpts, persistentDiskBackend backend)
 		if opts.EtcdCPURequest == "" {
 			opts.EtcdCPURequest = "1"
 		}
+		if opts.AuditLogVolumeSize == "" {
+			opts.AuditLogVolumeSize = "10Gi"
+		}
 	}
 }
 
@@ -325,6 +336,30 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 	volume, mount := GetSecretVolumeAndMount(backendEnvVar)
 	volumes = append(volumes, volume)
 	volumeMounts = append(volumeMounts, mount)
+
+	// The audit log is kept on a persistent volume (or on the host, in local
+	// deployments), so that it survives pachd being restarted. The volume can
+	// only be attached to one pod at a time, so the old pachd pod is stopped
+	// before a new one is started.
+	strategy := apps.DeploymentStrategy{}
+	auditLogVolume := v1.Volume{
+		Name: auditLogVolumeName,
+	}
+	if objectStoreBackend == localBackend {
+		auditLogVolume.HostPath = &v1.HostPathVolumeSource{
+			Path: filepath.Join(hostPath, auditLogVolumeName),
+		}
+	} else {
+		auditLogVolume.PersistentVolumeClaim = &v1.PersistentVolumeClaimVolumeSource{
+			ClaimName: auditLogVolumeClaimName,
+		}
+		strategy.Type = apps.RecreateDeploymentStrategyType
+	}
+	volumes = append(volumes, auditLogVolume)
+	volumeMounts = append(volumeMounts, v1.VolumeMount{
+		Name:      auditLogVolumeName,
+		MountPath: auditLogMountPath,
+	})
 	resourceRequirements := v1.ResourceRequirements{
 		Requests: v1.ResourceList{
 			v1.ResourceCPU:    cpu,
@@ -351,12 +386,15 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 			Selector: &metav1.LabelSelector{
 				MatchLabels: labels(pachdName),
 			},
+			Strategy: strategy,
 			Template: v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:   pachdName,
 					Labels: labels(pachdName),
 					Annotations: map[string]string{
 						"iam.amazonaws.com/role": opts.IAMRole,
+						"prometheus.io/scrape":   "true",
+						"prometheus.io/port":     "651",
 					},
 				},
 				Spec: v1.PodSpec{
@@ -430,6 +468,10 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 									Name:  auth.DisableAuthenticationEnvVar,
 									Value: strconv.FormatBool(opts.DisableAuthentication),
 								},
+								{
+									Name:  "AUDIT_LOG_PATH",
+									Value: filepath.Join(auditLogMountPath, "audit.log"),
+								},
 							},
 							Ports: []v1.ContainerPort{
 								{
@@ -511,6 +553,29 @@ func PachdService() *v1.Service {
 	}
 }
 
+// AuditLogVolumeClaim returns a persistent volume claim for the volume that
+// pachd keeps its audit log on.
+func AuditLogVolumeClaim(opts *AssetOpts) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   auditLogVolumeClaimName,
+			Labels: labels(pachdName),
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			Resources: v1.ResourceRequirements{
+				Requests: map[v1.ResourceName]resource.Quantity{
+					"storage": resource.MustParse(opts.AuditLogVolumeSize),
+				},
+			},
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+		},
+	}
+}
+
 // GithookService returns a k8s service that exposes a public IP
 func GithookService() *v1.Service {
 	name := "githook"
@@ -1218,6 +1283,10 @@ func WriteAssets(w io.Writer, opts *AssetOpts, objectStoreBackend backend,
 
 	encoder.Encode(PachdService())
 	fmt.Fprintf(w, "\n")
+	if objectStoreBackend != localBackend {
+		encoder.Encode(AuditLogVolumeClaim(opts))
+		fmt.Fprintf(w, "\n")
+	}
 	encoder.Encode(PachdDeployment(opts, objectStoreBackend, hostPath))
 	fmt.Fprintf(w, "\n")
 	if opts.EnableDash {
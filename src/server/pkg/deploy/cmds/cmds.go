@@ -81,6 +81,7 @@ func DeployCmd(noMetrics *bool) *cobra.Command {
 	var imagePullSecret string
 	var noGuaranteed bool
 	var noRBAC bool
+	var auditLogVolumeSize string
 
 	deployLocal := &cobra.Command{
 		Use:   "local",
@@ -408,6 +409,7 @@ particular backend, run "pachctl deploy storage <backend>"`,
 				Registry:                registry,
 				NoGuaranteed:            noGuaranteed,
 				NoRBAC:                  noRBAC,
+				AuditLogVolumeSize:      auditLogVolumeSize,
 			}
 			return nil
 		}),
@@ -462,6 +464,10 @@ particular backend, run "pachctl deploy storage <backend>"`,
 		"etcd-memory-request", "", "(rarely set) The size of etcd's memory "+
 			"request. Size is in bytes, with SI suffixes (M, K, G, Mi, Ki, Gi, "+
 			"etc).")
+	deploy.PersistentFlags().StringVar(&auditLogVolumeSize,
+		"audit-log-volume-size", "", "(rarely set) The size of the persistent "+
+			"volume that pachd keeps its audit log on. Size is in bytes, with SI "+
+			"suffixes (M, K, G, Mi, Ki, Gi, etc). Not used in local deployments.")
 	return deploy
 }
 
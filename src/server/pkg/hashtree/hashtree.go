@@ -5,11 +5,13 @@ import (
 	"crypto/sha256"
 	"fmt"
 	pathlib "path"
+	"sort"
 	"strings"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 
 	globlib "github.com/gobwas/glob"
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -19,17 +21,20 @@ const (
 	none         nodetype = iota // No file is present at this point in the tree
 	directory                    // The file at this point in the tree is a directory
 	file                         // ... is a regular file
+	symlink                      // ... is a symbolic link
 	unrecognized                 // ... is an an unknown type
 )
 
 func (n *NodeProto) nodetype() nodetype {
 	switch {
-	case n == nil || (n.DirNode == nil && n.FileNode == nil):
+	case n == nil || (n.DirNode == nil && n.FileNode == nil && n.SymlinkNode == nil):
 		return none
 	case n.DirNode != nil:
 		return directory
 	case n.FileNode != nil:
 		return file
+	case n.SymlinkNode != nil:
+		return symlink
 	default:
 		return unrecognized
 	}
@@ -43,6 +48,8 @@ func (n *OpenNode) nodetype() nodetype {
 		return directory
 	case n.FileNode != nil:
 		return file
+	case n.SymlinkNode != nil:
+		return symlink
 	default:
 		return unrecognized
 	}
@@ -56,6 +63,8 @@ func (n nodetype) tostring() string {
 		return "directory"
 	case file:
 		return "file"
+	case symlink:
+		return "symlink"
 	default:
 		return "unknown"
 	}
@@ -187,7 +196,7 @@ func (h *HashTreeProto) FSSize() int64 {
 
 func walk(fs map[string]*NodeProto, path string, f func(string, *NodeProto) error) error {
 	path = clean(path)
-	if node, ok := fs[path]; ok && node.FileNode != nil {
+	if node, ok := fs[path]; ok && node.DirNode == nil {
 		return f(path, node)
 	} else if !ok {
 		return errorf(PathNotFound, "no node at \"%s\"", path)
@@ -226,7 +235,7 @@ func diff(new HashTree, old HashTree, newPath string, oldPath string, recursiveD
 	}
 	children := make(map[string]bool)
 	if newNode != nil {
-		if newNode.FileNode != nil || recursiveDepth == 0 {
+		if newNode.DirNode == nil || recursiveDepth == 0 {
 			if err := f(newPath, newNode, true); err != nil {
 				return err
 			}
@@ -237,7 +246,7 @@ func diff(new HashTree, old HashTree, newPath string, oldPath string, recursiveD
 		}
 	}
 	if oldNode != nil {
-		if oldNode.FileNode != nil || recursiveDepth == 0 {
+		if oldNode.DirNode == nil || recursiveDepth == 0 {
 			if err := f(oldPath, oldNode, false); err != nil {
 				return err
 			}
@@ -276,6 +285,17 @@ type hashtree struct {
 	// changed maps a path P to 'true' if P or one of its children has been
 	// modified in 'fs', and its hash needs to be updated.
 	changed map[string]bool
+
+	// modification, if set, is recorded by Finish() in the nodes that differ
+	// from modification.parent (see SetModified).
+	modification *modification
+}
+
+// modification describes the commit whose contents an OpenHashTree is.
+type modification struct {
+	parent   HashTree
+	commitID string
+	finished *types.Timestamp
 }
 
 // Open returns the hashtree since it's already an OpenHashTree
@@ -387,6 +407,20 @@ func (h *hashtree) canonicalize(path string) error {
 		for _, object := range n.FileNode.Objects {
 			hash.Write([]byte(object.Hash))
 		}
+		// Metadata is hashed in key order, so that the hash is stable
+		if len(n.FileNode.Metadata) > 0 {
+			var keys []string
+			for key := range n.FileNode.Metadata {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				hash.Write([]byte(fmt.Sprintf("%s=%s;", key, n.FileNode.Metadata[key])))
+			}
+		}
+	case symlink:
+		// Compute n.Hash from the link's target
+		hash.Write([]byte(fmt.Sprintf("symlink:%s", n.SymlinkNode.Target)))
 	default:
 		return errorf(Internal,
 			"malformed node at \"%s\" is neither a file nor a directory", path)
@@ -452,7 +486,7 @@ func (h *hashtree) removeFromMap(path string) error {
 	}
 
 	switch n.nodetype() {
-	case file:
+	case file, symlink:
 		delete(h.fs, path)
 	case directory:
 		for _, child := range n.DirNode.Children {
@@ -468,12 +502,69 @@ func (h *hashtree) removeFromMap(path string) error {
 	return nil
 }
 
+// SetModified implements OpenHashTree.SetModified
+func (h *hashtree) SetModified(parent HashTree, commitID string, finished *types.Timestamp) {
+	h.modification = &modification{
+		parent:   parent,
+		commitID: commitID,
+		finished: finished,
+	}
+}
+
+// stampModified records, in each node of 'h', the commit in which the node
+// was last modified: nodes that are identical to the node at the same path in
+// the parent tree keep the parent's modification, and all other nodes are
+// marked as modified by h.modification.commitID.
+func (h *hashtree) stampModified() {
+	m := h.modification
+	for path, node := range h.fs {
+		if m.parent != nil {
+			parentNode, err := m.parent.Get(path)
+			if err == nil && bytes.Equal(parentNode.Hash, node.Hash) {
+				node.ModifiedCommit = parentNode.ModifiedCommit
+				node.Modified = parentNode.Modified
+				continue
+			}
+		}
+		node.ModifiedCommit = m.commitID
+		node.Modified = m.finished
+	}
+}
+
+// RenameModifiedCommit returns a copy of 'h' in which the nodes that were
+// last modified by the commit 'from' are recorded as modified by the commit
+// 'to' instead. It returns nil if none of the nodes of 'h' were modified by
+// 'from'.
+func RenameModifiedCommit(h HashTree, from string, to string) (HashTree, error) {
+	tree, ok := h.(*HashTreeProto)
+	if !ok {
+		return nil, fmt.Errorf("HashTree is of the wrong concrete type")
+	}
+	var result *HashTreeProto
+	for path, node := range tree.Fs {
+		if node.ModifiedCommit != from {
+			continue
+		}
+		if result == nil {
+			result = proto.Clone(tree).(*HashTreeProto)
+		}
+		result.Fs[path].ModifiedCommit = to
+	}
+	if result == nil {
+		return nil, nil
+	}
+	return result, nil
+}
+
 // Finish makes a deep copy of the OpenHashTree, updates all of the hashes in
 // the copy, and returns the copy
 func (h *hashtree) Finish() (HashTree, error) {
 	if err := h.canonicalize(""); err != nil {
 		return nil, err
 	}
+	if h.modification != nil {
+		h.stampModified()
+	}
 	// Create a shallow copy of 'h'
 	innerp := &HashTreeProto{
 		Fs:      h.fs,
@@ -538,6 +629,61 @@ func (h *hashtree) putFile(path string, objects []*pfs.Object, overwriteIndex *p
 	})
 }
 
+// PutFileMetadata sets the given metadata keys on a file (and creates the
+// file if it doesn't exist). Existing keys that aren't in 'metadata' are kept.
+func (h *hashtree) PutFileMetadata(path string, metadata map[string]string) error {
+	if err := h.putFile(path, nil, nil, 0); err != nil {
+		return err
+	}
+	node := h.fs[clean(path)]
+	if node.FileNode.Metadata == nil {
+		node.FileNode.Metadata = make(map[string]string)
+	}
+	for key, value := range metadata {
+		node.FileNode.Metadata[key] = value
+	}
+	return nil
+}
+
+// PutSymlink creates a symbolic link to 'target' at 'path' (or updates the
+// target of the link if one exists).
+func (h *hashtree) PutSymlink(path string, target string) error {
+	path = clean(path)
+
+	// Detect any path conflicts before modifying 'h'
+	if err := h.visit(path, nop); err != nil {
+		return err
+	}
+
+	node, ok := h.fs[path]
+	if !ok {
+		node = &NodeProto{
+			Name:        base(path),
+			SymlinkNode: &SymlinkNodeProto{},
+		}
+		h.fs[path] = node
+	} else if node.nodetype() != symlink {
+		return errorf(PathConflict, "could not create symlink at \"%s\"; a "+
+			"node of type %s is already there", path, node.nodetype().tostring())
+	}
+	node.SymlinkNode.Target = target
+	h.changed[path] = true
+
+	// Add 'path' to parent (if it's new) & mark nodes as 'changed' back to root
+	return h.visit(path, func(node *NodeProto, parent, child string) error {
+		if node == nil {
+			node = &NodeProto{
+				Name:    base(parent),
+				DirNode: &DirectoryNodeProto{},
+			}
+			h.fs[parent] = node
+		}
+		insertStr(&node.DirNode.Children, child)
+		h.changed[parent] = true
+		return nil
+	})
+}
+
 // PutDir creates a directory (or does nothing if one exists).
 func (h *hashtree) PutDir(path string) error {
 	path = clean(path)
@@ -623,10 +769,11 @@ func (h *hashtree) GetOpen(path string) (*OpenNode, error) {
 		return nil, errorf(PathNotFound, "no node at \"%s\"", path)
 	}
 	return &OpenNode{
-		Name:     np.Name,
-		Size:     np.SubtreeSize,
-		FileNode: np.FileNode,
-		DirNode:  np.DirNode,
+		Name:        np.Name,
+		Size:        np.SubtreeSize,
+		FileNode:    np.FileNode,
+		DirNode:     np.DirNode,
+		SymlinkNode: np.SymlinkNode,
 	}, nil
 }
 
@@ -684,6 +831,8 @@ func (h *hashtree) mergeNode(path string, srcs []HashTree) (int64, error) {
 				destNode.DirNode = &DirectoryNodeProto{}
 			} else if n.nodetype() == file {
 				destNode.FileNode = &FileNodeProto{}
+			} else if n.nodetype() == symlink {
+				destNode.SymlinkNode = &SymlinkNodeProto{}
 			} else {
 				return 0, errorf(Internal, "could not merge unrecognized node type at "+
 					"\"%s\", which is neither a file nore a directory", path)
@@ -704,7 +853,16 @@ func (h *hashtree) mergeNode(path string, srcs []HashTree) (int64, error) {
 			// done in canonicalize)
 			destNode.FileNode.Objects = append(destNode.FileNode.Objects,
 				n.FileNode.Objects...)
+			for key, value := range n.FileNode.Metadata {
+				if destNode.FileNode.Metadata == nil {
+					destNode.FileNode.Metadata = make(map[string]string)
+				}
+				destNode.FileNode.Metadata[key] = value
+			}
 			sizeDelta += n.SubtreeSize
+		case symlink:
+			// The last tree's link wins
+			destNode.SymlinkNode.Target = n.SymlinkNode.Target
 		default:
 			return sizeDelta, errorf(Internal, "malformed node at \"%s\" in source "+
 				"hashtree is neither a file nor a directory", path)
@@ -10,6 +10,7 @@
 	It has these top-level messages:
 		FileNodeProto
 		DirectoryNodeProto
+		SymlinkNodeProto
 		NodeProto
 		HashTreeProto
 */
@@ -19,6 +20,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import pfs "github.com/pachyderm/pachyderm/src/client/pfs"
+import google_protobuf1 "github.com/gogo/protobuf/types"
 
 import io "io"
 
@@ -38,6 +40,9 @@ type FileNodeProto struct {
 	// Object references an object in the object store which contains the content
 	// of the data.
 	Objects []*pfs.Object `protobuf:"bytes,4,rep,name=objects" json:"objects,omitempty"`
+	// Metadata holds user-defined key/value attributes of the file (e.g. its
+	// content type or source URL).
+	Metadata map[string]string `protobuf:"bytes,5,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *FileNodeProto) Reset()                    { *m = FileNodeProto{} }
@@ -52,6 +57,13 @@ func (m *FileNodeProto) GetObjects() []*pfs.Object {
 	return nil
 }
 
+func (m *FileNodeProto) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // DirectoryNodeProto is a node corresponding to a directory.
 type DirectoryNodeProto struct {
 	// Children of this directory. Note that paths are relative, so if "/foo/bar"
@@ -74,6 +86,27 @@ func (m *DirectoryNodeProto) GetChildren() []string {
 	return nil
 }
 
+// SymlinkNodeProto is a node corresponding to a symbolic link (which is also
+// a leaf node).
+type SymlinkNodeProto struct {
+	// Target is the path that the link points to. It's either a path in the
+	// same commit (absolute, or relative to the link's directory) or a path in
+	// another repo, of the form pfs://repo/commit/path.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *SymlinkNodeProto) Reset()                    { *m = SymlinkNodeProto{} }
+func (m *SymlinkNodeProto) String() string            { return proto.CompactTextString(m) }
+func (*SymlinkNodeProto) ProtoMessage()               {}
+func (*SymlinkNodeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{2} }
+
+func (m *SymlinkNodeProto) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
 // NodeProto is a node in the file tree (either a file or a directory)
 type NodeProto struct {
 	// Name is the name (not path) of the file/directory (e.g. /lib).
@@ -87,14 +120,20 @@ type NodeProto struct {
 	SubtreeSize int64 `protobuf:"varint,3,opt,name=subtree_size,json=subtreeSize,proto3" json:"subtree_size,omitempty"`
 	// Exactly one of the following fields must be set. The type of this node will
 	// be determined by which field is set.
-	FileNode *FileNodeProto      `protobuf:"bytes,4,opt,name=file_node,json=fileNode" json:"file_node,omitempty"`
-	DirNode  *DirectoryNodeProto `protobuf:"bytes,5,opt,name=dir_node,json=dirNode" json:"dir_node,omitempty"`
+	FileNode    *FileNodeProto      `protobuf:"bytes,4,opt,name=file_node,json=fileNode" json:"file_node,omitempty"`
+	DirNode     *DirectoryNodeProto `protobuf:"bytes,5,opt,name=dir_node,json=dirNode" json:"dir_node,omitempty"`
+	SymlinkNode *SymlinkNodeProto   `protobuf:"bytes,8,opt,name=symlink_node,json=symlinkNode" json:"symlink_node,omitempty"`
+	// modified_commit is the ID of the commit in which this node was last
+	// modified, and modified is the time at which that commit was finished.
+	// They're set by Finish() on trees that are the contents of a commit.
+	ModifiedCommit string                      `protobuf:"bytes,6,opt,name=modified_commit,json=modifiedCommit,proto3" json:"modified_commit,omitempty"`
+	Modified       *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=modified" json:"modified,omitempty"`
 }
 
 func (m *NodeProto) Reset()                    { *m = NodeProto{} }
 func (m *NodeProto) String() string            { return proto.CompactTextString(m) }
 func (*NodeProto) ProtoMessage()               {}
-func (*NodeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{2} }
+func (*NodeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{3} }
 
 func (m *NodeProto) GetName() string {
 	if m != nil {
@@ -131,6 +170,27 @@ func (m *NodeProto) GetDirNode() *DirectoryNodeProto {
 	return nil
 }
 
+func (m *NodeProto) GetSymlinkNode() *SymlinkNodeProto {
+	if m != nil {
+		return m.SymlinkNode
+	}
+	return nil
+}
+
+func (m *NodeProto) GetModifiedCommit() string {
+	if m != nil {
+		return m.ModifiedCommit
+	}
+	return ""
+}
+
+func (m *NodeProto) GetModified() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
 // HashTreeProto is a tree corresponding to the complete file contents of a
 // pachyderm repo at a given commit (based on a Merkle Tree). We store one
 // HashTree for every PFS commit.
@@ -152,7 +212,7 @@ type HashTreeProto struct {
 func (m *HashTreeProto) Reset()                    { *m = HashTreeProto{} }
 func (m *HashTreeProto) String() string            { return proto.CompactTextString(m) }
 func (*HashTreeProto) ProtoMessage()               {}
-func (*HashTreeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{3} }
+func (*HashTreeProto) Descriptor() ([]byte, []int) { return fileDescriptorHashtree, []int{4} }
 
 func (m *HashTreeProto) GetVersion() int32 {
 	if m != nil {
@@ -171,6 +231,7 @@ func (m *HashTreeProto) GetFs() map[string]*NodeProto {
 func init() {
 	proto.RegisterType((*FileNodeProto)(nil), "FileNodeProto")
 	proto.RegisterType((*DirectoryNodeProto)(nil), "DirectoryNodeProto")
+	proto.RegisterType((*SymlinkNodeProto)(nil), "SymlinkNodeProto")
 	proto.RegisterType((*NodeProto)(nil), "NodeProto")
 	proto.RegisterType((*HashTreeProto)(nil), "HashTreeProto")
 }
@@ -201,6 +262,23 @@ func (m *FileNodeProto) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovHashtree(uint64(len(k))) + 1 + len(v) + sovHashtree(uint64(len(v)))
+			i = encodeVarintHashtree(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHashtree(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHashtree(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -237,6 +315,30 @@ func (m *DirectoryNodeProto) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SymlinkNodeProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymlinkNodeProto) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
+	}
+	return i, nil
+}
+
 func (m *NodeProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -289,6 +391,32 @@ func (m *NodeProto) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if len(m.ModifiedCommit) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.ModifiedCommit)))
+		i += copy(dAtA[i:], m.ModifiedCommit)
+	}
+	if m.Modified != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Modified.Size()))
+		n3, err := m.Modified.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.SymlinkNode != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.SymlinkNode.Size()))
+		n4, err := m.SymlinkNode.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -332,11 +460,11 @@ func (m *HashTreeProto) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintHashtree(dAtA, i, uint64(v.Size()))
-				n3, err := v.MarshalTo(dAtA[i:])
+				n5, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n3
+				i += n5
 			}
 		}
 	}
@@ -361,6 +489,14 @@ func (m *FileNodeProto) Size() (n int) {
 			n += 1 + l + sovHashtree(uint64(l))
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHashtree(uint64(len(k))) + 1 + len(v) + sovHashtree(uint64(len(v)))
+			n += mapEntrySize + 1 + sovHashtree(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -376,6 +512,16 @@ func (m *DirectoryNodeProto) Size() (n int) {
 	return n
 }
 
+func (m *SymlinkNodeProto) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	return n
+}
+
 func (m *NodeProto) Size() (n int) {
 	var l int
 	_ = l
@@ -398,6 +544,18 @@ func (m *NodeProto) Size() (n int) {
 		l = m.DirNode.Size()
 		n += 1 + l + sovHashtree(uint64(l))
 	}
+	l = len(m.ModifiedCommit)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if m.Modified != nil {
+		l = m.Modified.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if m.SymlinkNode != nil {
+		l = m.SymlinkNode.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
 	return n
 }
 
@@ -496,6 +654,124 @@ func (m *FileNodeProto) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHashtree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHashtree
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHashtree
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHashtree(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthHashtree
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHashtree(dAtA[iNdEx:])
@@ -596,6 +872,85 @@ func (m *DirectoryNodeProto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SymlinkNodeProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHashtree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymlinkNodeProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymlinkNodeProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHashtree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NodeProto) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -770,6 +1125,101 @@ func (m *NodeProto) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModifiedCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Modified.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SymlinkNode == nil {
+				m.SymlinkNode = &SymlinkNodeProto{}
+			}
+			if err := m.SymlinkNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHashtree(dAtA[iNdEx:])
@@ -1091,28 +1541,37 @@ var (
 func init() { proto.RegisterFile("server/pkg/hashtree/hashtree.proto", fileDescriptorHashtree) }
 
 var fileDescriptorHashtree = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0x75, 0x92, 0xd6, 0x34, 0x37, 0xad, 0x94, 0x51, 0x64, 0xe8, 0x22, 0xc4, 0x80, 0x12, 0x10,
-	0xa6, 0x52, 0x41, 0xc4, 0x9d, 0xa2, 0xc5, 0x95, 0xca, 0xe8, 0xbe, 0xa4, 0xc9, 0x8d, 0x19, 0x1b,
-	0x93, 0x32, 0x93, 0x16, 0xda, 0xef, 0x70, 0xe1, 0x7f, 0xf8, 0x13, 0x2e, 0xfd, 0x04, 0xe9, 0xfb,
-	0x91, 0xc7, 0x4c, 0xd3, 0x96, 0xf2, 0x16, 0x03, 0xe7, 0x9c, 0x7b, 0x2e, 0x9c, 0x7b, 0x06, 0x62,
-	0x8d, 0x6a, 0x8b, 0x6a, 0xba, 0x5e, 0x7d, 0x9f, 0x96, 0xa9, 0x2e, 0x5b, 0x85, 0x78, 0x06, 0x7c,
-	0xad, 0x9a, 0xb6, 0x99, 0x3c, 0xca, 0x2a, 0x89, 0x75, 0x3b, 0x5d, 0x17, 0xda, 0xbc, 0xa3, 0x1a,
-	0xbf, 0x82, 0xd1, 0x5c, 0x56, 0xf8, 0xa9, 0xc9, 0xf1, 0x8b, 0x11, 0xe8, 0x53, 0xf0, 0x9a, 0xe5,
-	0x0f, 0xcc, 0x5a, 0xcd, 0x7a, 0x91, 0x9b, 0x04, 0xb3, 0x80, 0x1b, 0xf7, 0x67, 0xab, 0x89, 0xd3,
-	0x2c, 0x7e, 0x01, 0xf4, 0xbd, 0x54, 0x98, 0xb5, 0x8d, 0xda, 0x5d, 0x96, 0x27, 0x30, 0xc8, 0x4a,
-	0x59, 0xe5, 0x0a, 0x6b, 0xe6, 0x46, 0x6e, 0xe2, 0x8b, 0x33, 0x8f, 0xff, 0x10, 0xf0, 0x2f, 0x4e,
-	0x0a, 0xbd, 0x3a, 0xfd, 0x89, 0x8c, 0x44, 0x24, 0xf1, 0x85, 0xc5, 0x46, 0x33, 0x99, 0x99, 0x13,
-	0x91, 0x64, 0x28, 0x2c, 0xa6, 0x4f, 0x60, 0xa8, 0x37, 0x4b, 0x73, 0xc6, 0x42, 0xcb, 0x3d, 0x32,
-	0x37, 0x22, 0x89, 0x2b, 0x82, 0x4e, 0xfb, 0x2a, 0xf7, 0x48, 0x9f, 0x83, 0x5f, 0xc8, 0x0a, 0x17,
-	0x75, 0x93, 0x23, 0xeb, 0x45, 0x24, 0x09, 0x66, 0x0f, 0xf8, 0xd5, 0x51, 0x62, 0x50, 0x74, 0x94,
-	0x72, 0x18, 0xe4, 0x52, 0x1d, 0xbd, 0x7d, 0xeb, 0x7d, 0xc8, 0xef, 0x1e, 0x22, 0xbc, 0x5c, 0x2a,
-	0xc3, 0xe2, 0x5f, 0x04, 0x46, 0x1f, 0x53, 0x5d, 0x7e, 0x53, 0xd8, 0x25, 0x67, 0xe0, 0x6d, 0x51,
-	0x69, 0xd9, 0xd4, 0x36, 0x7c, 0x5f, 0x9c, 0x28, 0x7d, 0x06, 0x4e, 0xa1, 0x99, 0x63, 0x5b, 0x7b,
-	0xcc, 0xaf, 0xb6, 0xf8, 0x5c, 0x7f, 0xa8, 0x5b, 0xb5, 0x13, 0x4e, 0xa1, 0x27, 0x6f, 0xc1, 0xeb,
-	0x28, 0x1d, 0x83, 0xbb, 0xc2, 0x5d, 0xd7, 0x82, 0x81, 0x34, 0x82, 0xfe, 0x36, 0xad, 0x36, 0x68,
-	0x5b, 0x08, 0x66, 0xc0, 0x2f, 0xa1, 0x8e, 0x83, 0x37, 0xce, 0x6b, 0xf2, 0x6e, 0xfc, 0xf7, 0x10,
-	0x92, 0x7f, 0x87, 0x90, 0xfc, 0x3f, 0x84, 0xe4, 0xf7, 0x4d, 0x78, 0x6f, 0x79, 0xdf, 0xfe, 0xe7,
-	0xcb, 0xdb, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0x83, 0xf2, 0x7a, 0x0b, 0x02, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0x36, 0xc9, 0x76, 0x9b, 0x9e, 0xb4, 0x6b, 0x1d, 0x97, 0x25, 0x14, 0xa9, 0x31, 0xa0, 0x16,
+	0x85, 0xa9, 0x54, 0x91, 0x45, 0xaf, 0xfc, 0x2b, 0xde, 0xf8, 0x43, 0x76, 0xef, 0x4b, 0xda, 0x9c,
+	0xb4, 0x63, 0x93, 0x4c, 0x99, 0x99, 0x16, 0xba, 0xcf, 0xe1, 0x85, 0x2f, 0xe1, 0x7b, 0x88, 0x57,
+	0x3e, 0x82, 0xd4, 0x17, 0x91, 0x4c, 0x92, 0xc6, 0x2a, 0x5e, 0x04, 0xce, 0xf7, 0x9d, 0x6f, 0x4e,
+	0xce, 0x7c, 0xf3, 0x81, 0x2f, 0x51, 0x6c, 0x50, 0x0c, 0x57, 0xcb, 0xf9, 0x70, 0x11, 0xca, 0x85,
+	0x12, 0x88, 0xfb, 0x82, 0xae, 0x04, 0x57, 0xbc, 0x77, 0x3a, 0x4b, 0x18, 0x66, 0x6a, 0xb8, 0x8a,
+	0x65, 0xfe, 0x95, 0xec, 0xed, 0x39, 0xe7, 0xf3, 0x04, 0x87, 0x1a, 0x4d, 0xd7, 0xf1, 0x50, 0xb1,
+	0x14, 0xa5, 0x0a, 0xd3, 0x55, 0x21, 0xf0, 0xbf, 0x1a, 0xd0, 0x19, 0xb3, 0x04, 0xdf, 0xf3, 0x08,
+	0x3f, 0xe6, 0x0c, 0xb9, 0x0b, 0x4d, 0x3e, 0xfd, 0x84, 0x33, 0x25, 0xdd, 0x23, 0xcf, 0x1a, 0x38,
+	0x23, 0x87, 0xe6, 0xf3, 0x3e, 0x68, 0x2e, 0xa8, 0x7a, 0xe4, 0x1c, 0xec, 0x14, 0x55, 0x18, 0x85,
+	0x2a, 0x74, 0x1b, 0x5a, 0x77, 0x8b, 0x1e, 0x0c, 0xa2, 0xef, 0xca, 0xf6, 0x9b, 0x4c, 0x89, 0x6d,
+	0xb0, 0x57, 0xf7, 0x9e, 0x43, 0xe7, 0xa0, 0x45, 0xba, 0x60, 0x2d, 0x71, 0xeb, 0x1a, 0x9e, 0x31,
+	0x68, 0x05, 0x79, 0x49, 0x4e, 0xa1, 0xb1, 0x09, 0x93, 0x35, 0xba, 0xa6, 0xe6, 0x0a, 0xf0, 0xcc,
+	0x3c, 0x37, 0xfc, 0x47, 0x40, 0x5e, 0x33, 0x81, 0x33, 0xc5, 0xc5, 0xb6, 0xde, 0xb9, 0x07, 0xf6,
+	0x6c, 0xc1, 0x92, 0x48, 0x60, 0xe6, 0x5a, 0x9e, 0x35, 0x68, 0x05, 0x7b, 0xec, 0x3f, 0x80, 0xee,
+	0xc5, 0x36, 0x4d, 0x58, 0xb6, 0xac, 0xf5, 0x67, 0x70, 0xac, 0x42, 0x31, 0x47, 0x55, 0xfe, 0xb4,
+	0x44, 0xfe, 0x77, 0x13, 0x5a, 0xb5, 0x8a, 0xc0, 0x51, 0x16, 0xa6, 0x58, 0x6a, 0x74, 0x9d, 0x73,
+	0xb9, 0xf1, 0x7a, 0xb1, 0x76, 0xa0, 0x6b, 0x72, 0x07, 0xda, 0x72, 0x3d, 0xcd, 0xdf, 0x62, 0x22,
+	0xd9, 0x15, 0xba, 0x96, 0x67, 0x0c, 0xac, 0xc0, 0x29, 0xb9, 0x0b, 0x76, 0x85, 0xe4, 0x21, 0xb4,
+	0x62, 0x96, 0xe0, 0x24, 0xe3, 0x11, 0xba, 0x47, 0x9e, 0x31, 0x70, 0x46, 0x27, 0x87, 0x76, 0x05,
+	0x76, 0x5c, 0x42, 0x42, 0xc1, 0x8e, 0x98, 0x28, 0xb4, 0x0d, 0xad, 0xbd, 0x49, 0xff, 0xbd, 0x74,
+	0xd0, 0x8c, 0x98, 0xd0, 0xfa, 0xfb, 0x70, 0x3d, 0xe5, 0x11, 0x8b, 0x19, 0x46, 0x93, 0x19, 0x4f,
+	0x53, 0xa6, 0xdc, 0x63, 0xbd, 0xf2, 0x49, 0x45, 0xbf, 0xd2, 0x2c, 0x79, 0x0a, 0x76, 0xc5, 0xb8,
+	0x4d, 0x3d, 0xb8, 0x47, 0x8b, 0x80, 0xd0, 0x2a, 0x20, 0xf4, 0xb2, 0x0a, 0x48, 0xb0, 0xd7, 0x92,
+	0x27, 0xd0, 0x96, 0x85, 0x85, 0xc5, 0x52, 0xb6, 0x3e, 0x7b, 0x83, 0xfe, 0xed, 0x6b, 0xe0, 0xc8,
+	0x9a, 0xf1, 0x3f, 0x1b, 0xd0, 0x79, 0x1b, 0xca, 0xc5, 0xa5, 0xc0, 0xd2, 0x50, 0x17, 0x9a, 0x1b,
+	0x14, 0x92, 0xf1, 0x4c, 0x7b, 0xda, 0x08, 0x2a, 0x48, 0xee, 0x81, 0x19, 0x4b, 0xd7, 0xd4, 0x39,
+	0x3a, 0xa3, 0x07, 0xa7, 0xe8, 0x58, 0x16, 0x09, 0x32, 0x63, 0xd9, 0x7b, 0x01, 0xcd, 0xb1, 0xfc,
+	0x5f, 0x6a, 0xbc, 0x3f, 0x53, 0xe3, 0x8c, 0x80, 0xd6, 0x8b, 0xd5, 0x09, 0x7a, 0xd9, 0xfd, 0xb6,
+	0xeb, 0x1b, 0x3f, 0x76, 0x7d, 0xe3, 0xe7, 0xae, 0x6f, 0x7c, 0xf9, 0xd5, 0xbf, 0x36, 0x3d, 0xd6,
+	0x97, 0x7f, 0xfc, 0x7b, 0x00, 0xce, 0x5c, 0x77, 0xb3, 0x67, 0x03, 0x00, 0x00,
 }
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
@@ -468,6 +469,128 @@ func TestGlobFile(t *testing.T) {
 	}
 }
 
+func TestPutFileMetadata(t *testing.T) {
+	h := NewHashTree()
+	h.PutFile("/foo", obj(`hash:"20c27"`), 1)
+	h1 := finish(t, h)
+
+	// Setting metadata changes the file's hash but not its size
+	require.NoError(t, h.PutFileMetadata("/foo", map[string]string{"a": "1", "b": "2"}))
+	h2 := finish(t, h)
+	require.NotEqual(t, h1.Fs["/foo"].Hash, h2.Fs["/foo"].Hash)
+	require.Equal(t, int64(1), h2.Fs["/foo"].SubtreeSize)
+	require.Equal(t, "1", h2.Fs["/foo"].FileNode.Metadata["a"])
+
+	// Existing keys are kept
+	require.NoError(t, h.PutFileMetadata("/foo", map[string]string{"b": "3"}))
+	h3 := finish(t, h)
+	require.Equal(t, "1", h3.Fs["/foo"].FileNode.Metadata["a"])
+	require.Equal(t, "3", h3.Fs["/foo"].FileNode.Metadata["b"])
+
+	// Metadata can't be set on a directory
+	h.PutFile("/dir/bar", obj(`hash:"ebc57"`), 1)
+	require.YesError(t, h.PutFileMetadata("/dir", map[string]string{"a": "1"}))
+
+	// Metadata is merged
+	other := NewHashTree()
+	other.PutFileMetadata("/foo", map[string]string{"c": "4"})
+	h4 := h3.Open()
+	require.NoError(t, h4.Merge(finish(t, other)))
+	h5 := finish(t, h4)
+	require.Equal(t, 3, len(h5.Fs["/foo"].FileNode.Metadata))
+}
+
+func TestSymlink(t *testing.T) {
+	h := NewHashTree()
+	require.NoError(t, h.PutSymlink("/dir/link", "../foo"))
+	h1 := finish(t, h)
+	require.Equal(t, "../foo", h1.Fs["/dir/link"].SymlinkNode.Target)
+	require.Equal(t, []string{"link"}, h1.Fs["/dir"].DirNode.Children)
+
+	// Changing the target changes the link's hash
+	require.NoError(t, h.PutSymlink("/dir/link", "/bar"))
+	h2 := finish(t, h)
+	require.NotEqual(t, h1.Fs["/dir/link"].Hash, h2.Fs["/dir/link"].Hash)
+	require.NotEqual(t, h1.Fs[""].Hash, h2.Fs[""].Hash)
+
+	// Symlinks can't replace other nodes, or have children
+	h.PutFile("/foo", obj(`hash:"20c27"`), 1)
+	require.YesError(t, h.PutSymlink("/foo", "/bar"))
+	require.YesError(t, h.PutSymlink("/dir", "/bar"))
+	require.YesError(t, h.PutFile("/dir/link/baz", obj(`hash:"ebc57"`), 1))
+
+	// Symlinks are walked as leaves and can be deleted
+	h3 := finish(t, h)
+	var walked []string
+	require.NoError(t, h3.Walk("/dir", func(path string, node *NodeProto) error {
+		walked = append(walked, path)
+		return nil
+	}))
+	require.Equal(t, []string{"/dir/link", "/dir"}, walked)
+	require.NoError(t, h.DeleteFile("/dir/link"))
+	h4 := finish(t, h)
+	_, ok := h4.Fs["/dir/link"]
+	require.False(t, ok)
+}
+
+func TestSetModified(t *testing.T) {
+	h := NewHashTree()
+	h.PutFile("/foo", obj(`hash:"20c27"`), 1)
+	h.PutFile("/dir/bar", obj(`hash:"ebc57"`), 1)
+	t1 := &types.Timestamp{Seconds: 1}
+	h.SetModified(nil, "c1", t1)
+	h1 := finish(t, h)
+	for _, path := range []string{"", "/foo", "/dir", "/dir/bar"} {
+		require.Equal(t, "c1", h1.Fs[path].ModifiedCommit)
+		require.Equal(t, t1, h1.Fs[path].Modified)
+	}
+
+	// Only the nodes that changed (and their ancestors) are marked as modified
+	// by the new commit
+	h = h1.Open()
+	h.PutFile("/dir/buzz", obj(`hash:"8e02c"`), 1)
+	t2 := &types.Timestamp{Seconds: 2}
+	h.SetModified(h1, "c2", t2)
+	h2 := finish(t, h)
+	require.Equal(t, "c1", h2.Fs["/foo"].ModifiedCommit)
+	require.Equal(t, "c1", h2.Fs["/dir/bar"].ModifiedCommit)
+	require.Equal(t, "c2", h2.Fs["/dir/buzz"].ModifiedCommit)
+	require.Equal(t, "c2", h2.Fs["/dir"].ModifiedCommit)
+	require.Equal(t, "c2", h2.Fs[""].ModifiedCommit)
+	require.Equal(t, t2, h2.Fs["/dir"].Modified)
+
+	// Trees built from scratch get their modifications from the parent
+	h = NewHashTree()
+	h.PutFile("/foo", obj(`hash:"20c27"`), 1)
+	h.SetModified(h2, "c3", &types.Timestamp{Seconds: 3})
+	h3 := finish(t, h)
+	require.Equal(t, "c1", h3.Fs["/foo"].ModifiedCommit)
+	require.Equal(t, "c3", h3.Fs[""].ModifiedCommit)
+}
+
+func TestRenameModifiedCommit(t *testing.T) {
+	h := NewHashTree()
+	h.PutFile("/foo", obj(`hash:"20c27"`), 1)
+	h.SetModified(nil, "c1", &types.Timestamp{Seconds: 1})
+	h1 := finish(t, h)
+	h = h1.Open()
+	h.PutFile("/bar", obj(`hash:"ebc57"`), 1)
+	h.SetModified(h1, "c2", &types.Timestamp{Seconds: 2})
+	h2 := finish(t, h)
+
+	renamed, err := RenameModifiedCommit(h2, "c1", "c3")
+	require.NoError(t, err)
+	require.Equal(t, "c3", renamed.(*HashTreeProto).Fs["/foo"].ModifiedCommit)
+	require.Equal(t, "c2", renamed.(*HashTreeProto).Fs["/bar"].ModifiedCommit)
+	// The original tree is left alone
+	require.Equal(t, "c1", h2.Fs["/foo"].ModifiedCommit)
+
+	// Trees without any nodes modified by the commit aren't copied
+	renamed, err = RenameModifiedCommit(h2, "c4", "c3")
+	require.NoError(t, err)
+	require.Nil(t, renamed)
+}
+
 func TestMerge(t *testing.T) {
 	lTmp, rTmp := NewHashTree(), NewHashTree()
 	lTmp.PutFile("/foo-left", obj(`hash:"20c27"`), 1)
@@ -2,6 +2,8 @@ package hashtree
 
 import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+
+	"github.com/gogo/protobuf/types"
 )
 
 // ErrCode identifies different kinds of errors returned by methods in
@@ -84,8 +86,9 @@ type OpenNode struct {
 	Name string
 	Size int64
 
-	FileNode *FileNodeProto
-	DirNode  *DirectoryNodeProto
+	FileNode    *FileNodeProto
+	DirNode     *DirectoryNodeProto
+	SymlinkNode *SymlinkNodeProto
 }
 
 // OpenHashTree is like HashTree, except that it can be modified. Once an
@@ -109,6 +112,15 @@ type OpenHashTree interface {
 	// the size of the objects removed.
 	PutFileOverwrite(path string, objects []*pfs.Object, overwriteIndex *pfs.OverwriteIndex, sizeDelta int64) error
 
+	// PutFileMetadata sets the given metadata keys on a file (and creates the
+	// file if it doesn't exist). Existing keys that aren't in 'metadata' are
+	// kept.
+	PutFileMetadata(path string, metadata map[string]string) error
+
+	// PutSymlink creates a symbolic link to 'target' at 'path' (or updates the
+	// target of the link if one exists).
+	PutSymlink(path string, target string) error
+
 	// PutDir creates a directory (or does nothing if one exists).
 	PutDir(path string) error
 
@@ -121,6 +133,13 @@ type OpenHashTree interface {
 	// state of the tree you should Finish and then Open the tree.
 	Merge(trees ...HashTree) error
 
+	// SetModified marks this tree as the contents of the commit 'commitID',
+	// which was finished at 'finished'. Finish() then records, in every node
+	// that differs from the node at the same path in 'parent' (the contents of
+	// the commit's parent, which may be nil), that it was last modified in
+	// 'commitID'. The other nodes keep the modification recorded in 'parent'.
+	SetModified(parent HashTree, commitID string, finished *types.Timestamp)
+
 	// Finish makes a deep copy of the OpenHashTree, updates all of the hashes and
 	// node size metadata in the copy, and returns the copy
 	Finish() (HashTree, error)
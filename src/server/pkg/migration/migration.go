@@ -19,6 +19,12 @@ func init() {
 		migrationRoutines[version] = make(map[string]migrationFunc)
 		migrationRoutines[version]["1.5.0"] = oneFourToOneFive
 	}
+
+	// Register 1.6.* -> 1.7.0
+	for _, version := range allPatchVersions("1.6") {
+		migrationRoutines[version] = make(map[string]migrationFunc)
+		migrationRoutines[version]["1.7.0"] = oneSixToOneSeven
+	}
 }
 
 // Given a macro.micro version number like 1.4, returns a slice of version
@@ -0,0 +1,150 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	log "github.com/sirupsen/logrus"
+)
+
+// oneSixToOneSeven migrates the branches collection, which used to store the
+// head commit of each branch and now stores a BranchInfo (the head along with
+// the branch's provenance and subvenance). Each pipeline's input branches are
+// put in the provenance of its output branch, as CreatePipeline now does, so
+// that PFS opens output commits for the pipeline's jobs. Branches that have
+// already been migrated are left alone, so the migration can safely be run
+// more than once.
+func oneSixToOneSeven(etcdAddress, pfsPrefix, ppsPrefix string) error {
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{fmt.Sprintf("%s:2379", etcdAddress)},
+		DialOptions: client.EtcdDialOptions(),
+	})
+	if err != nil {
+		return fmt.Errorf("error constructing etcdClient: %v", err)
+	}
+
+	// branchInfos holds every branch, keyed by branchKey. The keys of the
+	// branches that the migration changes are added to 'changed'.
+	branchInfos := make(map[string]*pfs.BranchInfo)
+	changed := make(map[string]bool)
+	prefix := path.Join(pfsPrefix, branchesPrefix) + "/"
+	resp, err := etcdClient.Get(context.Background(), prefix, etcd.WithPrefix())
+	if err != nil {
+		return fmt.Errorf("error getting %v: %v", prefix, err)
+	}
+	for _, kv := range resp.Kvs {
+		// Branch keys have the form <prefix><repo>/<branch>
+		repo, branch := path.Split(strings.TrimPrefix(string(kv.Key), prefix))
+		repo = strings.TrimSuffix(repo, "/")
+		key := path.Join(repo, branch)
+		if branchInfo, ok := branchInfoFromHead(repo, branch, kv.Value); ok {
+			branchInfos[key] = branchInfo
+			changed[key] = true
+			continue
+		}
+		branchInfo := &pfs.BranchInfo{}
+		if err := proto.Unmarshal(kv.Value, branchInfo); err != nil {
+			return fmt.Errorf("error unmarshalling branch %v: %v", string(kv.Key), err)
+		}
+		branchInfos[key] = branchInfo
+	}
+
+	prefix = path.Join(ppsPrefix, pipelinesPrefix) + "/"
+	resp, err = etcdClient.Get(context.Background(), prefix, etcd.WithPrefix())
+	if err != nil {
+		return fmt.Errorf("error getting %v: %v", prefix, err)
+	}
+	for _, kv := range resp.Kvs {
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := proto.Unmarshal(kv.Value, pipelineInfo); err != nil {
+			return fmt.Errorf("error unmarshalling pipeline %v: %v", string(kv.Key), err)
+		}
+		for _, key := range setPipelineProvenance(branchInfos, pipelineInfo) {
+			changed[key] = true
+		}
+	}
+
+	for key := range changed {
+		bytes, err := proto.Marshal(branchInfos[key])
+		if err != nil {
+			return fmt.Errorf("error marshalling branch %v: %v", key, err)
+		}
+		etcdKey := path.Join(pfsPrefix, branchesPrefix, key)
+		if _, err := etcdClient.Put(context.Background(), etcdKey, string(bytes)); err != nil {
+			return fmt.Errorf("error putting branch %v: %v", etcdKey, err)
+		}
+	}
+	log.Infof("finished migrating branches")
+	return nil
+}
+
+// setPipelineProvenance puts the input branches of the pipeline described by
+// 'pipelineInfo' in the provenance of its output branch, and adds the output
+// branch to the subvenance of the input branches. 'branchInfos' is keyed by
+// branchKey, and branches that aren't in it are added. It returns the keys of
+// the branches that it changed.
+func setPipelineProvenance(branchInfos map[string]*pfs.BranchInfo, pipelineInfo *pps.PipelineInfo) []string {
+	getBranchInfo := func(branch *pfs.Branch) *pfs.BranchInfo {
+		key := branchKey(branch)
+		if branchInfos[key] == nil {
+			branchInfos[key] = &pfs.BranchInfo{Name: branch.Name}
+		}
+		return branchInfos[key]
+	}
+	outputBranch := pipelineInfo.OutputBranch
+	if outputBranch == "" {
+		outputBranch = "master"
+	}
+	output := client.NewBranch(pipelineInfo.Pipeline.Name, outputBranch)
+	outputInfo := getBranchInfo(output)
+	var result []string
+	for _, input := range pps.InputBranches(pipelineInfo.Input) {
+		if !hasBranch(outputInfo.Provenance, input) {
+			outputInfo.Provenance = append(outputInfo.Provenance, input)
+			result = append(result, branchKey(output))
+		}
+		inputInfo := getBranchInfo(input)
+		if !hasBranch(inputInfo.Subvenance, output) {
+			inputInfo.Subvenance = append(inputInfo.Subvenance, output)
+			result = append(result, branchKey(input))
+		}
+	}
+	return result
+}
+
+func branchKey(branch *pfs.Branch) string {
+	return path.Join(branch.Repo.Name, branch.Name)
+}
+
+func hasBranch(branches []*pfs.Branch, branch *pfs.Branch) bool {
+	for _, b := range branches {
+		if branchKey(b) == branchKey(branch) {
+			return true
+		}
+	}
+	return false
+}
+
+// branchInfoFromHead converts 'value', the head commit that used to be stored
+// for 'branch' in 'repo', to a BranchInfo. It returns false if 'value' isn't
+// such a commit, which is the case for branches that have been migrated.
+func branchInfoFromHead(repo string, branch string, value []byte) (*pfs.BranchInfo, bool) {
+	head := &pfs.Commit{}
+	if err := proto.Unmarshal(value, head); err != nil {
+		return nil, false
+	}
+	// A BranchInfo may decode as a commit, but it won't have a commit ID (a
+	// UUID without dashes) in the branch's own repo
+	if head.Repo == nil || head.Repo.Name != repo || len(head.ID) != 32 {
+		return nil, false
+	}
+	return &pfs.BranchInfo{Name: branch, Head: head}, true
+}
@@ -0,0 +1,75 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestBranchInfoFromHead(t *testing.T) {
+	head := client.NewCommit("repo", uuid.NewWithoutDashes())
+	value, err := proto.Marshal(head)
+	require.NoError(t, err)
+	branchInfo, ok := branchInfoFromHead("repo", "master", value)
+	require.True(t, ok)
+	require.Equal(t, &pfs.BranchInfo{Name: "master", Head: head}, branchInfo)
+
+	// Migrated branches are left alone
+	value, err = proto.Marshal(branchInfo)
+	require.NoError(t, err)
+	_, ok = branchInfoFromHead("repo", "master", value)
+	require.False(t, ok)
+	value, err = proto.Marshal(&pfs.BranchInfo{
+		Name:       "master",
+		Provenance: []*pfs.Branch{client.NewBranch("input", "master")},
+	})
+	require.NoError(t, err)
+	_, ok = branchInfoFromHead("repo", "master", value)
+	require.False(t, ok)
+}
+
+func TestSetPipelineProvenance(t *testing.T) {
+	head := client.NewCommit("pipeline", uuid.NewWithoutDashes())
+	branchInfos := map[string]*pfs.BranchInfo{
+		"input/master":    {Name: "master"},
+		"pipeline/master": {Name: "master", Head: head},
+	}
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline: &pps.Pipeline{Name: "pipeline"},
+		Input: &pps.Input{Cross: []*pps.Input{
+			{Atom: &pps.AtomInput{Repo: "input", Branch: "master", Glob: "/*"}},
+			{Cron: &pps.CronInput{Repo: "pipeline_tick"}},
+		}},
+	}
+	changed := setPipelineProvenance(branchInfos, pipelineInfo)
+	require.Equal(t, []string{"pipeline/master", "input/master", "pipeline/master", "pipeline_tick/master"}, changed)
+
+	output := client.NewBranch("pipeline", "master")
+	require.Equal(t, &pfs.BranchInfo{
+		Name:       "master",
+		Head:       head,
+		Provenance: []*pfs.Branch{client.NewBranch("input", "master"), client.NewBranch("pipeline_tick", "master")},
+	}, branchInfos["pipeline/master"])
+	require.Equal(t, &pfs.BranchInfo{Name: "master", Subvenance: []*pfs.Branch{output}}, branchInfos["input/master"])
+	// Input branches that didn't exist are created
+	require.Equal(t, &pfs.BranchInfo{Name: "master", Subvenance: []*pfs.Branch{output}}, branchInfos["pipeline_tick/master"])
+
+	// Migrating the pipeline again doesn't change anything
+	require.Equal(t, 0, len(setPipelineProvenance(branchInfos, pipelineInfo)))
+}
+
+func TestSetPipelineProvenanceOutputBranch(t *testing.T) {
+	branchInfos := make(map[string]*pfs.BranchInfo)
+	setPipelineProvenance(branchInfos, &pps.PipelineInfo{
+		Pipeline:     &pps.Pipeline{Name: "pipeline"},
+		OutputBranch: "out",
+		Input:        &pps.Input{Atom: &pps.AtomInput{Repo: "input", Branch: "dev", Glob: "/"}},
+	})
+	require.Equal(t, []*pfs.Branch{client.NewBranch("input", "dev")}, branchInfos["pipeline/out"].Provenance)
+	require.Equal(t, []*pfs.Branch{client.NewBranch("pipeline", "out")}, branchInfos["input/dev"].Subvenance)
+}
@@ -0,0 +1,104 @@
+package obj
+
+import (
+	"io"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	bytesRead = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "pachyderm_object_store_read_bytes_total",
+		Help: "Total number of bytes read from object storage.",
+	})
+	bytesWritten = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "pachyderm_object_store_written_bytes_total",
+		Help: "Total number of bytes written to object storage.",
+	})
+	opErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_object_store_errors_total",
+		Help: "Total number of failed object storage operations, excluding errors for objects that don't exist.",
+	}, []string{"op"})
+)
+
+func init() {
+	prometheus.MustRegister(bytesRead, bytesWritten, opErrors)
+}
+
+// NewMeteredClient wraps c so that the bytes it reads and writes, and the
+// errors it returns, are exported as Prometheus metrics.
+func NewMeteredClient(c Client) Client {
+	return &meteredClient{c}
+}
+
+type meteredClient struct {
+	Client
+}
+
+func (c *meteredClient) Writer(name string) (io.WriteCloser, error) {
+	w, err := c.Client.Writer(name)
+	if err != nil {
+		c.observeError("write", err)
+		return nil, err
+	}
+	return &meteredWriter{WriteCloser: w, c: c}, nil
+}
+
+func (c *meteredClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	r, err := c.Client.Reader(name, offset, size)
+	if err != nil {
+		c.observeError("read", err)
+		return nil, err
+	}
+	return &meteredReader{ReadCloser: r, c: c}, nil
+}
+
+func (c *meteredClient) Delete(name string) error {
+	err := c.Client.Delete(name)
+	c.observeError("delete", err)
+	return err
+}
+
+func (c *meteredClient) Walk(prefix string, fn func(name string) error) error {
+	err := c.Client.Walk(prefix, fn)
+	c.observeError("walk", err)
+	return err
+}
+
+// observeError counts err against op unless it's nil or an expected error.
+func (c *meteredClient) observeError(op string, err error) {
+	if err == nil || err == io.EOF || c.IsNotExist(err) || c.IsIgnorable(err) {
+		return
+	}
+	opErrors.WithLabelValues(op).Inc()
+}
+
+type meteredWriter struct {
+	io.WriteCloser
+	c *meteredClient
+}
+
+func (w *meteredWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteCloser.Write(p)
+	bytesWritten.Add(float64(n))
+	w.c.observeError("write", err)
+	return n, err
+}
+
+func (w *meteredWriter) Close() error {
+	err := w.WriteCloser.Close()
+	w.c.observeError("write", err)
+	return err
+}
+
+type meteredReader struct {
+	io.ReadCloser
+	c *meteredClient
+}
+
+func (r *meteredReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	bytesRead.Add(float64(n))
+	r.c.observeError("read", err)
+	return n, err
+}
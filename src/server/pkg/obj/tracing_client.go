@@ -0,0 +1,96 @@
+package obj
+
+import (
+	"fmt"
+	"io"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+	"golang.org/x/net/context"
+)
+
+// NewTracingClient wraps c so that each of its operations is recorded as a
+// span, as a child of the span carried by ctx. It returns c unchanged if ctx
+// doesn't carry a span (e.g. because tracing is disabled).
+func NewTracingClient(ctx context.Context, c Client) Client {
+	if opentracing.SpanFromContext(ctx) == nil {
+		return c
+	}
+	return &tracingClient{Client: c, ctx: ctx}
+}
+
+type tracingClient struct {
+	Client
+	ctx context.Context
+}
+
+func (c *tracingClient) Writer(name string) (io.WriteCloser, error) {
+	span, _ := tracing.StartSpanFromContext(c.ctx, "obj.Writer", "object", name)
+	w, err := c.Client.Writer(name)
+	if err != nil {
+		tracing.SetError(span, err)
+		span.Finish()
+		return nil, err
+	}
+	// The span covers the whole write, and ends when w is closed
+	return &tracingWriter{WriteCloser: w, span: span}, nil
+}
+
+func (c *tracingClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	span, _ := tracing.StartSpanFromContext(c.ctx, "obj.Reader", "object", name,
+		"offset", fmt.Sprint(offset), "size", fmt.Sprint(size))
+	r, err := c.Client.Reader(name, offset, size)
+	if err != nil {
+		tracing.SetError(span, err)
+		span.Finish()
+		return nil, err
+	}
+	// The span covers the whole read, and ends when r is closed
+	return &tracingReader{ReadCloser: r, span: span}, nil
+}
+
+func (c *tracingClient) Delete(name string) error {
+	span, _ := tracing.StartSpanFromContext(c.ctx, "obj.Delete", "object", name)
+	defer span.Finish()
+	err := c.Client.Delete(name)
+	tracing.SetError(span, err)
+	return err
+}
+
+func (c *tracingClient) Walk(prefix string, fn func(name string) error) error {
+	span, _ := tracing.StartSpanFromContext(c.ctx, "obj.Walk", "prefix", prefix)
+	defer span.Finish()
+	err := c.Client.Walk(prefix, fn)
+	tracing.SetError(span, err)
+	return err
+}
+
+func (c *tracingClient) Exists(name string) bool {
+	span, _ := tracing.StartSpanFromContext(c.ctx, "obj.Exists", "object", name)
+	defer span.Finish()
+	return c.Client.Exists(name)
+}
+
+type tracingWriter struct {
+	io.WriteCloser
+	span opentracing.Span
+}
+
+func (w *tracingWriter) Close() error {
+	err := w.WriteCloser.Close()
+	tracing.SetError(w.span, err)
+	w.span.Finish()
+	return err
+}
+
+type tracingReader struct {
+	io.ReadCloser
+	span opentracing.Span
+}
+
+func (r *tracingReader) Close() error {
+	err := r.ReadCloser.Close()
+	tracing.SetError(r.span, err)
+	r.span.Finish()
+	return err
+}
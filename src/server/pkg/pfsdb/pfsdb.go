@@ -18,6 +18,8 @@ const (
 	commitsPrefix        = "/commits"
 	branchesPrefix       = "/branches"
 	openCommitsPrefix    = "/openCommits"
+	transactionsPrefix   = "/transactions"
+	txnRequestsPrefix    = "/transactionRequests"
 )
 
 var (
@@ -75,7 +77,7 @@ func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Colle
 		etcdClient,
 		path.Join(etcdPrefix, branchesPrefix, repo),
 		nil,
-		&pfs.Commit{},
+		&pfs.BranchInfo{},
 		func(key string) error {
 			if len(key) == uuid.UUIDWithoutDashesLength {
 				return fmt.Errorf("branch name cannot be of the same length as commit IDs")
@@ -95,3 +97,27 @@ func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 		nil,
 	)
 }
+
+// Transactions returns a collection of open transactions
+func Transactions(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, transactionsPrefix),
+		nil,
+		&pfs.TransactionInfo{},
+		nil,
+	)
+}
+
+// TransactionRequests returns a collection of the requests that have been
+// added to open transactions. A request's key is the ID of its transaction
+// followed by its (zero-padded) position in the transaction.
+func TransactionRequests(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, txnRequestsPrefix),
+		nil,
+		&pfs.TransactionRequest{},
+		nil,
+	)
+}
@@ -2,6 +2,7 @@
 package ppsdb
 
 import (
+	"fmt"
 	"path"
 
 	etcd "github.com/coreos/etcd/clientv3"
@@ -11,8 +12,9 @@ import (
 )
 
 const (
-	pipelinesPrefix = "/pipelines"
-	jobsPrefix      = "/jobs"
+	pipelinesPrefix        = "/pipelines"
+	pipelineVersionsPrefix = "/pipeline_versions"
+	jobsPrefix             = "/jobs"
 )
 
 var (
@@ -43,6 +45,33 @@ func Pipelines(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	)
 }
 
+// PipelineVersions returns a Collection of every version of each pipeline's
+// spec, keyed by PipelineVersionKey
+func PipelineVersions(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, pipelineVersionsPrefix),
+		[]col.Index{},
+		&pps.PipelineInfo{},
+		nil,
+	)
+}
+
+// PipelineVersionKey returns the key of a version of a pipeline in the
+// PipelineVersions collection. All of a pipeline's versions share the prefix
+// PipelineVersionsPrefix(pipelineName).
+func PipelineVersionKey(pipelineName string, version uint64) string {
+	return fmt.Sprintf("%s%d", PipelineVersionsPrefix(pipelineName), version)
+}
+
+// PipelineVersionsPrefix returns the prefix of the keys of a pipeline's
+// versions in the PipelineVersions collection. It ends with a "/", so that
+// it doesn't match the versions of other pipelines whose names start with
+// 'pipelineName'.
+func PipelineVersionsPrefix(pipelineName string) string {
+	return pipelineName + "/"
+}
+
 // Jobs returns a Collection of jobs
 func Jobs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	return col.NewCollection(
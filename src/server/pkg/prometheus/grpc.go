@@ -0,0 +1,70 @@
+// Package prometheus provides gRPC interceptors that export the count and
+// latency of the RPCs served by pachd and the workers as Prometheus metrics.
+// The metrics are registered with the default registry of
+// github.com/prometheus/client_golang, which promhttp.Handler serves.
+package prometheus
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var (
+	grpcHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_grpc_server_handled_total",
+		Help: "Total number of RPCs completed on the server, regardless of success or failure.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+	grpcHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "pachyderm_grpc_server_handling_seconds",
+		Help: "Time taken by the server to complete RPCs.",
+	}, []string{"grpc_service", "grpc_method"})
+)
+
+func init() {
+	prometheus.MustRegister(grpcHandled, grpcHandlingSeconds)
+}
+
+// UnaryServerInterceptor records the count and latency of unary RPCs.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the count and latency of streaming RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeRPC(info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
+
+// observeRPC records an RPC that returned 'err'. Handlers often return the
+// errors of the RPCs they make in turn, which the client scrubs (see
+// grpcutil.ScrubGRPC), so the code is read with grpcutil.Code, which still
+// finds the code of a scrubbed error.
+func observeRPC(fullMethod string, err error, duration time.Duration) {
+	service, method := splitMethodName(fullMethod)
+	grpcHandled.WithLabelValues(service, method, grpcutil.Code(err).String()).Inc()
+	grpcHandlingSeconds.WithLabelValues(service, method).Observe(duration.Seconds())
+}
+
+// splitMethodName splits a full method name of the form
+// "/package.Service/Method" into its service and method.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
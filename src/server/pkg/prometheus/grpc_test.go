@@ -0,0 +1,40 @@
+package prometheus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pfs.API/InspectRepo"}
+	call := func(err error) {
+		_, retErr := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		})
+		require.Equal(t, err, retErr)
+	}
+	call(nil)
+	call(nil)
+	call(errors.New("failed"))
+	require.Equal(t, float64(2), testutil.ToFloat64(grpcHandled.WithLabelValues("pfs.API", "InspectRepo", "OK")))
+	require.Equal(t, float64(1), testutil.ToFloat64(grpcHandled.WithLabelValues("pfs.API", "InspectRepo", "Unknown")))
+
+	// The code of an error that was scrubbed by the client is still recorded
+	call(grpcutil.ScrubGRPC(status.Error(codes.NotFound, "not found")))
+	require.Equal(t, float64(1), testutil.ToFloat64(grpcHandled.WithLabelValues("pfs.API", "InspectRepo", "NotFound")))
+}
+
+func TestSplitMethodName(t *testing.T) {
+	service, method := splitMethodName("/pfs.API/PutFile")
+	require.Equal(t, "pfs.API", service)
+	require.Equal(t, "PutFile", method)
+}
@@ -2,6 +2,7 @@
 package sync
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path"
@@ -143,8 +144,15 @@ func (p *Puller) makeFile(path string, f func(io.Writer) error) (retErr error) {
 // mutually exclusive with pipes.
 // tree is a hashtree to mirror the pulled content into (it may be left nil)
 // treeRoot is the root the data is mirrored to within tree
+// Symlinks are pulled as copies of what they point to.
 func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, file string,
 	pipes bool, emptyFiles bool, concurrency int, tree hashtree.OpenHashTree, treeRoot string) error {
+	return p.pull(client, root, repo, commit, file, pipes, emptyFiles, concurrency, tree, treeRoot, 0)
+}
+
+// pull is Pull, where depth is the number of symlinks followed to get to file.
+func (p *Puller) pull(client *pachclient.APIClient, root string, repo, commit, file string,
+	pipes bool, emptyFiles bool, concurrency int, tree hashtree.OpenHashTree, treeRoot string, depth int) error {
 	limiter := limit.New(concurrency)
 	var eg errgroup.Group
 	if err := client.Walk(repo, commit, file, func(fileInfo *pfs.FileInfo) error {
@@ -152,6 +160,17 @@ func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, f
 		if err != nil {
 			return err
 		}
+		if fileInfo.FileType == pfs.FileType_SYMLINK {
+			if depth >= pfs.MaxSymlinkDepth {
+				return fmt.Errorf("too many levels of symbolic links pulling %s", fileInfo.File.Path)
+			}
+			target, err := pfs.ResolveSymlink(fileInfo.File, fileInfo.SymlinkTarget)
+			if err != nil {
+				return err
+			}
+			return p.pull(client, filepath.Join(root, basepath), target.Commit.Repo.Name, target.Commit.ID, target.Path,
+				pipes, emptyFiles, concurrency, tree, path.Join(treeRoot, basepath), depth+1)
+		}
 		if tree != nil {
 			treePath := path.Join(treeRoot, basepath)
 			if fileInfo.FileType == pfs.FileType_DIR {
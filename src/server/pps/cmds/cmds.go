@@ -12,8 +12,10 @@ import (
 	"os"
 	"os/user"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"text/template"
 
 	"github.com/fsouza/go-dockerclient"
 	"github.com/gogo/protobuf/jsonpb"
@@ -23,6 +25,7 @@ import (
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	"github.com/pachyderm/pachyderm/src/server/pps/pretty"
+	"github.com/pachyderm/pachyderm/src/server/worker"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
 )
@@ -219,11 +222,28 @@ $ pachctl list-job -p foo bar/YYY
 	}
 	var pageSize int64
 	var page int64
+	var datumPipelinePath string
 	listDatum := &cobra.Command{
 		Use:   "list-datum job-id",
 		Short: "Return the datums in a job.",
-		Long:  "Return the datums in a job.",
-		Run: cmdutil.RunBoundedArgs(1, 1, func(args []string) error {
+		Long: `Return the datums in a job.
+
+If a pipeline spec is passed with -f instead of a job, the datums that the
+pipeline would process are listed, using the current heads of its input
+branches. No job is run.
+
+Examples:
+
+` + codestart + `# list the datums processed by a job
+$ pachctl list-datum 5f93d03b65fa421996185e53f7f8b1e4
+
+# preview the datums a pipeline would process, before creating it
+$ pachctl list-datum -f pipeline.json
+` + codeend,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			if (len(args) == 1) == (datumPipelinePath != "") {
+				return fmt.Errorf("must specify exactly one of a job-id or a pipeline spec with -f")
+			}
 			client, err := pachdclient.NewOnUserMachine(metrics, "user")
 			if err != nil {
 				return err
@@ -234,9 +254,40 @@ $ pachctl list-job -p foo bar/YYY
 			if page < 0 {
 				return fmt.Errorf("page must be zero or positive")
 			}
-			resp, err := client.ListDatum(args[0], pageSize, page)
-			if err != nil {
-				return err
+			var resp *ppsclient.ListDatumResponse
+			if datumPipelinePath != "" {
+				cfgReader, err := newPipelineManifestReader(datumPipelinePath, nil)
+				if err != nil {
+					return err
+				}
+				request, err := cfgReader.nextCreatePipelineRequest()
+				if err != nil {
+					return err
+				}
+				if request.Input == nil {
+					return fmt.Errorf("pipeline spec has no input")
+				}
+				// Cron repos are named after the pipeline that creates them
+				ppsclient.VisitInput(request.Input, func(input *ppsclient.Input) {
+					if input.Cron != nil && input.Cron.Repo == "" && request.Pipeline != nil {
+						input.Cron.Repo = fmt.Sprintf("%s_%s", request.Pipeline.Name, input.Cron.Name)
+					}
+				})
+				// If the pipeline exists, its datums are listed with the IDs its
+				// workers would give them
+				if request.Pipeline != nil {
+					resp, err = client.ListDatumInputForPipeline(request.Pipeline.Name, request.Input, pageSize, page)
+				} else {
+					resp, err = client.ListDatumInput(request.Input, pageSize, page)
+				}
+				if err != nil {
+					return err
+				}
+			} else {
+				resp, err = client.ListDatum(args[0], pageSize, page)
+				if err != nil {
+					return err
+				}
 			}
 			if raw {
 				for _, datumInfo := range resp.DatumInfos {
@@ -247,14 +298,22 @@ $ pachctl list-job -p foo bar/YYY
 				return nil
 			}
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
-			pretty.PrintDatumInfoHeader(writer)
-			for _, datumInfo := range resp.DatumInfos {
-				pretty.PrintDatumInfo(writer, datumInfo)
+			if datumPipelinePath != "" {
+				pretty.PrintDatumDataHeader(writer)
+				for _, datumInfo := range resp.DatumInfos {
+					pretty.PrintDatumData(writer, datumInfo)
+				}
+			} else {
+				pretty.PrintDatumInfoHeader(writer)
+				for _, datumInfo := range resp.DatumInfos {
+					pretty.PrintDatumInfo(writer, datumInfo)
+				}
 			}
 			return writer.Flush()
 		}),
 	}
 	rawFlag(listDatum)
+	listDatum.Flags().StringVarP(&datumPipelinePath, "file", "f", "", "The pipeline spec whose input should be evaluated instead of a job's, it can be a url or local file. - reads from stdin.")
 	listDatum.Flags().Int64Var(&pageSize, "pageSize", 0, "Specify the number of results sent back in a single page")
 	listDatum.Flags().Int64Var(&page, "page", 0, "Specify the page of results to send")
 
@@ -382,12 +441,26 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	var username string
 	var password string
 	var pipelinePath string
+	var templateArgs []string
 	createPipeline := &cobra.Command{
 		Use:   "create-pipeline -f pipeline.json",
 		Short: "Create a new pipeline.",
-		Long:  fmt.Sprintf("Create a new pipeline from a %s", pipelineSpec),
+		Long: fmt.Sprintf(`Create a new pipeline from a %s
+
+The spec can be a Go template, which is rendered with the arguments passed
+with --arg. The template and its arguments are stored in the pipeline.
+
+Examples:
+
+`+codestart+`# create a pipeline from a template, replacing {{.repo}} with "images"
+$ pachctl create-pipeline -f template.json --arg repo=images
+`+codeend, pipelineSpec),
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			cfgReader, err := newPipelineManifestReader(pipelinePath)
+			argMap, err := cmdutil.ParseKeyValues(templateArgs)
+			if err != nil {
+				return err
+			}
+			cfgReader, err := newPipelineManifestReader(pipelinePath, argMap)
 			if err != nil {
 				return err
 			}
@@ -424,6 +497,7 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
 	createPipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	createPipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
+	addTemplateArgFlag(createPipeline, &templateArgs)
 
 	var reprocess bool
 	updatePipeline := &cobra.Command{
@@ -431,7 +505,11 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 		Short: "Update an existing Pachyderm pipeline.",
 		Long:  fmt.Sprintf("Update a Pachyderm pipeline with a new %s", pipelineSpec),
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			cfgReader, err := newPipelineManifestReader(pipelinePath)
+			argMap, err := cmdutil.ParseKeyValues(templateArgs)
+			if err != nil {
+				return err
+			}
+			cfgReader, err := newPipelineManifestReader(pipelinePath, argMap)
 			if err != nil {
 				return err
 			}
@@ -471,7 +549,9 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	updatePipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	addTemplateArgFlag(updatePipeline, &templateArgs)
 
+	var pipelineVersion uint64
 	inspectPipeline := &cobra.Command{
 		Use:   "inspect-pipeline pipeline-name",
 		Short: "Return info about a pipeline.",
@@ -481,7 +561,12 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if err != nil {
 				return err
 			}
-			pipelineInfo, err := client.InspectPipeline(args[0])
+			var pipelineInfo *ppsclient.PipelineInfo
+			if pipelineVersion != 0 {
+				pipelineInfo, err = client.InspectPipelineVersion(args[0], pipelineVersion)
+			} else {
+				pipelineInfo, err = client.InspectPipeline(args[0])
+			}
 			if err != nil {
 				return err
 			}
@@ -495,6 +580,100 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 		}),
 	}
 	rawFlag(inspectPipeline)
+	inspectPipeline.Flags().Uint64Var(&pipelineVersion, "version", 0, "Return info about a previous version of the pipeline, rather than the current one.")
+
+	listPipelineVersions := &cobra.Command{
+		Use:   "list-pipeline-versions pipeline-name",
+		Short: "Return info about all versions of a pipeline.",
+		Long:  "Return info about all versions of a pipeline, newest first.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			pipelineInfos, err := client.ListPipelineVersions(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, pipelineInfo := range pipelineInfos {
+					if err := marshaller.Marshal(os.Stdout, pipelineInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintPipelineVersionHeader(writer)
+			for _, pipelineInfo := range pipelineInfos {
+				pretty.PrintPipelineVersion(writer, pipelineInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	rawFlag(listPipelineVersions)
+
+	diffPipeline := &cobra.Command{
+		Use:   "diff-pipeline pipeline-name from-version [to-version]",
+		Short: "Show the differences between two versions of a pipeline's spec.",
+		Long: `Show the differences between two versions of a pipeline's spec.
+
+If to-version is omitted, from-version is compared against the current version of the pipeline.
+
+Examples:
+
+` + codestart + `# show what changed between version 1 and version 2 of pipeline "foo"
+$ pachctl diff-pipeline foo 1 2
+
+# show what changed between version 1 and the current version of pipeline "foo"
+$ pachctl diff-pipeline foo 1
+` + codeend,
+		Run: cmdutil.RunBoundedArgs(2, 3, func(args []string) error {
+			fromVersion, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %q: %v", args[1], err)
+			}
+			var toVersion uint64
+			if len(args) == 3 {
+				toVersion, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid version %q: %v", args[2], err)
+				}
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			from, err := client.InspectPipelineVersion(args[0], fromVersion)
+			if err != nil {
+				return err
+			}
+			to, err := client.InspectPipelineVersion(args[0], toVersion)
+			if err != nil {
+				return err
+			}
+			return pretty.PrintPipelineSpecDiff(os.Stdout, from, to)
+		}),
+	}
+
+	var rollbackReprocess bool
+	rollbackPipeline := &cobra.Command{
+		Use:   "rollback-pipeline pipeline-name version",
+		Short: "Roll a pipeline back to a previous version of its spec.",
+		Long:  "Roll a pipeline back to a previous version of its spec. The rollback is recorded as a new version of the pipeline.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			version, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %q: %v", args[1], err)
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			return client.RollbackPipeline(args[0], version, rollbackReprocess)
+		}),
+	}
+	rollbackPipeline.Flags().BoolVar(&rollbackReprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
 
 	listPipeline := &cobra.Command{
 		Use:   "list-pipeline",
@@ -527,6 +706,48 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 	rawFlag(listPipeline)
 
+	var dagFormat string
+	drawDAG := &cobra.Command{
+		Use:   "draw-dag [repo-name]",
+		Short: "Draw the graph of repos and pipelines.",
+		Long: `Draw the graph of repos and pipelines, built from repo provenance and pipeline inputs. Pipelines are annotated with their state, the state of their last job and their job counts.
+
+If repo-name is given, only that repo and its ancestors and descendants are drawn.
+
+Examples:
+
+` + codestart + `# print the graph as a tree
+$ pachctl draw-dag
+
+# render the graph of everything upstream and downstream of repo "foo" with Graphviz
+$ pachctl draw-dag foo --format dot | dot -Tpng >dag.png
+` + codeend,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			var repoName string
+			if len(args) > 0 {
+				repoName = args[0]
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			nodes, err := client.InspectDAG(repoName)
+			if err != nil {
+				return err
+			}
+			switch dagFormat {
+			case "tree":
+				return pretty.PrintDAGTree(os.Stdout, nodes)
+			case "dot":
+				return pretty.PrintDAGDot(os.Stdout, nodes)
+			case "json":
+				return marshaller.Marshal(os.Stdout, &ppsclient.DAGInfo{Nodes: nodes})
+			}
+			return fmt.Errorf("unrecognized format %q, must be one of tree, dot or json", dagFormat)
+		}),
+	}
+	drawDAG.Flags().StringVar(&dagFormat, "format", "tree", "Output format, one of tree, dot (Graphviz) or json.")
+
 	var all bool
 	var deleteJobs bool
 	var deleteRepo bool
@@ -658,6 +879,70 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 	runPipeline.Flags().StringVarP(&specPath, "file", "f", "", "The file containing the run-pipeline spec, - reads from stdin.")
 
+	var inputDir string
+	var outputDir string
+	var scratchDir string
+	runLocal := &cobra.Command{
+		Use:   "run-local -f pipeline.json -o output-dir",
+		Short: "Run a pipeline's user code on this machine.",
+		Long: `Run a pipeline's user code on this machine, without Kubernetes.
+
+Every datum of the pipeline is downloaded to a local scratch directory and
+processed by running the pipeline's transform as a local process. Inputs are
+read from pachd at the heads of their branches, or, if --input-dir is set,
+from a local directory whose subdirectories stand in for the input repos.
+The merged output of all datums is written to --output, nothing is committed
+to the pipeline's output repo.
+
+Since /pfs isn't mounted, references to /pfs in the transform's cmd and stdin
+are rewritten to point at each datum's directory, user code should reference
+its inputs through those or through the input environment variables.
+
+Examples:
+
+` + codestart + `# Run the pipeline in pipeline.json against the data in pachd:
+$ pachctl run-local -f pipeline.json -o out
+
+# Run it against local data, where ./data/images stands in for the
+# pipeline's input repo "images":
+$ pachctl run-local -f pipeline.json --input-dir data -o out
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if outputDir == "" {
+				return fmt.Errorf("an output directory must be specified with --output")
+			}
+			argMap, err := cmdutil.ParseKeyValues(templateArgs)
+			if err != nil {
+				return err
+			}
+			cfgReader, err := newPipelineManifestReader(pipelinePath, argMap)
+			if err != nil {
+				return err
+			}
+			request, err := cfgReader.nextCreatePipelineRequest()
+			if err != nil {
+				return err
+			}
+			var client *pachdclient.APIClient
+			if inputDir == "" {
+				client, err = pachdclient.NewOnUserMachine(metrics, "user")
+				if err != nil {
+					return fmt.Errorf("error connecting to pachd: %v", err)
+				}
+			}
+			return worker.RunLocal(context.Background(), client, request, &worker.LocalOptions{
+				InputDir:   inputDir,
+				OutputDir:  outputDir,
+				ScratchDir: scratchDir,
+			})
+		}),
+	}
+	runLocal.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The file containing the pipeline, it can be a url or local file. - reads from stdin.")
+	runLocal.Flags().StringVar(&inputDir, "input-dir", "", "A local directory to read the pipeline's inputs from instead of pachd, each of its subdirectories stands in for the input repo of the same name.")
+	runLocal.Flags().StringVarP(&outputDir, "output", "o", "", "The directory to write the pipeline's output to, it must be empty.")
+	addTemplateArgFlag(runLocal, &templateArgs)
+	runLocal.Flags().StringVar(&scratchDir, "scratch-dir", "", "The directory datums are downloaded to while they're processed, defaults to the OS's temp directory.")
+
 	var result []*cobra.Command
 	result = append(result, job)
 	result = append(result, inspectJob)
@@ -673,10 +958,15 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	result = append(result, updatePipeline)
 	result = append(result, inspectPipeline)
 	result = append(result, listPipeline)
+	result = append(result, listPipelineVersions)
+	result = append(result, diffPipeline)
+	result = append(result, rollbackPipeline)
+	result = append(result, drawDAG)
 	result = append(result, deletePipeline)
 	result = append(result, startPipeline)
 	result = append(result, stopPipeline)
 	result = append(result, runPipeline)
+	result = append(result, runLocal)
 	return result, nil
 }
 
@@ -705,11 +995,15 @@ func (arr ByCreationTime) Less(i, j int) bool {
 // pipelineManifestReader helps with unmarshalling pipeline configs from JSON. It's used by
 // create-pipeline and update-pipeline
 type pipelineManifestReader struct {
-	buf     bytes.Buffer
-	decoder *json.Decoder
+	buf      bytes.Buffer
+	decoder  *json.Decoder
+	template *ppsclient.PipelineTemplate
 }
 
-func newPipelineManifestReader(path string) (result *pipelineManifestReader, retErr error) {
+// newPipelineManifestReader reads the pipeline specs at 'path'. If 'args' is
+// non-empty the file is a Go template, which is rendered with 'args' before
+// it's decoded.
+func newPipelineManifestReader(path string, args map[string]string) (result *pipelineManifestReader, retErr error) {
 	result = new(pipelineManifestReader)
 	var pipelineReader io.Reader
 	if path == "-" {
@@ -738,10 +1032,47 @@ func newPipelineManifestReader(path string) (result *pipelineManifestReader, ret
 
 		pipelineReader = io.TeeReader(strings.NewReader(string(rawBytes)), &result.buf)
 	}
+	if len(args) > 0 {
+		rawBytes, err := ioutil.ReadAll(pipelineReader)
+		if err != nil {
+			return nil, err
+		}
+		rendered, err := renderPipelineTemplate(string(rawBytes), args)
+		if err != nil {
+			return nil, err
+		}
+		result.template = &ppsclient.PipelineTemplate{
+			Template: string(rawBytes),
+			Args:     args,
+		}
+		result.buf.Reset()
+		pipelineReader = io.TeeReader(strings.NewReader(rendered), &result.buf)
+	}
 	result.decoder = json.NewDecoder(pipelineReader)
 	return result, nil
 }
 
+// renderPipelineTemplate executes the Go template 'text' with 'args' as its
+// data, so "{{.repo}}" is replaced by the value of the "repo" argument.
+// Referencing an argument that wasn't passed is an error.
+func renderPipelineTemplate(text string, args map[string]string) (string, error) {
+	t, err := template.New("pipeline").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("malformed pipeline template: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, args); err != nil {
+		return "", fmt.Errorf("error rendering pipeline template: %v", err)
+	}
+	return buf.String(), nil
+}
+
+// addTemplateArgFlag adds the --arg flag, which sets the arguments that a
+// pipeline spec is rendered with, to 'cmd'.
+func addTemplateArgFlag(cmd *cobra.Command, templateArgs *[]string) {
+	cmd.Flags().StringArrayVar(templateArgs, "arg", nil, "Render the pipeline spec as a Go template with the key=value argument, which it references as {{.key}}. May be given more than once; the value may contain commas.")
+}
+
 func (r *pipelineManifestReader) nextCreatePipelineRequest() (*ppsclient.CreatePipelineRequest, error) {
 	var result ppsclient.CreatePipelineRequest
 	if err := jsonpb.UnmarshalNext(r.decoder, &result); err != nil {
@@ -750,6 +1081,9 @@ func (r *pipelineManifestReader) nextCreatePipelineRequest() (*ppsclient.CreateP
 		}
 		return nil, fmt.Errorf("malformed pipeline spec: %s", err)
 	}
+	if r.template != nil {
+		result.Template = r.template
+	}
 	return &result, nil
 }
 
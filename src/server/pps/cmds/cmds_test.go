@@ -29,6 +29,7 @@ import (
 	"testing"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
 )
 
@@ -94,3 +95,19 @@ func TestJSONSyntaxErrorsReportedUpdatePipeline(t *testing.T) {
 // 	os.Args = []string{"pachctl", "create-pipeline", "--push-images", "-f", "test-push-images.json"}
 // 	require.NoError(t, rootCmd().Execute())
 // }
+
+func TestRenderPipelineTemplate(t *testing.T) {
+	args, err := cmdutil.ParseKeyValues([]string{"repo=images", "image=ubuntu:16.04", "env=FOO=bar"})
+	require.NoError(t, err)
+	rendered, err := renderPipelineTemplate(`{"input": {"atom": {"repo": "{{.repo}}"}}, "transform": {"image": "{{.image}}"}}`, args)
+	require.NoError(t, err)
+	require.Equal(t, `{"input": {"atom": {"repo": "images"}}, "transform": {"image": "ubuntu:16.04"}}`, rendered)
+	require.Equal(t, "FOO=bar", args["env"])
+
+	// Arguments that the template references must be passed
+	_, err = renderPipelineTemplate(`{"pipeline": {"name": "{{.name}}"}}`, args)
+	require.YesError(t, err)
+
+	_, err = cmdutil.ParseKeyValues([]string{"repo"})
+	require.YesError(t, err)
+}
@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 	"text/template"
 
 	"github.com/docker/go-units"
 	"github.com/fatih/color"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+	"github.com/sergi/go-diff/diffmatchpatch"
 )
 
 // PrintJobHeader prints a job header.
@@ -64,6 +68,179 @@ func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo) {
 	fmt.Fprintf(w, "%s\t\n", pipelineState(pipelineInfo.State))
 }
 
+// PrintPipelineVersionHeader prints a pipeline version header.
+func PrintPipelineVersionHeader(w io.Writer) {
+	fmt.Fprint(w, "VERSION\tIMAGE\tINPUT\tCREATED\t\n")
+}
+
+// PrintPipelineVersion pretty-prints a version of a pipeline's spec.
+func PrintPipelineVersion(w io.Writer, pipelineInfo *ppsclient.PipelineInfo) {
+	fmt.Fprintf(w, "%d\t", pipelineInfo.Version)
+	fmt.Fprintf(w, "%s\t", pipelineInfo.Transform.Image)
+	fmt.Fprintf(w, "%s\t", shorthandInput(pipelineInfo.Input))
+	fmt.Fprintf(w, "%s\t\n", pretty.Ago(pipelineInfo.CreatedAt))
+}
+
+// PrintPipelineSpecDiff prints a line-by-line diff of the specs of two
+// versions of a pipeline.
+func PrintPipelineSpecDiff(w io.Writer, from *ppsclient.PipelineInfo, to *ppsclient.PipelineInfo) error {
+	fromSpec, err := pipelineSpec(from)
+	if err != nil {
+		return err
+	}
+	toSpec, err := pipelineSpec(to)
+	if err != nil {
+		return err
+	}
+	dmp := diffmatchpatch.New()
+	fromChars, toChars, lines := dmp.DiffLinesToChars(fromSpec, toSpec)
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(fromChars, toChars, false), lines)
+	fmt.Fprintf(w, "--- %s (version %d)\n", from.Pipeline.Name, from.Version)
+	fmt.Fprintf(w, "+++ %s (version %d)\n", to.Pipeline.Name, to.Version)
+	for _, diff := range diffs {
+		prefix := " "
+		switch diff.Type {
+		case diffmatchpatch.DiffInsert:
+			prefix = "+"
+		case diffmatchpatch.DiffDelete:
+			prefix = "-"
+		}
+		for _, line := range strings.SplitAfter(diff.Text, "\n") {
+			if line != "" {
+				fmt.Fprintf(w, "%s%s", prefix, line)
+			}
+		}
+	}
+	return nil
+}
+
+// pipelineSpec returns the JSON spec of a pipeline
+func pipelineSpec(pipelineInfo *ppsclient.PipelineInfo) (string, error) {
+	var buf bytes.Buffer
+	marshaller := &jsonpb.Marshaler{Indent: "  "}
+	if err := marshaller.Marshal(&buf, ppsserver.PipelineReqFromInfo(pipelineInfo)); err != nil {
+		return "", err
+	}
+	buf.WriteString("\n")
+	return buf.String(), nil
+}
+
+// PrintDAGDot prints the DAG formed by 'nodes' in Graphviz's DOT language.
+// Repos are drawn as boxes and pipelines as ellipses, pipelines that have
+// failed, or whose last job failed, are drawn in red.
+func PrintDAGDot(w io.Writer, nodes []*ppsclient.DAGNode) error {
+	if _, err := fmt.Fprint(w, "digraph pachyderm {\n"); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		attrs := fmt.Sprintf("shape=box, label=%q", node.Repo.Name)
+		if node.Pipeline != nil {
+			label := node.Repo.Name + "\n" + dagNodeAnnotation(node, "\n")
+			color := "black"
+			if node.State == ppsclient.PipelineState_PIPELINE_FAILURE ||
+				(node.LastJob != nil && node.LastJobState == ppsclient.JobState_JOB_FAILURE) {
+				color = "red"
+			}
+			attrs = fmt.Sprintf("shape=ellipse, color=%s, label=%q", color, label)
+		}
+		if _, err := fmt.Fprintf(w, "  %q [%s];\n", node.Repo.Name, attrs); err != nil {
+			return err
+		}
+	}
+	for _, node := range nodes {
+		for _, parent := range node.Parents {
+			if _, err := fmt.Fprintf(w, "  %q -> %q;\n", parent.Name, node.Repo.Name); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprint(w, "}\n")
+	return err
+}
+
+// PrintDAGTree prints the DAG formed by 'nodes' as an ASCII tree, with the
+// repos that have no parents at the root. A node with several parents is
+// printed in full under its first parent only.
+func PrintDAGTree(w io.Writer, nodes []*ppsclient.DAGNode) error {
+	byName := make(map[string]*ppsclient.DAGNode)
+	children := make(map[string][]string)
+	var roots []string
+	for _, node := range nodes {
+		byName[node.Repo.Name] = node
+	}
+	for _, node := range nodes {
+		hasParent := false
+		for _, parent := range node.Parents {
+			// Parents may have been filtered out of 'nodes'
+			if _, ok := byName[parent.Name]; ok {
+				children[parent.Name] = append(children[parent.Name], node.Repo.Name)
+				hasParent = true
+			}
+		}
+		if !hasParent {
+			roots = append(roots, node.Repo.Name)
+		}
+	}
+	printed := make(map[string]bool)
+	var printNode func(name string, prefix string, childPrefix string) error
+	printNode = func(name string, prefix string, childPrefix string) error {
+		node := byName[name]
+		line := prefix + name
+		if node.Pipeline != nil {
+			line += " (" + dagNodeAnnotation(node, ", ") + ")"
+		}
+		if printed[name] && len(children[name]) > 0 {
+			line += " ..."
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+		if printed[name] {
+			return nil
+		}
+		printed[name] = true
+		for i, child := range children[name] {
+			if i == len(children[name])-1 {
+				if err := printNode(child, childPrefix+"└── ", childPrefix+"    "); err != nil {
+					return err
+				}
+			} else {
+				if err := printNode(child, childPrefix+"├── ", childPrefix+"│   "); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for _, root := range roots {
+		if err := printNode(root, "", ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dagNodeAnnotation describes the state of a pipeline node in the DAG.
+func dagNodeAnnotation(node *ppsclient.DAGNode, sep string) string {
+	annotations := []string{"pipeline: " + shortState(node.State.String(), "PIPELINE_")}
+	if node.LastJob != nil {
+		annotations = append(annotations, "last job: "+shortState(node.LastJobState.String(), "JOB_"))
+	}
+	var counts []string
+	for i := int32(ppsclient.JobState_JOB_STARTING); i <= int32(ppsclient.JobState_JOB_SUCCESS); i++ {
+		counts = append(counts, fmt.Sprintf("%s: %d", shortState(ppsclient.JobState(i).String(), "JOB_"), node.JobCounts[i]))
+	}
+	annotations = append(annotations, "jobs: "+strings.Join(counts, " "))
+	return strings.Join(annotations, sep)
+}
+
+// shortState turns an enum name such as JOB_SUCCESS into "success". Unlike
+// jobState and pipelineState it doesn't color its output, which would break
+// the DOT format.
+func shortState(state string, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(state, prefix))
+}
+
 // PrintJobInputHeader pretty prints a job input header.
 func PrintJobInputHeader(w io.Writer) {
 	fmt.Fprint(w, "NAME\tREPO\tCOMMIT\tGLOB\tLAZY\t\n")
@@ -138,6 +315,8 @@ Process Time: {{prettyDuration .Stats.ProcessTime}}
 Upload Time: {{prettyDuration .Stats.UploadTime}}
 Datum Timeout: {{.DatumTimeout}}
 Job Timeout: {{.JobTimeout}}
+Datum Tries: {{.DatumTries}}
+Skip Failed Datums: {{.SkipFailedDatums}}
 Worker Status:
 {{workerStatus .}}Restarts: {{.Restart}}
 ParallelismSpec: {{.ParallelismSpec}}
@@ -173,6 +352,7 @@ func PrintDetailedPipelineInfo(pipelineInfo *ppsclient.PipelineInfo) error {
 	template, err := template.New("PipelineInfo").Funcs(funcMap).Parse(
 		`Name: {{.Pipeline.Name}}{{if .Description}}
 Description: {{.Description}}{{end}}
+Version: {{.Version}}
 Created: {{prettyAgo .CreatedAt}}
 State: {{pipelineState .State}}
 Reason: {{.Reason}}
@@ -186,13 +366,20 @@ Parallelism Spec: {{.ParallelismSpec}}
 	GPU: {{ .ResourceLimits.Gpu }} {{end}}
 Datum Timeout: {{.DatumTimeout}}
 Job Timeout: {{.JobTimeout}}
-Input:
+Datum Tries: {{.DatumTries}}
+Skip Failed Datums: {{.SkipFailedDatums}}
+{{ if .Spout }}Spout:
+	Overwrite: {{ .Spout.Overwrite }}
+{{end}}Input:
 {{pipelineInput .}}
 {{ if .GithookURL }}Githook URL: {{.GithookURL}} {{end}}
 Output Branch: {{.OutputBranch}}
 Transform:
 {{prettyTransform .Transform}}
-{{ if .Egress }}Egress: {{.Egress.URL}} {{end}}
+{{ if .Template }}Template Args:
+{{templateArgs .Template.Args}}Template:
+{{.Template.Template}}
+{{end}}{{ if .Egress }}Egress: {{.Egress.URL}} {{end}}
 {{if .RecentError}} Recent Error: {{.RecentError}} {{end}}
 Job Counts:
 {{jobCounts .JobCounts}}
@@ -223,6 +410,20 @@ func PrintDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\n", datumInfo.Datum.ID, datumState(datumInfo.State), totalTime)
 }
 
+// PrintDatumDataHeader prints the header for a datum preview.
+func PrintDatumDataHeader(w io.Writer) {
+	fmt.Fprint(w, "ID\tFILES\t\n")
+}
+
+// PrintDatumData pretty-prints the files that make up a datum.
+func PrintDatumData(w io.Writer, datumInfo *ppsclient.DatumInfo) {
+	var files []string
+	for _, fileInfo := range datumInfo.Data {
+		files = append(files, fmt.Sprintf("%s@%s:%s", fileInfo.File.Commit.Repo.Name, fileInfo.File.Commit.ID, fileInfo.File.Path))
+	}
+	fmt.Fprintf(w, "%s\t%s\t\n", datumInfo.Datum.ID, strings.Join(files, ", "))
+}
+
 // PrintDetailedDatumInfo pretty-prints detailed info about a datum
 func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	fmt.Fprintf(w, "ID\t%s\n", datumInfo.Datum.ID)
@@ -365,8 +566,23 @@ func prettyTransform(transform *ppsclient.Transform) (string, error) {
 	return pretty.UnescapeHTML(string(result)), nil
 }
 
+func templateArgs(args map[string]string) string {
+	var keys []string
+	for key := range args {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var buffer bytes.Buffer
+	for _, key := range keys {
+		fmt.Fprintf(&buffer, "\t%s: %s\n", key, args[key])
+	}
+	return buffer.String()
+}
+
 func shorthandInput(input *ppsclient.Input) string {
 	switch {
+	case input == nil:
+		return "none"
 	case input.Atom != nil:
 		return fmt.Sprintf("%s:%s", input.Atom.Repo, input.Atom.Glob)
 	case input.Cross != nil:
@@ -381,6 +597,18 @@ func shorthandInput(input *ppsclient.Input) string {
 			subInput = append(subInput, shorthandInput(input))
 		}
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
+	case input.Join != nil:
+		var subInput []string
+		for _, input := range input.Join {
+			subInput = append(subInput, shorthandInput(input))
+		}
+		return "(" + strings.Join(subInput, " ⋈ ") + ")"
+	case input.Group != nil:
+		var subInput []string
+		for _, input := range input.Group {
+			subInput = append(subInput, shorthandInput(input))
+		}
+		return "group(" + strings.Join(subInput, ", ") + ")"
 	case input.Cron != nil:
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
 	}
@@ -400,4 +628,5 @@ var funcMap = template.FuncMap{
 	"prettySize":           pretty.Size,
 	"jobCounts":            jobCounts,
 	"prettyTransform":      prettyTransform,
+	"templateArgs":         templateArgs,
 }
@@ -6,7 +6,6 @@ import (
 	goerr "errors"
 	"fmt"
 	"io"
-	"path"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -22,6 +21,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dag"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
@@ -34,6 +34,7 @@ import (
 
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -117,8 +118,9 @@ type apiServer struct {
 	imagePullSecret       string
 	reporter              *metrics.Reporter
 	// collections
-	pipelines col.Collection
-	jobs      col.Collection
+	pipelines        col.Collection
+	pipelineVersions col.Collection
+	jobs             col.Collection
 }
 
 func merge(from, to map[string]bool) {
@@ -158,6 +160,18 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 				return err
 			}
 		}
+	case input.Join != nil:
+		for _, input := range input.Join {
+			if err := validateNames(names, input); err != nil {
+				return err
+			}
+		}
+	case input.Group != nil:
+		for _, input := range input.Group {
+			if err := validateNames(names, input); err != nil {
+				return err
+			}
+		}
 	case input.Git != nil:
 		if names[input.Git.Name] == true {
 			return fmt.Errorf("name %s was used more than once", input.Git.Name)
@@ -226,6 +240,34 @@ func (a *apiServer) validateInput(ctx context.Context, pipelineName string, inpu
 				}
 				set = true
 			}
+			if input.Join != nil {
+				if set {
+					return fmt.Errorf("multiple input types set")
+				}
+				set = true
+				for _, input := range input.Join {
+					if input.Atom == nil {
+						return fmt.Errorf("join inputs may only contain atom inputs")
+					}
+					if err := workerpkg.ValidateJoinInput(input.Atom); err != nil {
+						return err
+					}
+				}
+			}
+			if input.Group != nil {
+				if set {
+					return fmt.Errorf("multiple input types set")
+				}
+				set = true
+				for _, input := range input.Group {
+					if input.Atom == nil {
+						return fmt.Errorf("group inputs may only contain atom inputs")
+					}
+					if err := workerpkg.ValidateGroupInput(input.Atom); err != nil {
+						return err
+					}
+				}
+			}
 			if input.Cron != nil {
 				if set {
 					return fmt.Errorf("multiple input types set")
@@ -360,7 +402,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 			OutputBranch:     request.OutputBranch,
 			Started:          now(),
 			Finished:         nil,
-			OutputCommit:     nil,
+			OutputCommit:     request.OutputCommit,
 			Service:          request.Service,
 			ParentJob:        request.ParentJob,
 			ResourceRequests: request.ResourceRequests,
@@ -375,6 +417,8 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 			ChunkSpec:        request.ChunkSpec,
 			DatumTimeout:     request.DatumTimeout,
 			JobTimeout:       request.JobTimeout,
+			DatumTries:       request.DatumTries,
+			SkipFailedDatums: request.SkipFailedDatums,
 		}
 		if request.Pipeline != nil {
 			pipelineInfo := new(pps.PipelineInfo)
@@ -621,10 +665,119 @@ func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumR
 	return &types.Empty{}, nil
 }
 
+// getTotalPages returns the number of pages of size 'pageSize' needed to hold
+// 'totalSize' items
+func getTotalPages(totalSize int, pageSize int64) int64 {
+	return (int64(totalSize) + pageSize - 1) / pageSize // == ceil(totalSize/pageSize)
+}
+
+// getPageBounds returns the range of the 'totalSize' items that falls on
+// 'page', or io.EOF if 'page' is past the last item
+func getPageBounds(totalSize int, page, pageSize int64) (int, int, error) {
+	start := int(page * pageSize)
+	end := int((page + 1) * pageSize)
+	switch {
+	case totalSize <= start:
+		return 0, 0, io.EOF
+	case totalSize <= end:
+		return start, totalSize, nil
+	case end < totalSize:
+		return start, end, nil
+	}
+	return 0, 0, goerr.New("getPageBounds: unreachable code")
+}
+
+// pendingDatums lists the datums produced by 'df' that haven't been processed
+// yet, hashing them as the workers of 'pipelineName' would. If 'pipelineName'
+// is empty, the datums aren't given IDs.
+func pendingDatums(df workerpkg.DatumFactory, pipelineName string, salt string, job *pps.Job, page, pageSize int64) (*pps.ListDatumResponse, error) {
+	response := &pps.ListDatumResponse{}
+	start := 0
+	end := df.Len()
+	if pageSize > 0 {
+		var err error
+		start, end, err = getPageBounds(df.Len(), page, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		response.Page = page
+		response.TotalPages = getTotalPages(df.Len(), pageSize)
+	}
+	for i := start; i < end; i++ {
+		datum := df.Datum(i) // flattened slice of *worker.Input to job
+		datumInfo := &pps.DatumInfo{
+			Datum: &pps.Datum{
+				Job: job,
+			},
+			State: pps.DatumState_STARTING,
+		}
+		if pipelineName != "" {
+			datumInfo.Datum.ID = workerpkg.HashDatum(pipelineName, salt, datum)
+		}
+		for _, input := range datum {
+			datumInfo.Data = append(datumInfo.Data, input.FileInfo)
+		}
+		response.DatumInfos = append(response.DatumInfos, datumInfo)
+	}
+	return response, nil
+}
+
+// listDatumInput lists the datums that a pipeline with 'input' would process
+// if it ran against the current heads of its input branches. It's used to
+// preview globs before creating (or updating) a pipeline, so no job is
+// involved. A datum's ID depends on the pipeline that processes it (see
+// workerpkg.HashDatum), so the datums only get IDs if 'pipeline' is an
+// existing pipeline, in which case they're the IDs its workers would use.
+func (a *apiServer) listDatumInput(ctx context.Context, pipeline *pps.Pipeline, input *pps.Input, page, pageSize int64) (*pps.ListDatumResponse, error) {
+	if err := a.authorizePipelineOp(ctx, pipelineOpListDatum, input, ""); err != nil {
+		return nil, err
+	}
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	pachClient = pachClient.WithCtx(ctx)
+	input = proto.Clone(input).(*pps.Input)
+	ppsserver.SetInputDefaults("", input)
+	// Pin every input to the current head of its branch
+	if err := workerpkg.PinInputCommits(pachClient, input); err != nil {
+		return nil, err
+	}
+	df, err := workerpkg.NewDatumFactory(ctx, pachClient.PfsAPIClient, input)
+	if err != nil {
+		return nil, err
+	}
+	var pipelineName, salt string
+	if pipeline != nil {
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := a.pipelines.ReadOnly(ctx).Get(pipeline.Name, pipelineInfo); err != nil {
+			if !col.IsErrNotFound(err) {
+				return nil, err
+			}
+		} else {
+			pipelineName, salt = pipelineInfo.Pipeline.Name, pipelineInfo.Salt
+		}
+	}
+	return pendingDatums(df, pipelineName, salt, nil, page, pageSize)
+}
+
 // listDatum contains our internal implementation of ListDatum, which is shared
 // between ListDatum and ListDatumStream. When ListDatum is removed, this should
 // be inlined into ListDatumStream
-func (a *apiServer) listDatum(ctx context.Context, job *pps.Job, page, pageSize int64) (response *pps.ListDatumResponse, retErr error) {
+func (a *apiServer) listDatum(ctx context.Context, request *pps.ListDatumRequest) (response *pps.ListDatumResponse, retErr error) {
+	if request.Input != nil {
+		if request.Job != nil {
+			return nil, fmt.Errorf("only one of job and input may be set")
+		}
+		return a.listDatumInput(ctx, request.Pipeline, request.Input, request.Page, request.PageSize)
+	}
+	if request.Pipeline != nil {
+		return nil, fmt.Errorf("pipeline can only be set along with input")
+	}
+	if request.Job == nil {
+		return nil, fmt.Errorf("must specify either a job or an input")
+	}
+	job, page, pageSize := request.Job, request.Page, request.PageSize
 	response = &pps.ListDatumResponse{}
 
 	// get information about 'job'
@@ -653,59 +806,13 @@ func (a *apiServer) listDatum(ctx context.Context, job *pps.Job, page, pageSize
 	}
 	pfsClient := pachClient.PfsAPIClient
 
-	// helper functions for pagination
-	getTotalPages := func(totalSize int) int64 {
-		return (int64(totalSize) + pageSize - 1) / pageSize // == ceil(totalSize/pageSize)
-	}
-	getPageBounds := func(totalSize int) (int, int, error) {
-		start := int(page * pageSize)
-		end := int((page + 1) * pageSize)
-		switch {
-		case totalSize <= start:
-			return 0, 0, io.EOF
-		case totalSize <= end:
-			return start, totalSize, nil
-		case end < totalSize:
-			return start, end, nil
-		}
-		return 0, 0, goerr.New("getPageBounds: unreachable code")
-	}
-
 	df, err := workerpkg.NewDatumFactory(ctx, pfsClient, jobInfo.Input)
 	if err != nil {
 		return nil, err
 	}
 	// If there's no stats commit (job not finished), compute datums using jobInfo
 	if jobInfo.StatsCommit == nil {
-		start := 0
-		end := df.Len()
-		if pageSize > 0 {
-			var err error
-			start, end, err = getPageBounds(df.Len())
-			if err != nil {
-				return nil, err
-			}
-			response.Page = page
-			response.TotalPages = getTotalPages(df.Len())
-		}
-		var datumInfos []*pps.DatumInfo
-		for i := start; i < end; i++ {
-			datum := df.Datum(i) // flattened slice of *worker.Input to job
-			id := workerpkg.HashDatum(jobInfo.Pipeline.Name, jobInfo.Salt, datum)
-			datumInfo := &pps.DatumInfo{
-				Datum: &pps.Datum{
-					ID:  id,
-					Job: jobInfo.Job,
-				},
-				State: pps.DatumState_STARTING,
-			}
-			for _, input := range datum {
-				datumInfo.Data = append(datumInfo.Data, input.FileInfo)
-			}
-			datumInfos = append(datumInfos, datumInfo)
-		}
-		response.DatumInfos = datumInfos
-		return response, nil
+		return pendingDatums(df, jobInfo.Pipeline.Name, jobInfo.Salt, jobInfo.Job, page, pageSize)
 	}
 
 	// There is a stats commit -- job is finished
@@ -752,8 +859,8 @@ func (a *apiServer) listDatum(ctx context.Context, job *pps.Job, page, pageSize
 	})
 	if pageSize > 0 {
 		response.Page = page
-		response.TotalPages = getTotalPages(len(datumFileInfos))
-		start, end, err := getPageBounds(len(datumFileInfos))
+		response.TotalPages = getTotalPages(len(datumFileInfos), pageSize)
+		start, end, err := getPageBounds(len(datumFileInfos), page, pageSize)
 		if err != nil {
 			return nil, err
 		}
@@ -804,7 +911,7 @@ func (a *apiServer) ListDatum(ctx context.Context, request *pps.ListDatumRequest
 			a.Log(request, response, retErr, time.Since(start))
 		}
 	}(time.Now())
-	return a.listDatum(ctx, request.Job, request.Page, request.PageSize)
+	return a.listDatum(ctx, request)
 }
 
 func (a *apiServer) ListDatumStream(req *pps.ListDatumRequest, resp pps.API_ListDatumStreamServer) (retErr error) {
@@ -814,7 +921,7 @@ func (a *apiServer) ListDatumStream(req *pps.ListDatumRequest, resp pps.API_List
 		a.Log(req, fmt.Sprintf("stream containing %d DatumInfos", sent), retErr, time.Since(start))
 	}(time.Now())
 	ctx := auth.In2Out(resp.Context())
-	ldr, err := a.listDatum(ctx, req.Job, req.Page, req.PageSize)
+	ldr, err := a.listDatum(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -1194,8 +1301,20 @@ func (a *apiServer) getLogsFromStats(ctx context.Context, request *pps.GetLogsRe
 }
 
 func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
-	if err := a.validateInput(ctx, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false); err != nil {
-		return err
+	if pipelineInfo.Spout != nil {
+		if pipelineInfo.Input != nil {
+			return fmt.Errorf("spout pipelines can't have an input")
+		}
+		if pipelineInfo.Service != nil {
+			return fmt.Errorf("spout pipelines can't also be services")
+		}
+	} else {
+		if pipelineInfo.Input == nil {
+			return fmt.Errorf("pipeline needs to specify an input")
+		}
+		if err := a.validateInput(ctx, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false); err != nil {
+			return err
+		}
 	}
 	if err := validateTransform(pipelineInfo.Transform); err != nil {
 		return fmt.Errorf("invalid transform: %v", err)
@@ -1215,6 +1334,9 @@ func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.Pipe
 		if pipelineInfo.Service != nil && pipelineInfo.ParallelismSpec.Constant != 1 {
 			return fmt.Errorf("services can only be run with a constant parallelism of 1")
 		}
+		if pipelineInfo.Spout != nil && pipelineInfo.ParallelismSpec.Constant != 1 {
+			return fmt.Errorf("spouts can only be run with a constant parallelism of 1")
+		}
 	}
 	if pipelineInfo.OutputBranch == "" {
 		return fmt.Errorf("pipeline needs to specify an output branch")
@@ -1263,6 +1385,9 @@ func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.Pipe
 			return err
 		}
 	}
+	if pipelineInfo.DatumTries < 0 {
+		return fmt.Errorf("datum_tries cannot be negative (got %d)", pipelineInfo.DatumTries)
+	}
 	return nil
 }
 
@@ -1414,6 +1539,10 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		ChunkSpec:          request.ChunkSpec,
 		DatumTimeout:       request.DatumTimeout,
 		JobTimeout:         request.JobTimeout,
+		DatumTries:         request.DatumTries,
+		SkipFailedDatums:   request.SkipFailedDatums,
+		Spout:              request.Spout,
+		Template:           request.Template,
 	}
 	setPipelineDefaults(pipelineInfo)
 	if err := a.validatePipeline(ctx, pipelineInfo); err != nil {
@@ -1471,7 +1600,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 				pipelineInfo.Salt = oldPipelineInfo.Salt
 			}
 			pipelines.Put(pipelineName, pipelineInfo)
-			return nil
+			return a.putPipelineVersion(stm, pipelineInfo)
 		})
 		if err != nil {
 			return nil, err
@@ -1501,13 +1630,6 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			return nil, err
 		}
 
-		if _, err := pfsClient.DeleteBranch(ctx, &pfs.DeleteBranchRequest{
-			Repo:   &pfs.Repo{pipelineName},
-			Branch: oldPipelineInfo.OutputBranch,
-		}); err != nil && !isNotFoundErr(err) {
-			return nil, err
-		}
-
 		if _, err := a.StartPipeline(ctx, &pps.StartPipelineRequest{request.Pipeline}); err != nil {
 			return nil, err
 		}
@@ -1540,6 +1662,14 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}); err != nil && !isAlreadyExistsErr(err) {
 			return nil, err
 		}
+		if err := a.createOutputBranch(ctx, pachClient, pipelineInfo); err != nil {
+			return nil, err
+		}
+		// The updated pipeline processes the current inputs, even if none of
+		// them has a new commit
+		if err := a.startOutputCommit(ctx, pachClient, pipelineInfo); err != nil {
+			return nil, err
+		}
 		if provenanceChanged {
 			// Restart all downstream pipelines so they relaunch with the
 			// correct provenance.
@@ -1568,7 +1698,10 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			if isAlreadyExistsErr(err) {
 				return newErrPipelineExists(pipelineName)
 			}
-			return err
+			if err != nil {
+				return err
+			}
+			return a.putPipelineVersion(stm, pipelineInfo)
 		})
 		if err != nil {
 			return nil, err
@@ -1586,47 +1719,95 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}); err != nil && !isAlreadyExistsErr(err) {
 			return nil, err
 		}
+		if err := a.createOutputBranch(ctx, pachClient, pipelineInfo); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.Empty{}, nil
 }
 
-// setPipelineDefaults sets the default values for a pipeline info
-func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
-	now := time.Now()
-	if pipelineInfo.Transform.Image == "" {
-		pipelineInfo.Transform.Image = DefaultUserImage
-	}
-	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
-		if input.Atom != nil {
-			if input.Atom.Branch == "" {
-				input.Atom.Branch = "master"
+// createOutputBranch puts the pipeline's input branches in the provenance of
+// its output branch. PFS then opens a commit in the output branch whenever the
+// inputs have new commits, and the pipeline processes those commits. Input
+// branches that don't exist yet are created.
+func (a *apiServer) createOutputBranch(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	pachClient = pachClient.WithCtx(auth.In2Out(ctx))
+	inputBranches := pps.InputBranches(pipelineInfo.Input)
+	for _, branch := range inputBranches {
+		if _, err := pachClient.InspectBranch(branch.Repo.Name, branch.Name); err != nil {
+			if !isNotFoundErr(err) {
+				return err
 			}
-			if input.Atom.Name == "" {
-				input.Atom.Name = input.Atom.Repo
+			if err := pachClient.CreateBranch(branch.Repo.Name, branch.Name, "", nil); err != nil {
+				return err
 			}
 		}
-		if input.Cron != nil {
-			if input.Cron.Start == nil {
-				start, _ := types.TimestampProto(now)
-				input.Cron.Start = start
-			}
-			if input.Cron.Repo == "" {
-				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineInfo.Pipeline.Name, input.Cron.Name)
-			}
+	}
+	return pachClient.CreateBranch(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch, "", inputBranches)
+}
+
+// startOutputCommit starts a commit in the pipeline's output branch, with the
+// heads of its inputs as provenance. Nothing is started if the branch's head
+// is still open (the pipeline processes that commit instead), or if some of
+// the inputs have no finished head yet (PFS opens a commit once they do).
+func (a *apiServer) startOutputCommit(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	pachClient = pachClient.WithCtx(auth.In2Out(ctx))
+	outputBranchInfo, err := pachClient.InspectBranch(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch)
+	if err != nil {
+		return err
+	}
+	if outputBranchInfo.Head != nil {
+		headInfo, err := pachClient.InspectCommit(pipelineInfo.Pipeline.Name, outputBranchInfo.Head.ID)
+		if err != nil {
+			return err
 		}
-		if input.Git != nil {
-			if input.Git.Branch == "" {
-				input.Git.Branch = "master"
-			}
-			if input.Git.Name == "" {
-				// We know URL looks like:
-				// "https://github.com/sjezewski/testgithook.git",
-				tokens := strings.Split(path.Base(input.Git.URL), ".")
-				input.Git.Name = tokens[0]
-			}
+		if headInfo.Finished == nil {
+			return nil
 		}
+	}
+	var provenance []*pfs.Commit
+	for _, branch := range pps.InputBranches(pipelineInfo.Input) {
+		branchInfo, err := pachClient.InspectBranch(branch.Repo.Name, branch.Name)
+		if err != nil {
+			return err
+		}
+		if branchInfo.Head == nil {
+			return nil
+		}
+		headInfo, err := pachClient.InspectCommit(branch.Repo.Name, branchInfo.Head.ID)
+		if err != nil {
+			return err
+		}
+		if headInfo.Finished == nil {
+			return nil
+		}
+		provenance = append(provenance, headInfo.Commit)
+	}
+	_, err = pachClient.PfsAPIClient.StartCommit(pachClient.Ctx(), &pfs.StartCommitRequest{
+		Parent:     client.NewCommit(pipelineInfo.Pipeline.Name, ""),
+		Branch:     pipelineInfo.OutputBranch,
+		Provenance: provenance,
 	})
+	return grpcutil.ScrubGRPC(err)
+}
+
+// putPipelineVersion records 'pipelineInfo' in the pipeline's version history.
+func (a *apiServer) putPipelineVersion(stm col.STM, pipelineInfo *pps.PipelineInfo) error {
+	versionInfo := *pipelineInfo
+	// The capability is revoked when the pipeline is updated, so there's no
+	// point in keeping it around
+	versionInfo.Capability = ""
+	return a.pipelineVersions.ReadWrite(stm).Put(
+		ppsdb.PipelineVersionKey(pipelineInfo.Pipeline.Name, pipelineInfo.Version), &versionInfo)
+}
+
+// setPipelineDefaults sets the default values for a pipeline info
+func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
+	if pipelineInfo.Transform.Image == "" {
+		pipelineInfo.Transform.Image = DefaultUserImage
+	}
+	ppsserver.SetInputDefaults(pipelineInfo.Pipeline.Name, pipelineInfo.Input)
 	if pipelineInfo.OutputBranch == "" {
 		// Output branches default to master
 		pipelineInfo.OutputBranch = "master"
@@ -1642,6 +1823,9 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
 	if pipelineInfo.MaxQueueSize < 1 {
 		pipelineInfo.MaxQueueSize = 1
 	}
+	if pipelineInfo.DatumTries == 0 {
+		pipelineInfo.DatumTries = ppsserver.DefaultDatumTries
+	}
 }
 
 func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (response *pps.PipelineInfo, retErr error) {
@@ -1652,6 +1836,16 @@ func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPip
 	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, pipelineInfo); err != nil {
 		return nil, err
 	}
+	if request.Version != 0 && request.Version != pipelineInfo.Version {
+		versionInfo := new(pps.PipelineInfo)
+		if err := a.pipelineVersions.ReadOnly(ctx).Get(ppsdb.PipelineVersionKey(request.Pipeline.Name, request.Version), versionInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, fmt.Errorf("version %d of pipeline %s not found", request.Version, request.Pipeline.Name)
+			}
+			return nil, err
+		}
+		return versionInfo, nil
+	}
 	var hasGitInput bool
 	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
 		if input.Git != nil {
@@ -1718,6 +1912,192 @@ func (a *apiServer) ListPipeline(ctx context.Context, request *pps.ListPipelineR
 	return pipelineInfos, nil
 }
 
+func (a *apiServer) ListPipelineVersions(ctx context.Context, request *pps.ListPipelineVersionsRequest) (response *pps.PipelineInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if _, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{Pipeline: request.Pipeline}); err != nil {
+		return nil, err
+	}
+	iter, err := a.pipelineVersions.ReadOnly(ctx).ListPrefix(ppsdb.PipelineVersionsPrefix(request.Pipeline.Name))
+	if err != nil {
+		return nil, err
+	}
+	response = &pps.PipelineInfos{}
+	for {
+		var key string
+		pipelineInfo := new(pps.PipelineInfo)
+		ok, err := iter.Next(&key, pipelineInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if pipelineInfo.Pipeline.Name == request.Pipeline.Name {
+			response.PipelineInfo = append(response.PipelineInfo, pipelineInfo)
+		}
+	}
+	// Versions are listed newest first. They're sorted by their version
+	// number, as the order of their keys is lexicographic (e.g. version 10
+	// comes before version 9).
+	sort.Slice(response.PipelineInfo, func(i, j int) bool {
+		return response.PipelineInfo[i].Version > response.PipelineInfo[j].Version
+	})
+	return response, nil
+}
+
+func (a *apiServer) RollbackPipeline(ctx context.Context, request *pps.RollbackPipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if request.Version == 0 {
+		return nil, fmt.Errorf("must specify the version to roll back to")
+	}
+	pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{
+		Pipeline: request.Pipeline,
+		Version:  request.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The old spec is re-applied as a new version, so that the rollback
+	// itself shows up in the pipeline's history
+	createRequest := ppsserver.PipelineReqFromInfo(pipelineInfo)
+	createRequest.Update = true
+	createRequest.Reprocess = request.Reprocess
+	if _, err := a.CreatePipeline(ctx, createRequest); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) InspectDAG(ctx context.Context, request *pps.InspectDAGRequest) (response *pps.DAGInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	pachClient, err := a.getPachClient()
+	if err != nil {
+		return nil, err
+	}
+	pachClient = pachClient.WithCtx(ctx) // pachClient will propagate auth info
+	repoInfos, err := pachClient.ListRepo(nil)
+	if err != nil {
+		return nil, err
+	}
+	pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
+	if err != nil {
+		return nil, err
+	}
+	pipelines := make(map[string]*pps.PipelineInfo)
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		pipelines[pipelineInfo.Pipeline.Name] = pipelineInfo
+	}
+
+	// A pipeline's output repo's parents are the repos in the pipeline's
+	// input, every other repo's parents are its provenance.
+	parents := make(map[string][]string)
+	for _, repoInfo := range repoInfos {
+		repo := repoInfo.Repo.Name
+		seen := make(map[string]bool)
+		addParent := func(parent string) {
+			if !seen[parent] {
+				seen[parent] = true
+				parents[repo] = append(parents[repo], parent)
+			}
+		}
+		if pipelineInfo, ok := pipelines[repo]; ok {
+			for _, commit := range pps.InputCommits(pipelineInfo.Input) {
+				addParent(commit.Repo.Name)
+			}
+		} else {
+			for _, provRepo := range repoInfo.Provenance {
+				addParent(provRepo.Name)
+			}
+		}
+		if _, ok := parents[repo]; !ok {
+			parents[repo] = nil
+		}
+	}
+	d := dag.NewDAG(parents)
+
+	include := func(string) bool { return true }
+	if request.Repo != nil {
+		if _, ok := parents[request.Repo.Name]; !ok {
+			return nil, fmt.Errorf("repo %s not found", request.Repo.Name)
+		}
+		related := make(map[string]bool)
+		for _, repo := range d.Ancestors(request.Repo.Name, nil) {
+			related[repo] = true
+		}
+		for _, repo := range d.Descendants(request.Repo.Name, nil) {
+			related[repo] = true
+		}
+		include = func(repo string) bool { return related[repo] }
+	}
+
+	response = &pps.DAGInfo{}
+	for _, repo := range d.Sorted() {
+		if !include(repo) {
+			continue
+		}
+		node := &pps.DAGNode{Repo: client.NewRepo(repo)}
+		for _, parent := range parents[repo] {
+			node.Parents = append(node.Parents, client.NewRepo(parent))
+		}
+		if pipelineInfo, ok := pipelines[repo]; ok {
+			node.Pipeline = pipelineInfo.Pipeline
+			node.State = pipelineInfo.State
+			node.JobCounts = pipelineInfo.JobCounts
+			lastJob, err := a.lastJob(ctx, pipelineInfo.Pipeline)
+			if err != nil {
+				return nil, err
+			}
+			if lastJob != nil {
+				node.LastJob = lastJob.Job
+				node.LastJobState = lastJob.State
+			}
+		}
+		response.Nodes = append(response.Nodes, node)
+	}
+	return response, nil
+}
+
+// lastJob returns the most recently started job of 'pipeline', or nil if the
+// pipeline has no jobs.
+func (a *apiServer) lastJob(ctx context.Context, pipeline *pps.Pipeline) (*pps.JobInfo, error) {
+	iter, err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var result *pps.JobInfo
+	for {
+		var jobID string
+		jobInfo := new(pps.JobInfo)
+		ok, err := iter.Next(&jobID, jobInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if result == nil || startedBefore(result, jobInfo) {
+			result = jobInfo
+		}
+	}
+	return result, nil
+}
+
+func startedBefore(x *pps.JobInfo, y *pps.JobInfo) bool {
+	if x.Started == nil || y.Started == nil {
+		return y.Started != nil
+	}
+	if x.Started.Seconds != y.Started.Seconds {
+		return x.Started.Seconds < y.Started.Seconds
+	}
+	return x.Started.Nanos < y.Started.Nanos
+}
+
 func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -1744,7 +2124,7 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	if err != nil {
 		return nil, err
 	}
-	pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{request.Pipeline})
+	pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{Pipeline: request.Pipeline})
 	if err != nil {
 		return nil, fmt.Errorf("pipeline %v was not found: %v", request.Pipeline.Name, err)
 	}
@@ -1803,6 +2183,9 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	}
 
 	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		// The pipeline's history is deleted with it, so that a new pipeline
+		// with the same name starts from version 1
+		a.pipelineVersions.ReadWrite(stm).DeleteAllPrefix(ppsdb.PipelineVersionsPrefix(request.Pipeline.Name))
 		return a.pipelines.ReadWrite(stm).Delete(request.Pipeline.Name)
 	}); err != nil {
 		return nil, err
@@ -1824,6 +2207,12 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 		if err := eg.Wait(); err != nil {
 			return nil, err
 		}
+	} else {
+		// Detach the output branch from the pipeline's inputs, so that PFS
+		// stops opening commits in it
+		if err := pachClient.WithCtx(auth.In2Out(ctx)).ClearBranchProvenance(request.Pipeline.Name, pipelineInfo.OutputBranch); err != nil && !isNotFoundErr(err) {
+			return nil, err
+		}
 	}
 	return &types.Empty{}, nil
 }
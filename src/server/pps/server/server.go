@@ -53,6 +53,7 @@ func NewAPIServer(
 		imagePullSecret:       imagePullSecret,
 		reporter:              reporter,
 		pipelines:             ppsdb.Pipelines(etcdClient, etcdPrefix),
+		pipelineVersions:      ppsdb.PipelineVersions(etcdClient, etcdPrefix),
 		jobs:                  ppsdb.Jobs(etcdClient, etcdPrefix),
 	}
 	apiServer.validateKube()
@@ -79,14 +80,15 @@ func NewSidecarAPIServer(
 	}
 
 	apiServer := &apiServer{
-		Logger:     log.NewLogger("pps.API"),
-		address:    address,
-		etcdPrefix: etcdPrefix,
-		etcdClient: etcdClient,
-		iamRole:    iamRole,
-		reporter:   reporter,
-		pipelines:  ppsdb.Pipelines(etcdClient, etcdPrefix),
-		jobs:       ppsdb.Jobs(etcdClient, etcdPrefix),
+		Logger:           log.NewLogger("pps.API"),
+		address:          address,
+		etcdPrefix:       etcdPrefix,
+		etcdClient:       etcdClient,
+		iamRole:          iamRole,
+		reporter:         reporter,
+		pipelines:        ppsdb.Pipelines(etcdClient, etcdPrefix),
+		pipelineVersions: ppsdb.PipelineVersions(etcdClient, etcdPrefix),
+		jobs:             ppsdb.Jobs(etcdClient, etcdPrefix),
 	}
 	return apiServer, nil
 }
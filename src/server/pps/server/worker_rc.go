@@ -2,9 +2,11 @@ package server
 
 import (
 	"context"
+	"os"
 
 	client "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 
@@ -54,6 +56,16 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 		Name:  "STORAGE_BACKEND",
 		Value: a.storageBackend,
 	}}
+	// Send the sidecar's spans to the same collector as pachd's, sampled the
+	// same way
+	for _, name := range tracing.EnvVars {
+		if value := os.Getenv(name); value != "" {
+			sidecarEnv = append(sidecarEnv, v1.EnvVar{
+				Name:  name,
+				Value: value,
+			})
+		}
+	}
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
 	storageVolumeName := "pach-disk"
@@ -225,6 +237,16 @@ func (a *apiServer) getWorkerOptions(pipelineName string, rcName string,
 		Name:  client.PPSNamespaceEnv,
 		Value: a.namespace,
 	})
+	// Send the worker's spans to the same collector as pachd's, sampled the
+	// same way
+	for _, name := range tracing.EnvVars {
+		if value := os.Getenv(name); value != "" {
+			workerEnv = append(workerEnv, v1.EnvVar{
+				Name:  name,
+				Value: value,
+			})
+		}
+	}
 
 	var volumes []v1.Volume
 	var volumeMounts []v1.VolumeMount
@@ -301,7 +323,11 @@ func (a *apiServer) getWorkerOptions(pipelineName string, rcName string,
 		imagePullSecrets = append(imagePullSecrets, v1.LocalObjectReference{Name: a.imagePullSecret})
 	}
 
-	annotations := map[string]string{"pipelineName": pipelineName}
+	annotations := map[string]string{
+		"pipelineName":         pipelineName,
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "652",
+	}
 	if a.iamRole != "" {
 		annotations["iam.amazonaws.com/role"] = a.iamRole
 	}
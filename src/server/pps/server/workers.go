@@ -3,23 +3,16 @@ package server
 import (
 	"context"
 	"fmt"
-	"path"
 
 	"github.com/gogo/protobuf/types"
-	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	workerpkg "github.com/pachyderm/pachyderm/src/server/worker"
 
 	etcd "github.com/coreos/etcd/clientv3"
-	"google.golang.org/grpc"
-)
-
-const (
-	workerEtcdPrefix = "workers"
 )
 
 func status(ctx context.Context, id string, etcdClient *etcd.Client, etcdPrefix string) ([]*pps.WorkerStatus, error) {
-	workerClients, err := workerClients(ctx, id, etcdClient, etcdPrefix)
+	workerClients, err := workerpkg.Clients(ctx, id, etcdClient, etcdPrefix)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +29,7 @@ func status(ctx context.Context, id string, etcdClient *etcd.Client, etcdPrefix
 
 func cancel(ctx context.Context, id string, etcdClient *etcd.Client,
 	etcdPrefix string, jobID string, dataFilter []string) error {
-	workerClients, err := workerClients(ctx, id, etcdClient, etcdPrefix)
+	workerClients, err := workerpkg.Clients(ctx, id, etcdClient, etcdPrefix)
 	if err != nil {
 		return err
 	}
@@ -58,21 +51,3 @@ func cancel(ctx context.Context, id string, etcdClient *etcd.Client,
 	}
 	return nil
 }
-
-func workerClients(ctx context.Context, id string, etcdClient *etcd.Client, etcdPrefix string) ([]workerpkg.WorkerClient, error) {
-	resp, err := etcdClient.Get(ctx, path.Join(etcdPrefix, workerEtcdPrefix, id), etcd.WithPrefix())
-	if err != nil {
-		return nil, err
-	}
-
-	var result []workerpkg.WorkerClient
-	for _, kv := range resp.Kvs {
-		conn, err := grpc.Dial(fmt.Sprintf("%s:%d", path.Base(string(kv.Key)), client.PPSWorkerPort),
-			client.PachDialOptions()...)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, workerpkg.NewWorkerClient(conn))
-	}
-	return result, nil
-}
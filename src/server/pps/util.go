@@ -3,7 +3,11 @@ package pps
 import (
 	"fmt"
 	"math"
+	"path"
 	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kube "k8s.io/client-go/kubernetes"
@@ -12,6 +16,10 @@ import (
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 )
 
+// DefaultDatumTries is the number of times a datum is tried when the pipeline
+// does not specify datum_tries.
+const DefaultDatumTries = 3
+
 // JobRepo creates a pfs repo for a given job.
 func JobRepo(job *ppsclient.Job) *pfs.Repo {
 	return &pfs.Repo{Name: fmt.Sprintf("job_%s", job.ID)}
@@ -22,6 +30,72 @@ func PipelineRepo(pipeline *ppsclient.Pipeline) *pfs.Repo {
 	return &pfs.Repo{Name: pipeline.Name}
 }
 
+// PipelineReqFromInfo converts a PipelineInfo into the CreatePipelineRequest
+// (i.e. the spec) that it was created from.
+func PipelineReqFromInfo(pipelineInfo *ppsclient.PipelineInfo) *ppsclient.CreatePipelineRequest {
+	return &ppsclient.CreatePipelineRequest{
+		Pipeline:           pipelineInfo.Pipeline,
+		Transform:          pipelineInfo.Transform,
+		ParallelismSpec:    pipelineInfo.ParallelismSpec,
+		Egress:             pipelineInfo.Egress,
+		OutputBranch:       pipelineInfo.OutputBranch,
+		ScaleDownThreshold: pipelineInfo.ScaleDownThreshold,
+		ResourceRequests:   pipelineInfo.ResourceRequests,
+		ResourceLimits:     pipelineInfo.ResourceLimits,
+		Input:              pipelineInfo.Input,
+		Description:        pipelineInfo.Description,
+		Incremental:        pipelineInfo.Incremental,
+		CacheSize:          pipelineInfo.CacheSize,
+		EnableStats:        pipelineInfo.EnableStats,
+		Batch:              pipelineInfo.Batch,
+		MaxQueueSize:       pipelineInfo.MaxQueueSize,
+		Service:            pipelineInfo.Service,
+		ChunkSpec:          pipelineInfo.ChunkSpec,
+		DatumTimeout:       pipelineInfo.DatumTimeout,
+		JobTimeout:         pipelineInfo.JobTimeout,
+		DatumTries:         pipelineInfo.DatumTries,
+		SkipFailedDatums:   pipelineInfo.SkipFailedDatums,
+		Spout:              pipelineInfo.Spout,
+		Template:           pipelineInfo.Template,
+	}
+}
+
+// SetInputDefaults fills in the defaults of every input in 'input', naming
+// cron repos after 'pipelineName'.
+func SetInputDefaults(pipelineName string, input *ppsclient.Input) {
+	now := time.Now()
+	ppsclient.VisitInput(input, func(input *ppsclient.Input) {
+		if input.Atom != nil {
+			if input.Atom.Branch == "" {
+				input.Atom.Branch = "master"
+			}
+			if input.Atom.Name == "" {
+				input.Atom.Name = input.Atom.Repo
+			}
+		}
+		if input.Cron != nil {
+			if input.Cron.Start == nil {
+				start, _ := types.TimestampProto(now)
+				input.Cron.Start = start
+			}
+			if input.Cron.Repo == "" {
+				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
+			}
+		}
+		if input.Git != nil {
+			if input.Git.Branch == "" {
+				input.Git.Branch = "master"
+			}
+			if input.Git.Name == "" {
+				// We know URL looks like:
+				// "https://github.com/sjezewski/testgithook.git",
+				tokens := strings.Split(path.Base(input.Git.URL), ".")
+				input.Git.Name = tokens[0]
+			}
+		}
+	})
+}
+
 // PipelineRcName generates the name of the k8s replication controller that
 // manages a pipeline's workers
 func PipelineRcName(name string, version uint64) string {
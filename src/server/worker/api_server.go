@@ -13,6 +13,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"runtime/pprof"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,6 +40,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
@@ -58,8 +60,6 @@ const (
 
 	chunksPrefix = "/chunks"
 	lockPrefix   = "/locks"
-
-	maxRetries = 3
 )
 
 var (
@@ -120,6 +120,12 @@ type APIServer struct {
 	uid        uint32
 	gid        uint32
 	workingDir string
+
+	// scratchSpace is where datums are downloaded to
+	scratchSpace string
+	// local is true when datums are processed by RunLocal, in which case user
+	// code runs as the current user and /pfs isn't mounted
+	local bool
 }
 
 type putObjectResponse struct {
@@ -297,12 +303,13 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 			PipelineName: pipelineInfo.Pipeline.Name,
 			WorkerID:     os.Getenv(client.PPSPodNameEnv),
 		},
-		workerName: workerName,
-		namespace:  namespace,
-		jobs:       ppsdb.Jobs(etcdClient, etcdPrefix),
-		pipelines:  ppsdb.Pipelines(etcdClient, etcdPrefix),
-		chunks:     col.NewCollection(etcdClient, path.Join(etcdPrefix, chunksPrefix), []col.Index{}, &Chunks{}, nil),
-		datumCache: datumCache,
+		workerName:   workerName,
+		namespace:    namespace,
+		jobs:         ppsdb.Jobs(etcdClient, etcdPrefix),
+		pipelines:    ppsdb.Pipelines(etcdClient, etcdPrefix),
+		chunks:       col.NewCollection(etcdClient, path.Join(etcdPrefix, chunksPrefix), []col.Index{}, &Chunks{}, nil),
+		datumCache:   datumCache,
+		scratchSpace: client.PPSScratchSpace,
 	}
 	logger, err := server.getTaggedLogger(context.Background(), "", nil, false)
 	if err != nil {
@@ -349,7 +356,7 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 			server.pipelineInfo.Transform.Cmd = image.Config.Entrypoint
 		}
 	}
-	if pipelineInfo.Service == nil {
+	if pipelineInfo.Service == nil && pipelineInfo.Spout == nil {
 		go server.master()
 	} else {
 		go server.serviceMaster()
@@ -358,7 +365,14 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 	return server, nil
 }
 
-func (a *APIServer) downloadData(logger *taggedLogger, inputs []*Input, puller *filesync.Puller, parentTag *pfs.Tag, stats *pps.ProcessStats, statsTree hashtree.OpenHashTree, statsPath string) (string, error) {
+func (a *APIServer) downloadData(ctx context.Context, logger *taggedLogger, inputs []*Input, puller *filesync.Puller, parentTag *pfs.Tag, stats *pps.ProcessStats, statsTree hashtree.OpenHashTree, statsPath string) (_ string, retErr error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "worker.downloadData")
+	defer func() {
+		tracing.SetError(span, retErr)
+		span.Finish()
+	}()
+	// pachClient propagates the trace in ctx to pachd
+	pachClient := a.pachClient.WithCtx(ctx)
 	defer func(start time.Time) {
 		stats.DownloadTime = types.DurationProto(time.Since(start))
 	}(time.Now())
@@ -366,13 +380,13 @@ func (a *APIServer) downloadData(logger *taggedLogger, inputs []*Input, puller *
 	defer func(start time.Time) {
 		logger.Logf("input data download took (%v)", time.Since(start))
 	}(time.Now())
-	dir := filepath.Join(client.PPSScratchSpace, uuid.NewWithoutDashes())
+	dir := filepath.Join(a.scratchSpace, uuid.NewWithoutDashes())
 	for _, input := range inputs {
 		file := input.FileInfo.File
 		root := filepath.Join(dir, input.Name, file.Path)
 		treeRoot := path.Join(statsPath, input.Name, file.Path)
 		if a.pipelineInfo.Incremental && input.ParentCommit != nil {
-			if err := puller.PullDiff(a.pachClient, root,
+			if err := puller.PullDiff(pachClient, root,
 				file.Commit.Repo.Name, file.Commit.ID, file.Path,
 				input.ParentCommit.Repo.Name, input.ParentCommit.ID, file.Path,
 				true, input.Lazy, input.EmptyFiles, concurrency, statsTree, treeRoot); err != nil {
@@ -381,7 +395,7 @@ func (a *APIServer) downloadData(logger *taggedLogger, inputs []*Input, puller *
 		} else if input.GitURL != "" {
 			pachydermRepoName := input.Name
 			var rawJSON bytes.Buffer
-			err := a.pachClient.GetFile(pachydermRepoName, file.Commit.ID, "commit.json", 0, 0, &rawJSON)
+			err := pachClient.GetFile(pachydermRepoName, file.Commit.ID, "commit.json", 0, 0, &rawJSON)
 			if err != nil {
 				return "", err
 			}
@@ -414,21 +428,21 @@ func (a *APIServer) downloadData(logger *taggedLogger, inputs []*Input, puller *
 				return "", fmt.Errorf("error checking out SHA %v from repo %v: %v", sha, pachydermRepoName, err)
 			}
 		} else {
-			if err := puller.Pull(a.pachClient, root, file.Commit.Repo.Name, file.Commit.ID, file.Path, input.Lazy, input.EmptyFiles, concurrency, statsTree, treeRoot); err != nil {
+			if err := puller.Pull(pachClient, root, file.Commit.Repo.Name, file.Commit.ID, file.Path, input.Lazy, input.EmptyFiles, concurrency, statsTree, treeRoot); err != nil {
 				return "", err
 			}
 		}
 	}
 	if parentTag != nil {
 		var buffer bytes.Buffer
-		if err := a.pachClient.GetTag(parentTag.Name, &buffer); err != nil {
+		if err := pachClient.GetTag(parentTag.Name, &buffer); err != nil {
 			return "", fmt.Errorf("error getting parent for datum %v: %v", inputs, err)
 		}
 		tree, err := hashtree.Deserialize(buffer.Bytes())
 		if err != nil {
 			return "", fmt.Errorf("failed to deserialize parent hashtree: %v", err)
 		}
-		if err := puller.PullTree(a.pachClient, path.Join(dir, "out"), tree, false, concurrency); err != nil {
+		if err := puller.PullTree(pachClient, path.Join(dir, "out"), tree, false, concurrency); err != nil {
 			return "", fmt.Errorf("error pulling output tree: %+v", err)
 		}
 	}
@@ -437,6 +451,11 @@ func (a *APIServer) downloadData(logger *taggedLogger, inputs []*Input, puller *
 
 // Run user code and return the combined output of stdout and stderr.
 func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, environ []string, stats *pps.ProcessStats, rawDatumTimeout *types.Duration) (retErr error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "worker.runUserCode")
+	defer func() {
+		tracing.SetError(span, retErr)
+		span.Finish()
+	}()
 
 	defer func(start time.Time) {
 		stats.ProcessTime = types.DurationProto(time.Since(start))
@@ -461,12 +480,7 @@ func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, envir
 	cmd.Stdout = logger.userLogger()
 	cmd.Stderr = logger.userLogger()
 	cmd.Env = environ
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Credential: &syscall.Credential{
-			Uid: a.uid,
-			Gid: a.gid,
-		},
-	}
+	cmd.SysProcAttr = a.userCodeSysProcAttr()
 	cmd.Dir = a.workingDir
 	err := cmd.Start()
 	if err != nil {
@@ -503,12 +517,55 @@ func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, envir
 				}
 			}
 		}
+		if len(a.pipelineInfo.Transform.ErrCmd) > 0 {
+			logger.Logf("user code failed with error (%v), running error handler", err)
+			if err := a.runUserErrorHandlingCode(ctx, logger, environ); err != nil {
+				logger.Logf("error handler failed with error (%v)", err)
+				return err
+			}
+			logger.Logf("error handler succeeded, datum recovered")
+			return nil
+		}
 		return err
 	}
 	return nil
 }
 
+// runUserErrorHandlingCode runs Transform.ErrCmd in the same environment as
+// the user code. If it exits successfully the datum is considered recovered,
+// and whatever it wrote to /pfs/out is uploaded as the datum's output.
+func (a *APIServer) runUserErrorHandlingCode(ctx context.Context, logger *taggedLogger, environ []string) error {
+	cmd := exec.CommandContext(ctx, a.pipelineInfo.Transform.ErrCmd[0], a.pipelineInfo.Transform.ErrCmd[1:]...)
+	cmd.Stdin = strings.NewReader(strings.Join(a.pipelineInfo.Transform.ErrStdin, "\n") + "\n")
+	cmd.Stdout = logger.userLogger()
+	cmd.Stderr = logger.userLogger()
+	cmd.Env = environ
+	cmd.SysProcAttr = a.userCodeSysProcAttr()
+	cmd.Dir = a.workingDir
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	state, err := cmd.Process.Wait()
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	default:
+	}
+	// See runUserCode for why we use WaitIO rather than cmd.Wait()
+	return cmd.WaitIO(state, err)
+}
+
 func (a *APIServer) uploadOutput(ctx context.Context, dir string, tag string, logger *taggedLogger, inputs []*Input, stats *pps.ProcessStats, statsTree hashtree.OpenHashTree, statsRoot string) (retErr error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "worker.uploadOutput", "tag", tag)
+	defer func() {
+		tracing.SetError(span, retErr)
+		span.Finish()
+	}()
 	defer func(start time.Time) {
 		stats.UploadTime = types.DurationProto(time.Since(start))
 	}(time.Now())
@@ -776,6 +833,19 @@ func (a *APIServer) Cancel(ctx context.Context, request *CancelRequest) (*Cancel
 	return &CancelResponse{Success: true}, nil
 }
 
+// Profile streams one of the worker's pprof profiles
+func (a *APIServer) Profile(request *ProfileRequest, server Worker_ProfileServer) error {
+	profile := pprof.Lookup(request.Profile)
+	if profile == nil {
+		return fmt.Errorf("unknown profile %q", request.Profile)
+	}
+	var buf bytes.Buffer
+	if err := profile.WriteTo(&buf, int(request.Debug)); err != nil {
+		return err
+	}
+	return grpcutil.WriteToStreamingBytesServer(&buf, server)
+}
+
 func (a *APIServer) datum() []*pps.InputFile {
 	var result []*pps.InputFile
 	for _, datum := range a.data {
@@ -787,6 +857,21 @@ func (a *APIServer) datum() []*pps.InputFile {
 	return result
 }
 
+// userCodeSysProcAttr returns the attributes user code is run with. Workers
+// run it as the user of the pipeline's image, RunLocal runs it as the user
+// who called it.
+func (a *APIServer) userCodeSysProcAttr() *syscall.SysProcAttr {
+	if a.local {
+		return nil
+	}
+	return &syscall.SysProcAttr{
+		Credential: &syscall.Credential{
+			Uid: a.uid,
+			Gid: a.gid,
+		},
+	}
+}
+
 func (a *APIServer) userCodeEnv(jobID string, data []*Input) []string {
 	result := os.Environ()
 	for _, input := range data {
@@ -1013,6 +1098,12 @@ func (a *APIServer) processDatums(ctx context.Context, logger *taggedLogger, job
 		i := i
 		eg.Go(func() (retErr error) {
 			data := df.Datum(int(i))
+			span, ctx := tracing.StartSpanFromContext(ctx, "worker.processDatum",
+				"job", jobInfo.Job.ID, "datum", a.DatumID(data))
+			defer func() {
+				tracing.SetError(span, retErr)
+				span.Finish()
+			}()
 			logger, err := a.getTaggedLogger(ctx, jobInfo.Job.ID, data, a.pipelineInfo.EnableStats)
 			if err != nil {
 				return err
@@ -1133,7 +1224,14 @@ func (a *APIServer) processDatums(ctx context.Context, logger *taggedLogger, job
 			env := a.userCodeEnv(jobInfo.Job.ID, data)
 			atomic.AddInt64(&a.queueSize, -1)
 			var dir string
-			var retries int
+			var retries int64
+			datumTries := jobInfo.DatumTries
+			if datumTries == 0 {
+				datumTries = ppsserver.DefaultDatumTries
+			}
+			// Retries back off exponentially but are bounded only by datumTries.
+			b := backoff.NewExponentialBackOff()
+			b.MaxElapsedTime = 0
 			if err := backoff.RetryNotify(func() error {
 				// If the context is already cancelled (timeout, cancelled job), don't run datum
 				select {
@@ -1145,7 +1243,7 @@ func (a *APIServer) processDatums(ctx context.Context, logger *taggedLogger, job
 				puller := filesync.NewPuller()
 				// TODO parent tag shouldn't be nil
 				var err error
-				dir, err = a.downloadData(logger, data, puller, parentTag, subStats, statsTree, path.Join(statsPath, "pfs"))
+				dir, err = a.downloadData(ctx, logger, data, puller, parentTag, subStats, statsTree, path.Join(statsPath, "pfs"))
 				// We run these cleanup functions no matter what, so that if
 				// downloadData partially succeeded, we still clean up the resources.
 				defer func() {
@@ -1207,7 +1305,7 @@ func (a *APIServer) processDatums(ctx context.Context, logger *taggedLogger, job
 				}
 				atomic.AddUint64(&subStats.DownloadBytes, uint64(downSize))
 				return a.uploadOutput(ctx, dir, tag, logger, data, subStats, statsTree, path.Join(statsPath, "pfs", "out"))
-			}, &backoff.ZeroBackOff{}, func(err error, d time.Duration) error {
+			}, b, func(err error, d time.Duration) error {
 				// If the context is already cancelled (timeout, cancelled job),
 				// err out and don't retry
 				select {
@@ -1216,7 +1314,7 @@ func (a *APIServer) processDatums(ctx context.Context, logger *taggedLogger, job
 				default:
 				}
 				retries++
-				if retries >= maxRetries {
+				if retries >= datumTries {
 					logger.Logf("failed to process datum with error: %+v", err)
 					if statsTree != nil {
 						object, size, err := a.pachClient.PutObject(strings.NewReader(err.Error()))
@@ -1237,6 +1335,7 @@ func (a *APIServer) processDatums(ctx context.Context, logger *taggedLogger, job
 				failed++
 				return nil
 			}
+			observeDatumStats(a.pipelineInfo.Pipeline.Name, subStats)
 			statsMu.Lock()
 			defer statsMu.Unlock()
 			if err := mergeStats(stats, subStats); err != nil {
@@ -1255,7 +1354,7 @@ func (a *APIServer) processDatums(ctx context.Context, logger *taggedLogger, job
 		if err := jobs.Get(jobID, jobInfo); err != nil {
 			return err
 		}
-		jobInfo.DataProcessed += high - low - skipped
+		jobInfo.DataProcessed += high - low - skipped - failed
 		jobInfo.DataSkipped += skipped
 		jobInfo.DataFailed += failed
 		if jobInfo.Stats == nil {
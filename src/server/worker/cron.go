@@ -0,0 +1,97 @@
+package worker
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/robfig/cron"
+
+	"golang.org/x/net/context"
+)
+
+// runCrons starts a goroutine for each of the pipeline's cron inputs, which
+// commits the current time to the input's repo whenever the input's schedule
+// fires. PFS then opens a commit in the pipeline's output branch, as the
+// cron repo is in its provenance. Errors from the goroutines are sent to
+// errCh.
+func (a *APIServer) runCrons(ctx context.Context, pachClient *client.APIClient, errCh chan<- error) error {
+	var visitErr error
+	pps.VisitInput(a.pipelineInfo.Input, func(input *pps.Input) {
+		if input.Cron == nil || visitErr != nil {
+			return
+		}
+		schedule, err := cron.Parse(input.Cron.Spec)
+		// it shouldn't be possible to error here because we validate the spec in CreatePipeline
+		if err != nil {
+			visitErr = err
+			return
+		}
+		tstamp := &types.Timestamp{}
+		var buffer bytes.Buffer
+		if err := pachClient.GetFile(input.Cron.Repo, "master", "time", 0, 0, &buffer); err != nil && !isNotFoundErr(err) {
+			visitErr = err
+			return
+		} else if err != nil {
+			// File not found, this happens the first time the pipeline is run
+			tstamp = input.Cron.Start
+		} else {
+			if err := jsonpb.UnmarshalString(buffer.String(), tstamp); err != nil {
+				visitErr = err
+				return
+			}
+		}
+		t, err := types.TimestampFromProto(tstamp)
+		if err != nil {
+			visitErr = err
+			return
+		}
+		repo := input.Cron.Repo
+		go func() {
+			for {
+				nextT := schedule.Next(t)
+				t = nextT
+				select {
+				case <-time.After(time.Until(nextT)):
+				case <-ctx.Done():
+					return
+				}
+				if err := commitTime(pachClient, repo, nextT); err != nil {
+					select {
+					case <-ctx.Done():
+					case errCh <- err:
+					}
+					return
+				}
+			}
+		}()
+	})
+	return visitErr
+}
+
+// commitTime replaces the file "time" in the master branch of 'repo' (a cron
+// input's repo) with 't'.
+func commitTime(pachClient *client.APIClient, repo string, t time.Time) error {
+	if _, err := pachClient.StartCommit(repo, "master"); err != nil {
+		return err
+	}
+	timestamp, err := types.TimestampProto(t)
+	if err != nil {
+		return err
+	}
+	timeString, err := (&jsonpb.Marshaler{}).MarshalToString(timestamp)
+	if err != nil {
+		return err
+	}
+	if err := pachClient.DeleteFile(repo, "master", "time"); err != nil {
+		return err
+	}
+	if _, err := pachClient.PutFile(repo, "master", "time", strings.NewReader(timeString)); err != nil {
+		return err
+	}
+	return pachClient.FinishCommit(repo, "master")
+}
@@ -1,9 +1,13 @@
 package worker
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"regexp"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
@@ -132,6 +136,276 @@ func (d *crossDatumFactory) Datum(i int) []*Input {
 	return result
 }
 
+type joinDatumFactory struct {
+	datums [][]*Input
+}
+
+func newJoinDatumFactory(ctx context.Context, pfsClient pfs.APIClient, join []*pps.Input) (DatumFactory, error) {
+	// keys maps each join key to the files in each input that produce it
+	keys := make(map[string][][]*Input)
+	for i, input := range join {
+		if input.Atom == nil {
+			return nil, fmt.Errorf("join inputs must be atom inputs")
+		}
+		inputKeys, err := keyInputs(ctx, pfsClient, input.Atom, input.Atom.JoinOn)
+		if err != nil {
+			return nil, err
+		}
+		for key, inputs := range inputKeys {
+			if keys[key] == nil {
+				keys[key] = make([][]*Input, len(join))
+			}
+			keys[key][i] = inputs
+		}
+	}
+	var sortedKeys []string
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+	result := &joinDatumFactory{}
+	for _, key := range sortedKeys {
+		// A key produces datums if every input matched it (an inner join), or
+		// if one of the inputs that matched it is an outer join.
+		matched := true
+		outer := false
+		for i, inputs := range keys[key] {
+			if len(inputs) == 0 {
+				matched = false
+			} else if join[i].Atom.OuterJoin {
+				outer = true
+			}
+		}
+		if !matched && !outer {
+			continue
+		}
+		// Emit the cross product of the inputs that matched the key
+		datums := [][]*Input{nil}
+		for _, inputs := range keys[key] {
+			if len(inputs) == 0 {
+				continue
+			}
+			var next [][]*Input
+			for _, datum := range datums {
+				for _, input := range inputs {
+					next = append(next, append(append([]*Input{}, datum...), input))
+				}
+			}
+			datums = next
+		}
+		result.datums = append(result.datums, datums...)
+	}
+	return result, nil
+}
+
+func (d *joinDatumFactory) Len() int {
+	return len(d.datums)
+}
+
+func (d *joinDatumFactory) Datum(i int) []*Input {
+	return d.datums[i]
+}
+
+type groupDatumFactory struct {
+	datums [][]*Input
+}
+
+func newGroupDatumFactory(ctx context.Context, pfsClient pfs.APIClient, group []*pps.Input) (DatumFactory, error) {
+	keys := make(map[string][]*Input)
+	for _, input := range group {
+		if input.Atom == nil {
+			return nil, fmt.Errorf("group inputs must be atom inputs")
+		}
+		inputKeys, err := keyInputs(ctx, pfsClient, input.Atom, input.Atom.GroupBy)
+		if err != nil {
+			return nil, err
+		}
+		for key, inputs := range inputKeys {
+			keys[key] = append(keys[key], inputs...)
+		}
+	}
+	var sortedKeys []string
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+	result := &groupDatumFactory{}
+	for _, key := range sortedKeys {
+		result.datums = append(result.datums, keys[key])
+	}
+	return result, nil
+}
+
+func (d *groupDatumFactory) Len() int {
+	return len(d.datums)
+}
+
+func (d *groupDatumFactory) Datum(i int) []*Input {
+	return d.datums[i]
+}
+
+// keyInputs globs the files of 'input', whose glob may contain capture
+// groups, and maps each file to the key that 'keyExpr' expands to for it.
+// Files that are matched by the plain glob but not by the capture groups are
+// left out.
+func keyInputs(ctx context.Context, pfsClient pfs.APIClient, input *pps.AtomInput, keyExpr string) (map[string][]*Input, error) {
+	glob, re, err := joinGlob(input.Glob)
+	if err != nil {
+		return nil, err
+	}
+	atom := *input
+	atom.Glob = glob
+	datumFactory, err := newAtomDatumFactory(ctx, pfsClient, &atom)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]*Input)
+	for i := 0; i < datumFactory.Len(); i++ {
+		for _, datumInput := range datumFactory.Datum(i) {
+			path := datumInput.FileInfo.File.Path
+			if !strings.HasPrefix(path, "/") {
+				path = "/" + path
+			}
+			match := re.FindStringSubmatchIndex(path)
+			if match == nil {
+				continue
+			}
+			key := string(re.ExpandString(nil, keyExpr, path, match))
+			result[key] = append(result[key], datumInput)
+		}
+	}
+	return result, nil
+}
+
+// joinGlob splits a glob with capture groups, such as "/(*).png", into the
+// plain glob that's used to find files in PFS and a regexp that matches the
+// same paths, and whose submatches are the capture groups.
+func joinGlob(glob string) (string, *regexp.Regexp, error) {
+	if !strings.HasPrefix(glob, "/") {
+		glob = "/" + glob
+	}
+	var plain, re bytes.Buffer
+	re.WriteString("^")
+	parens, braces := 0, 0
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch c {
+		case '\\':
+			if i+1 == len(glob) {
+				return "", nil, fmt.Errorf("glob %q ends with an escape", glob)
+			}
+			i++
+			plain.WriteByte(c)
+			plain.WriteByte(glob[i])
+			re.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			continue
+		case '(':
+			parens++
+			re.WriteByte('(')
+			continue
+		case ')':
+			if parens == 0 {
+				return "", nil, fmt.Errorf("glob %q has an unmatched ')'", glob)
+			}
+			parens--
+			re.WriteByte(')')
+			continue
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				plain.WriteByte(c)
+				i++
+				re.WriteString(".*")
+			} else {
+				re.WriteString("[^/]*")
+			}
+		case '?':
+			re.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return "", nil, fmt.Errorf("glob %q has an unmatched '['", glob)
+			}
+			class := glob[i+1 : i+1+end]
+			plain.WriteString(glob[i : i+1+end])
+			i += end + 1
+			c = glob[i]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + class + "]")
+		case '{':
+			braces++
+			re.WriteString("(?:")
+		case '}':
+			if braces == 0 {
+				return "", nil, fmt.Errorf("glob %q has an unmatched '}'", glob)
+			}
+			braces--
+			re.WriteByte(')')
+		case ',':
+			if braces > 0 {
+				re.WriteByte('|')
+			} else {
+				re.WriteString(regexp.QuoteMeta(","))
+			}
+		default:
+			re.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+		plain.WriteByte(c)
+	}
+	if parens != 0 {
+		return "", nil, fmt.Errorf("glob %q has an unmatched '('", glob)
+	}
+	if braces != 0 {
+		return "", nil, fmt.Errorf("glob %q has an unmatched '{'", glob)
+	}
+	re.WriteString("$")
+	result, err := regexp.Compile(re.String())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid glob %q: %v", glob, err)
+	}
+	return plain.String(), result, nil
+}
+
+var keyExprGroupRe = regexp.MustCompile(`\$\{?([0-9]+)\}?`)
+
+// ValidateJoinInput checks that the glob of an atom input that's part of a
+// join has valid capture groups, and that its JoinOn expression only
+// references capture groups that exist.
+func ValidateJoinInput(input *pps.AtomInput) error {
+	if input.JoinOn == "" {
+		return fmt.Errorf("join input %s must specify join_on", input.Name)
+	}
+	return validateKeyExpr(input, "join_on", input.JoinOn)
+}
+
+// ValidateGroupInput checks that the glob of an atom input that's part of a
+// group has valid capture groups, and that its GroupBy expression only
+// references capture groups that exist.
+func ValidateGroupInput(input *pps.AtomInput) error {
+	if input.GroupBy == "" {
+		return fmt.Errorf("group input %s must specify group_by", input.Name)
+	}
+	return validateKeyExpr(input, "group_by", input.GroupBy)
+}
+
+func validateKeyExpr(input *pps.AtomInput, field string, keyExpr string) error {
+	_, re, err := joinGlob(input.Glob)
+	if err != nil {
+		return err
+	}
+	for _, match := range keyExprGroupRe.FindAllStringSubmatch(keyExpr, -1) {
+		group, err := strconv.Atoi(match[1])
+		if err != nil {
+			return err
+		}
+		if group > re.NumSubexp() {
+			return fmt.Errorf("%s %q of input %s references capture group %d, but glob %q only has %d", field, keyExpr, input.Name, group, input.Glob, re.NumSubexp())
+		}
+	}
+	return nil
+}
+
 type gitDatumFactory struct {
 	inputs []*Input
 	index  int
@@ -207,6 +481,10 @@ func NewDatumFactory(ctx context.Context, pfsClient pfs.APIClient, input *pps.In
 		return newUnionDatumFactory(ctx, pfsClient, input.Union)
 	case input.Cross != nil:
 		return newCrossDatumFactory(ctx, pfsClient, input.Cross)
+	case input.Join != nil:
+		return newJoinDatumFactory(ctx, pfsClient, input.Join)
+	case input.Group != nil:
+		return newGroupDatumFactory(ctx, pfsClient, input.Group)
 	case input.Cron != nil:
 		return newCronDatumFactory(ctx, pfsClient, input.Cron)
 	case input.Git != nil:
@@ -214,3 +492,36 @@ func NewDatumFactory(ctx context.Context, pfsClient pfs.APIClient, input *pps.In
 	}
 	return nil, fmt.Errorf("unrecognized input type")
 }
+
+// PinInputCommits sets the commit of every input in 'input' that doesn't
+// already name one to the current head of the input's branch.
+func PinInputCommits(pachClient *client.APIClient, input *pps.Input) error {
+	var headErr error
+	pps.VisitInput(input, func(input *pps.Input) {
+		if headErr != nil {
+			return
+		}
+		var repo, branch string
+		var commit *string
+		switch {
+		case input.Atom != nil:
+			repo, branch, commit = input.Atom.Repo, input.Atom.Branch, &input.Atom.Commit
+		case input.Cron != nil:
+			repo, branch, commit = input.Cron.Repo, "master", &input.Cron.Commit
+		case input.Git != nil:
+			repo, branch, commit = input.Git.Name, input.Git.Branch, &input.Git.Commit
+		default:
+			return
+		}
+		if *commit != "" {
+			return
+		}
+		commitInfo, err := pachClient.InspectCommit(repo, branch)
+		if err != nil {
+			headErr = err
+			return
+		}
+		*commit = commitInfo.Commit.ID
+	})
+	return headErr
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestJoinGlob(t *testing.T) {
+	glob, re, err := joinGlob("/(*)/(*).png")
+	require.NoError(t, err)
+	require.Equal(t, "/*/*.png", glob)
+	require.Equal(t, []string{"/a/123.png", "a", "123"}, re.FindStringSubmatch("/a/123.png"))
+	require.Nil(t, re.FindStringSubmatch("/a/b/123.png"))
+	require.Nil(t, re.FindStringSubmatch("/a/123.json"))
+
+	glob, re, err = joinGlob("labels/(**).{json,yaml}")
+	require.NoError(t, err)
+	require.Equal(t, "/labels/**.{json,yaml}", glob)
+	require.Equal(t, []string{"/labels/a/123.yaml", "a/123"}, re.FindStringSubmatch("/labels/a/123.yaml"))
+
+	glob, re, err = joinGlob("/([!a]?)")
+	require.NoError(t, err)
+	require.Equal(t, "/[!a]?", glob)
+	require.Equal(t, []string{"/b1", "b1"}, re.FindStringSubmatch("/b1"))
+	require.Nil(t, re.FindStringSubmatch("/a1"))
+
+	_, _, err = joinGlob("/(*")
+	require.YesError(t, err)
+	_, _, err = joinGlob("/*)")
+	require.YesError(t, err)
+
+	require.NoError(t, ValidateJoinInput(&pps.AtomInput{Glob: "/(*)-(*)", JoinOn: "$2"}))
+	require.YesError(t, ValidateJoinInput(&pps.AtomInput{Glob: "/(*)", JoinOn: "$2"}))
+	require.YesError(t, ValidateJoinInput(&pps.AtomInput{Glob: "/(*)"}))
+}
+
+func TestValidateGroupInput(t *testing.T) {
+	require.NoError(t, ValidateGroupInput(&pps.AtomInput{Glob: "/(*)-*", GroupBy: "$1"}))
+	require.YesError(t, ValidateGroupInput(&pps.AtomInput{Glob: "/(*)-*", GroupBy: "$2"}))
+	require.YesError(t, ValidateGroupInput(&pps.AtomInput{Glob: "/(*)-*", JoinOn: "$1"}))
+}
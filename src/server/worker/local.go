@@ -0,0 +1,421 @@
+package worker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+)
+
+// LocalOptions configures RunLocal.
+type LocalOptions struct {
+	// InputDir, if set, is a local directory whose top-level directories
+	// stand in for the repos the pipeline's inputs read from. If it's not set
+	// the inputs are read from pachd, at the heads of their branches.
+	InputDir string
+	// OutputDir is the directory that the output of every datum is written
+	// to, as it would be in the pipeline's output commit.
+	OutputDir string
+	// ScratchDir is where datums are downloaded to while they're processed,
+	// it defaults to the OS's temp directory.
+	ScratchDir string
+}
+
+// RunLocal processes the datums of the pipeline in 'request' one at a time,
+// running its user code as a local process rather than in a worker pod. The
+// datums are computed and downloaded exactly as they are in a worker, but as
+// /pfs isn't mounted, references to /pfs in the transform's cmd, stdin and
+// the input environment variables are rewritten to point at the datum's
+// directory instead.
+//
+// If options.InputDir is empty, pachClient is used to read the inputs. The
+// output is only ever written to options.OutputDir: nothing is uploaded to
+// pachd's object store or committed to the pipeline's output repo.
+func RunLocal(ctx context.Context, pachClient *client.APIClient, request *pps.CreatePipelineRequest, options *LocalOptions) (retErr error) {
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:         request.Pipeline,
+		Transform:        request.Transform,
+		Input:            request.Input,
+		Service:          request.Service,
+		Spout:            request.Spout,
+		DatumTimeout:     request.DatumTimeout,
+		SkipFailedDatums: request.SkipFailedDatums,
+	}
+	if pipelineInfo.Pipeline == nil || pipelineInfo.Transform == nil {
+		return fmt.Errorf("pipeline spec must include a pipeline name and a transform")
+	}
+	ppsserver.SetInputDefaults(pipelineInfo.Pipeline.Name, pipelineInfo.Input)
+	if pipelineInfo.Input == nil || pipelineInfo.Service != nil || pipelineInfo.Spout != nil {
+		return fmt.Errorf("pipeline %s doesn't process datums, only pipelines with an input can be run locally", pipelineInfo.Pipeline.Name)
+	}
+	if len(pipelineInfo.Transform.Cmd) == 0 {
+		return fmt.Errorf("pipeline %s must set transform.cmd to be run locally", pipelineInfo.Pipeline.Name)
+	}
+	if options.InputDir != "" {
+		pfsClient, err := newLocalPfsAPIClient(options.InputDir)
+		if err != nil {
+			return err
+		}
+		pachClient = &client.APIClient{PfsAPIClient: pfsClient}
+	} else if err := PinInputCommits(pachClient, pipelineInfo.Input); err != nil {
+		return err
+	}
+	scratchDir := options.ScratchDir
+	if scratchDir == "" {
+		scratchDir = os.TempDir()
+	}
+	scratchSpace, err := ioutil.TempDir(scratchDir, "pachyderm-run-local")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(scratchSpace); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	a := &APIServer{
+		pachClient:   pachClient,
+		pipelineInfo: pipelineInfo,
+		logMsgTemplate: pps.LogMessage{
+			PipelineName: pipelineInfo.Pipeline.Name,
+		},
+		scratchSpace: scratchSpace,
+		local:        true,
+	}
+	logger, err := a.getTaggedLogger(ctx, "", nil, false)
+	if err != nil {
+		return err
+	}
+	df, err := NewDatumFactory(ctx, pachClient.PfsAPIClient, pipelineInfo.Input)
+	if err != nil {
+		return err
+	}
+	if infos, err := ioutil.ReadDir(options.OutputDir); err == nil && len(infos) > 0 {
+		return fmt.Errorf("output directory %s must be empty", options.OutputDir)
+	}
+	if err := os.MkdirAll(options.OutputDir, 0755); err != nil {
+		return err
+	}
+	logger.Logf("processing %d datums locally", df.Len())
+	var failed int
+	for i := 0; i < df.Len(); i++ {
+		data := df.Datum(i)
+		if err := a.processLocalDatum(ctx, data, options.OutputDir); err != nil {
+			if !pipelineInfo.SkipFailedDatums {
+				return fmt.Errorf("error processing datum %v: %v", a.DatumID(data), err)
+			}
+			logger.Logf("skipping failed datum %v: %v", a.DatumID(data), err)
+			failed++
+		}
+	}
+	logger.Logf("processed %d datums, %d failed", df.Len()-failed, failed)
+	return nil
+}
+
+// processLocalDatum processes a single datum for RunLocal, appending its output
+// to the files in outputDir.
+func (a *APIServer) processLocalDatum(ctx context.Context, data []*Input, outputDir string) (retErr error) {
+	logger, err := a.getTaggedLogger(ctx, "", data, false)
+	if err != nil {
+		return err
+	}
+	stats := &pps.ProcessStats{}
+	puller := filesync.NewPuller()
+	dir, err := a.downloadData(ctx, logger, data, puller, nil, stats, nil, "")
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	defer func() {
+		if _, err := puller.CleanUp(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "out"), 0755); err != nil {
+		return err
+	}
+	transform := a.pipelineInfo.Transform
+	defer func() {
+		a.pipelineInfo.Transform = transform
+	}()
+	localTransform := *transform
+	localTransform.Cmd = rewritePfsPaths(transform.Cmd, dir)
+	localTransform.Stdin = rewritePfsPaths(transform.Stdin, dir)
+	localTransform.ErrCmd = rewritePfsPaths(transform.ErrCmd, dir)
+	localTransform.ErrStdin = rewritePfsPaths(transform.ErrStdin, dir)
+	a.pipelineInfo.Transform = &localTransform
+	env := rewritePfsPaths(a.userCodeEnv("", data), dir)
+	for name, value := range transform.Env {
+		env = append(env, fmt.Sprintf("%s=%s", name, value))
+	}
+	if err := a.runUserCode(ctx, logger, env, stats, a.pipelineInfo.DatumTimeout); err != nil {
+		return err
+	}
+	if _, err := puller.CleanUp(); err != nil {
+		return err
+	}
+	return appendOutput(filepath.Join(dir, "out"), outputDir)
+}
+
+// pfsPathRe matches references to /pfs that aren't part of a longer path.
+var pfsPathRe = regexp.MustCompile(`(^|[^\w./-])` + regexp.QuoteMeta(client.PPSInputPrefix) + `\b`)
+
+// rewritePfsPaths replaces references to /pfs in 'args' with 'dir'.
+func rewritePfsPaths(args []string, dir string) []string {
+	var result []string
+	for _, arg := range args {
+		result = append(result, pfsPathRe.ReplaceAllStringFunc(arg, func(match string) string {
+			return strings.TrimSuffix(match, client.PPSInputPrefix) + dir
+		}))
+	}
+	return result
+}
+
+// appendOutput appends the files under 'src' to the files of the same name
+// under 'dst', the same way that datums which write to the same path are
+// merged in an output commit.
+func appendOutput(src string, dst string) error {
+	return filepath.Walk(src, func(filePath string, info os.FileInfo, err error) (retErr error) {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, filePath)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dst, relPath)
+		if info.IsDir() {
+			return os.MkdirAll(dstPath, 0755)
+		}
+		r, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := r.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		w, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := w.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		_, err = io.Copy(w, r)
+		return err
+	})
+}
+
+// localPfsAPIClient serves the parts of the PFS API that the datum factories
+// and the puller use from a local directory. Each of the directory's
+// top-level directories is a repo with a single commit, so commit IDs are
+// ignored. Calls to the rest of the API panic.
+type localPfsAPIClient struct {
+	pfs.APIClient
+	root  string
+	trees map[string]hashtree.HashTree
+}
+
+func newLocalPfsAPIClient(root string) (*localPfsAPIClient, error) {
+	result := &localPfsAPIClient{
+		root:  root,
+		trees: make(map[string]hashtree.HashTree),
+	}
+	repoInfos, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, repoInfo := range repoInfos {
+		if !repoInfo.IsDir() {
+			continue
+		}
+		repoDir := filepath.Join(root, repoInfo.Name())
+		tree := hashtree.NewHashTree()
+		if err := filepath.Walk(repoDir, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			relPath, err := filepath.Rel(repoDir, filePath)
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return tree.PutDir(relPath)
+			}
+			// Symlinks are read as the files they point to
+			if info, err = os.Stat(filePath); err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			hash, err := hashLocalFile(filePath)
+			if err != nil {
+				return err
+			}
+			return tree.PutFile(relPath, []*pfs.Object{{Hash: hash}}, info.Size())
+		}); err != nil {
+			return nil, err
+		}
+		finishedTree, err := tree.Finish()
+		if err != nil {
+			return nil, err
+		}
+		result.trees[repoInfo.Name()] = finishedTree
+	}
+	return result, nil
+}
+
+func hashLocalFile(filePath string) (_ string, retErr error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (c *localPfsAPIClient) tree(commit *pfs.Commit) (hashtree.HashTree, error) {
+	tree, ok := c.trees[commit.Repo.Name]
+	if !ok {
+		return nil, fmt.Errorf("repo %s not found in %s", commit.Repo.Name, c.root)
+	}
+	return tree, nil
+}
+
+func (c *localPfsAPIClient) fileInfo(commit *pfs.Commit, path string, node *hashtree.NodeProto) *pfs.FileInfo {
+	fileInfo := &pfs.FileInfo{
+		File: &pfs.File{
+			Commit: commit,
+			Path:   path,
+		},
+		SizeBytes: uint64(node.SubtreeSize),
+		Hash:      node.Hash,
+	}
+	if node.DirNode != nil {
+		fileInfo.FileType = pfs.FileType_DIR
+		fileInfo.Children = node.DirNode.Children
+	} else {
+		fileInfo.FileType = pfs.FileType_FILE
+	}
+	return fileInfo
+}
+
+func (c *localPfsAPIClient) InspectFile(ctx context.Context, request *pfs.InspectFileRequest, opts ...grpc.CallOption) (*pfs.FileInfo, error) {
+	tree, err := c.tree(request.File.Commit)
+	if err != nil {
+		return nil, err
+	}
+	node, err := tree.Get(request.File.Path)
+	if err != nil {
+		return nil, err
+	}
+	return c.fileInfo(request.File.Commit, request.File.Path, node), nil
+}
+
+func (c *localPfsAPIClient) GlobFileStream(ctx context.Context, request *pfs.GlobFileRequest, opts ...grpc.CallOption) (pfs.API_GlobFileStreamClient, error) {
+	tree, err := c.tree(request.Commit)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := tree.Glob(request.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	result := &localGlobFileStream{}
+	// Local files have no metadata, so they can't match a metadata filter
+	if len(request.Metadata) == 0 {
+		for _, node := range nodes {
+			result.fileInfos = append(result.fileInfos, c.fileInfo(request.Commit, node.Name, node))
+		}
+	}
+	return result, nil
+}
+
+func (c *localPfsAPIClient) GetFile(ctx context.Context, request *pfs.GetFileRequest, opts ...grpc.CallOption) (pfs.API_GetFileClient, error) {
+	if _, err := c.tree(request.File.Commit); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(c.root, request.File.Commit.Repo.Name, path.Clean("/"+request.File.Path)))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(request.OffsetBytes, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	result := &localGetFileStream{f: f, r: f}
+	if request.SizeBytes > 0 {
+		result.r = io.LimitReader(f, request.SizeBytes)
+	}
+	return result, nil
+}
+
+type localGlobFileStream struct {
+	grpc.ClientStream
+	fileInfos []*pfs.FileInfo
+}
+
+func (s *localGlobFileStream) Recv() (*pfs.FileInfo, error) {
+	if len(s.fileInfos) == 0 {
+		return nil, io.EOF
+	}
+	fileInfo := s.fileInfos[0]
+	s.fileInfos = s.fileInfos[1:]
+	return fileInfo, nil
+}
+
+type localGetFileStream struct {
+	grpc.ClientStream
+	f *os.File
+	r io.Reader
+}
+
+func (s *localGetFileStream) Recv() (*types.BytesValue, error) {
+	buf := make([]byte, grpcutil.MaxMsgSize/10)
+	n, err := io.ReadFull(s.r, buf)
+	if n > 0 {
+		return &types.BytesValue{Value: buf[:n]}, nil
+	}
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	if closeErr := s.f.Close(); closeErr != nil && err == io.EOF {
+		err = closeErr
+	}
+	return nil, err
+}
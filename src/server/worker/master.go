@@ -1,9 +1,11 @@
 package worker
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path"
@@ -23,6 +25,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
@@ -166,6 +169,9 @@ func (a *APIServer) serviceMaster() {
 		if paused {
 			return fmt.Errorf("can't run master for a paused pipeline")
 		}
+		if a.pipelineInfo.Spout != nil {
+			return a.spoutSpawner(ctx, logger)
+		}
 		return a.serviceSpawner(ctx)
 	}, b, func(err error, d time.Duration) error {
 		logger.Logf("master: error running the master process: %v; retrying in %v", err, d)
@@ -173,49 +179,257 @@ func (a *APIServer) serviceMaster() {
 	})
 }
 
-func (a *APIServer) jobInput(bs *branchSet) (*pps.Input, error) {
+// jobInput returns the pipeline's input with every input commit set to the
+// commit that 'outputCommitInfo' (a commit in the pipeline's output branch)
+// was opened for, i.e. the commit from the input's repo in its provenance.
+func (a *APIServer) jobInput(ctx context.Context, outputCommitInfo *pfs.CommitInfo) (*pps.Input, error) {
+	pachClient := a.pachClient.WithCtx(ctx)
 	jobInput := proto.Clone(a.pipelineInfo.Input).(*pps.Input)
 	var visitErr error
 	pps.VisitInput(jobInput, func(input *pps.Input) {
-		if input.Atom != nil {
-			for _, branch := range bs.Branches {
-				if input.Atom.Repo == branch.Head.Repo.Name && input.Atom.Branch == branch.Name {
-					input.Atom.Commit = branch.Head.ID
-				}
-			}
-			if input.Atom.Commit == "" {
-				visitErr = fmt.Errorf("didn't find input commit for %s/%s", input.Atom.Repo, input.Atom.Branch)
-			}
+		if visitErr != nil {
+			return
+		}
+		var repo, branch string
+		var commit *string
+		switch {
+		case input.Atom != nil:
+			repo, branch, commit = input.Atom.Repo, input.Atom.Branch, &input.Atom.Commit
 			input.Atom.FromCommit = ""
+		case input.Cron != nil:
+			repo, branch, commit = input.Cron.Repo, "master", &input.Cron.Commit
+		case input.Git != nil:
+			repo, branch, commit = input.Git.Name, input.Git.Branch, &input.Git.Commit
+		default:
+			return
 		}
-		commitFromBranchSet := func(repoName string) string {
-			for _, branch := range bs.Branches {
-				if repoName == branch.Head.Repo.Name {
-					return branch.Head.ID
+		*commit, visitErr = provenanceCommit(pachClient, outputCommitInfo, repo, branch)
+	})
+	if visitErr != nil {
+		return nil, visitErr
+	}
+	return jobInput, nil
+}
+
+// provenanceCommit returns the ID of the commit from 'repo' in the provenance
+// of 'commitInfo'. The provenance can contain several commits from 'repo'
+// when the repo is also upstream of another input, in which case the most
+// recent one on 'branch' is returned.
+func provenanceCommit(pachClient *client.APIClient, commitInfo *pfs.CommitInfo, repo string, branch string) (string, error) {
+	candidates := make(map[string]bool)
+	var candidate string
+	for _, commit := range commitInfo.Provenance {
+		if commit.Repo.Name == repo {
+			candidates[commit.ID] = true
+			candidate = commit.ID
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("commit %s/%s has no provenance in %s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID, repo)
+	case 1:
+		return candidate, nil
+	}
+	// ListCommit returns the most recent commits first
+	branchCommitInfos, err := pachClient.ListCommit(repo, branch, "", 0)
+	if err != nil {
+		return "", err
+	}
+	for _, branchCommitInfo := range branchCommitInfos {
+		if candidates[branchCommitInfo.Commit.ID] {
+			return branchCommitInfo.Commit.ID, nil
+		}
+	}
+	return "", fmt.Errorf("none of the commits from %s in the provenance of %s/%s are on branch %s", repo, commitInfo.Commit.Repo.Name, commitInfo.Commit.ID, branch)
+}
+
+// outputCommits subscribes to the pipeline's output branch, and sends each
+// commit in it to the returned channel as soon as the commit is started. An
+// error from the subscription is sent to errCh.
+func (a *APIServer) outputCommits(ctx context.Context, errCh chan<- error) (<-chan *pfs.CommitInfo, error) {
+	iter, err := a.pachClient.WithCtx(ctx).SubscribeCommitState(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.OutputBranch, "", pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	commitCh := make(chan *pfs.CommitInfo)
+	go func() {
+		defer iter.Close()
+		for {
+			commitInfo, err := iter.Next()
+			if err != nil {
+				select {
+				case <-ctx.Done():
+				case errCh <- err:
 				}
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case commitCh <- commitInfo:
 			}
-			return ""
 		}
-		if input.Cron != nil {
-			input.Cron.Commit = commitFromBranchSet(input.Cron.Repo)
+	}()
+	return commitCh, nil
+}
+
+// isPipelineJob returns true if 'jobInfo' was created by the current version
+// of the pipeline (or by a version that shares its salt).
+func (a *APIServer) isPipelineJob(jobInfo *pps.JobInfo) bool {
+	return jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name &&
+		(jobInfo.Salt == a.pipelineInfo.Salt || (jobInfo.Salt == "" && jobInfo.PipelineVersion == a.pipelineInfo.Version))
+}
+
+// outputCommitJob returns the pipeline's job that writes to the output commit
+// 'commit', or nil if there's no such job.
+func (a *APIServer) outputCommitJob(ctx context.Context, commit *pfs.Commit) (*pps.JobInfo, error) {
+	jobIter, err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsOutputIndex, commit)
+	if err != nil {
+		return nil, err
+	}
+	var result *pps.JobInfo
+	for {
+		var jobID string
+		jobInfo := &pps.JobInfo{}
+		ok, err := jobIter.Next(&jobID, jobInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return result, nil
 		}
-		if input.Git != nil {
-			input.Git.Commit = commitFromBranchSet(input.Git.Name)
+		if a.isPipelineJob(jobInfo) {
+			result = jobInfo
 		}
+	}
+}
+
+// finishOutputCommit finishes the output commit 'commit' with 'tree' as its
+// contents, or with the contents of its parent if 'tree' is nil. Nothing is
+// done if the commit has already been finished.
+func (a *APIServer) finishOutputCommit(ctx context.Context, commit *pfs.Commit, tree *pfs.Object) error {
+	return a.finishOutputCommitRequest(ctx, &pfs.FinishCommitRequest{
+		Commit: commit,
+		Tree:   tree,
 	})
-	if visitErr != nil {
-		return nil, visitErr
+}
+
+// finishFailedOutputCommit finishes the output commit 'commit' of a job that
+// didn't succeed. The commit is finished with no files, rather than with the
+// contents of its parent, so that downstream pipelines don't mistake it for
+// the output of a successful job. Nothing is done if the commit has already
+// been finished.
+func (a *APIServer) finishFailedOutputCommit(ctx context.Context, commit *pfs.Commit) error {
+	return a.finishOutputCommitRequest(ctx, &pfs.FinishCommitRequest{
+		Commit: commit,
+		Empty:  true,
+	})
+}
+
+func (a *APIServer) finishOutputCommitRequest(ctx context.Context, request *pfs.FinishCommitRequest) error {
+	pachClient := a.pachClient.WithCtx(ctx)
+	commitInfo, err := pachClient.InspectCommit(request.Commit.Repo.Name, request.Commit.ID)
+	if err != nil {
+		return err
 	}
-	return jobInput, nil
+	if commitInfo.Finished != nil {
+		return nil
+	}
+	_, err = pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), request)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// finishJobOutputCommit finishes the output commit 'commit' of the job
+// 'jobInfo', which is done. The output of a successful job is finished by
+// the job itself, so this only matters if the job failed (or was killed or
+// timed out), in which case the commit is finished with no files.
+func (a *APIServer) finishJobOutputCommit(ctx context.Context, jobInfo *pps.JobInfo, commit *pfs.Commit) error {
+	if jobInfo.State == pps.JobState_JOB_SUCCESS {
+		return a.finishOutputCommit(ctx, commit, nil)
+	}
+	return a.finishFailedOutputCommit(ctx, commit)
 }
 
-// jobSpawner spawns jobs
+// createJob creates a job that processes the inputs of the output commit
+// 'commitInfo' and writes its output to that commit. It returns nil if the
+// commit's inputs can't be determined, in which case the commit is finished
+// without changes so that the output branch can move on.
+func (a *APIServer) createJob(ctx context.Context, logger *taggedLogger, commitInfo *pfs.CommitInfo) (*pps.JobInfo, error) {
+	jobInput, err := a.jobInput(ctx, commitInfo)
+	if err != nil {
+		logger.Logf("not processing output commit %s: %v", commitInfo.Commit.ID, err)
+		return nil, a.finishOutputCommit(ctx, commitInfo.Commit, nil)
+	}
+
+	// The parent job is the job that wrote the parent of this commit, and the
+	// new branch is the input that has moved on since then
+	var parentJob *pps.Job
+	var newBranch *pfs.BranchInfo
+	var parentProvenance map[string]bool
+	if commitInfo.ParentCommit != nil {
+		parentJobInfo, err := a.outputCommitJob(ctx, commitInfo.ParentCommit)
+		if err != nil {
+			return nil, err
+		}
+		if parentJobInfo != nil {
+			parentJob = parentJobInfo.Job
+		}
+		parentCommitInfo, err := a.pachClient.WithCtx(ctx).InspectCommit(commitInfo.ParentCommit.Repo.Name, commitInfo.ParentCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+		parentProvenance = make(map[string]bool)
+		for _, commit := range parentCommitInfo.Provenance {
+			parentProvenance[commit.ID] = true
+		}
+	}
+	pps.VisitInput(jobInput, func(input *pps.Input) {
+		if input.Atom != nil && newBranch == nil && !parentProvenance[input.Atom.Commit] {
+			newBranch = &pfs.BranchInfo{
+				Name: input.Atom.Branch,
+				Head: client.NewCommit(input.Atom.Repo, input.Atom.Commit),
+			}
+		}
+	})
+
+	job, err := a.pachClient.PpsAPIClient.CreateJob(ctx, &pps.CreateJobRequest{
+		Pipeline:         a.pipelineInfo.Pipeline,
+		Input:            jobInput,
+		OutputCommit:     commitInfo.Commit,
+		ParentJob:        parentJob,
+		NewBranch:        newBranch,
+		Salt:             a.pipelineInfo.Salt,
+		PipelineVersion:  a.pipelineInfo.Version,
+		EnableStats:      a.pipelineInfo.EnableStats,
+		Batch:            a.pipelineInfo.Batch,
+		Service:          a.pipelineInfo.Service,
+		ChunkSpec:        a.pipelineInfo.ChunkSpec,
+		DatumTimeout:     a.pipelineInfo.DatumTimeout,
+		JobTimeout:       a.pipelineInfo.JobTimeout,
+		DatumTries:       a.pipelineInfo.DatumTries,
+		SkipFailedDatums: a.pipelineInfo.SkipFailedDatums,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.pachClient.PpsAPIClient.InspectJob(ctx, &pps.InspectJobRequest{
+		Job: job,
+	})
+}
+
+// jobSpawner runs a job for every commit that PFS opens in the pipeline's
+// output branch.
 func (a *APIServer) jobSpawner(ctx context.Context, logger *taggedLogger) error {
-	bsf, err := a.newBranchSetFactory(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errCh := make(chan error, 1)
+	if err := a.runCrons(ctx, a.pachClient.WithCtx(ctx), errCh); err != nil {
+		return err
+	}
+	commitCh, err := a.outputCommits(ctx, errCh)
 	if err != nil {
-		return fmt.Errorf("error constructing branch set factory: %v", err)
+		return err
 	}
-	defer bsf.Close()
 nextInput:
 	for {
 		// scaleDownCh is closed after we have not received a job for
@@ -231,14 +445,13 @@ nextInput:
 				})
 			}
 		}
-		var bs *branchSet
+		var commitInfo *pfs.CommitInfo
 		select {
 		case <-ctx.Done():
 			return context.Canceled
-		case bs = <-bsf.Chan():
-			if bs.Err != nil {
-				return fmt.Errorf("error from branch set factory: %v", bs.Err)
-			}
+		case err := <-errCh:
+			return fmt.Errorf("error watching the output branch: %v", err)
+		case commitInfo = <-commitCh:
 		case <-scaleDownCh:
 			if err := a.scaleDownWorkers(); err != nil {
 				logger.Logf("error scaling down workers: %v", err)
@@ -246,209 +459,117 @@ nextInput:
 			continue nextInput
 		}
 
-		// Once we received a job, scale up the workers
-		if a.pipelineInfo.ScaleDownThreshold != nil {
-			if err := a.scaleUpWorkers(logger); err != nil {
-				logger.Logf("error scaling up workers: %v", err)
-			}
-		}
-
-		// (create JobInput for new processing job)
-		jobInput, err := a.jobInput(bs)
+		// Check if a job is already writing to this commit
+		jobInfo, err := a.outputCommitJob(ctx, commitInfo.Commit)
 		if err != nil {
 			return err
 		}
-
-		jobsRO := a.jobs.ReadOnly(ctx)
-		// Check if this input set has already been processed
-		jobIter, err := jobsRO.GetByIndex(ppsdb.JobsInputIndex, jobInput)
-		if err != nil {
-			return err
-		}
-
-		// This is doing the same thing as the line above but for 1.4.5 style jobs
-		oldJobIter, err := jobsRO.GetByIndex(ppsdb.JobsInputsIndex, untranslateJobInputs(jobInput))
-		if err != nil {
-			return err
-		}
-
-		// Check if any of the jobs in jobIter have been run already. If so, skip
-		// this input.
-		for {
-			var jobID string
-			var jobInfo pps.JobInfo
-			ok, err := jobIter.Next(&jobID, &jobInfo)
-			if err != nil {
-				return err
-			}
-			if !ok {
-				ok, err := oldJobIter.Next(&jobID, &jobInfo)
-				if err != nil {
+		if jobInfo != nil {
+			switch jobInfo.State {
+			case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING:
+			default:
+				// The job is done, but we may have stopped before
+				// finishing its output commit
+				if err := a.finishJobOutputCommit(ctx, jobInfo, commitInfo.Commit); err != nil {
 					return err
 				}
-				if !ok {
-					break
-				}
+				continue nextInput
 			}
-			if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name &&
-				(jobInfo.Salt == a.pipelineInfo.Salt || (jobInfo.Salt == "" && jobInfo.PipelineVersion == a.pipelineInfo.Version)) {
-				switch jobInfo.State {
-				case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING:
-					if err := a.waitJob(ctx, &jobInfo, logger); err != nil {
-						return err
-					}
-				case pps.JobState_JOB_SUCCESS:
-					continue nextInput
-				}
+		} else {
+			if commitInfo.Finished != nil {
+				continue nextInput
 			}
-		}
-
-		// now we need to find the parentJob for this job. The parent job
-		// is defined as the job with the same input commits except for the
-		// newest input commit which triggered this job. In place of it we
-		// use that commit's parent.
-		newBranch := bs.Branches[bs.NewBranch]
-		newCommitInfo, err := a.pachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
-			Commit: newBranch.Head,
-		})
-		if err != nil {
-			return err
-		}
-		var parentJob *pps.Job
-		if newCommitInfo.ParentCommit != nil {
-			// recreate our parent's inputs so we can look it up in etcd
-			parentJobInput := proto.Clone(jobInput).(*pps.Input)
-			pps.VisitInput(parentJobInput, func(input *pps.Input) {
-				if input.Atom != nil && input.Atom.Repo == newBranch.Head.Repo.Name && input.Atom.Branch == newBranch.Name {
-					input.Atom.Commit = newCommitInfo.ParentCommit.ID
-				}
-			})
-			jobIter, err := jobsRO.GetByIndex(ppsdb.JobsInputIndex, parentJobInput)
+			jobInfo, err = a.createJob(ctx, logger, commitInfo)
 			if err != nil {
 				return err
 			}
-			for {
-				var jobID string
-				var jobInfo pps.JobInfo
-				ok, err := jobIter.Next(&jobID, &jobInfo)
-				if err != nil {
-					return err
-				}
-				if !ok {
-					break
-				}
-				if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name &&
-					(jobInfo.Salt == a.pipelineInfo.Salt || (jobInfo.Salt == "" && jobInfo.PipelineVersion == a.pipelineInfo.Version)) {
-					parentJob = jobInfo.Job
-				}
+			if jobInfo == nil {
+				continue nextInput
 			}
 		}
 
-		job, err := a.pachClient.PpsAPIClient.CreateJob(ctx, &pps.CreateJobRequest{
-			Pipeline:        a.pipelineInfo.Pipeline,
-			Input:           jobInput,
-			ParentJob:       parentJob,
-			NewBranch:       newBranch,
-			Salt:            a.pipelineInfo.Salt,
-			PipelineVersion: a.pipelineInfo.Version,
-			EnableStats:     a.pipelineInfo.EnableStats,
-			Batch:           a.pipelineInfo.Batch,
-			Service:         a.pipelineInfo.Service,
-			ChunkSpec:       a.pipelineInfo.ChunkSpec,
-			DatumTimeout:    a.pipelineInfo.DatumTimeout,
-			JobTimeout:      a.pipelineInfo.JobTimeout,
-		})
-		if err != nil {
-			return err
+		// Once we received a job, scale up the workers
+		if a.pipelineInfo.ScaleDownThreshold != nil {
+			if err := a.scaleUpWorkers(logger); err != nil {
+				logger.Logf("error scaling up workers: %v", err)
+			}
 		}
 
-		jobInfo, err := a.pachClient.PpsAPIClient.InspectJob(ctx, &pps.InspectJobRequest{
-			Job: job,
-		})
+		if err := a.waitJob(ctx, jobInfo, logger); err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return context.Canceled
+		}
+		// A job that failed, was killed or timed out leaves its output commit
+		// open, which would stop PFS from opening new commits in the output
+		// branch
+		jobInfo, err = a.outputCommitJob(ctx, commitInfo.Commit)
 		if err != nil {
 			return err
 		}
-
-		if err := a.waitJob(ctx, jobInfo, logger); err != nil {
+		if jobInfo == nil {
+			return fmt.Errorf("could not find the job for output commit %s", commitInfo.Commit.ID)
+		}
+		if err := a.finishJobOutputCommit(ctx, jobInfo, commitInfo.Commit); err != nil {
 			return err
 		}
 	}
 }
 
+// serviceSpawner runs the pipeline's service on the inputs of the latest
+// commit in the pipeline's output branch.
 func (a *APIServer) serviceSpawner(ctx context.Context) error {
-	bsf, err := a.newBranchSetFactory(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errCh := make(chan error, 1)
+	if err := a.runCrons(ctx, a.pachClient.WithCtx(ctx), errCh); err != nil {
+		return err
+	}
+	commitCh, err := a.outputCommits(ctx, errCh)
 	if err != nil {
-		return fmt.Errorf("error constructing branch set factory: %v", err)
+		return err
 	}
-	defer bsf.Close()
+	logger := a.getMasterLogger()
 
 	var serviceCtx context.Context
 	var serviceCancel func()
 nextInput:
 	for {
-		var bs *branchSet
+		var commitInfo *pfs.CommitInfo
 		select {
 		case <-ctx.Done():
 			return context.Canceled
-		case bs = <-bsf.Chan():
-			if bs.Err != nil {
-				return fmt.Errorf("error from branch set factory: %v", bs.Err)
-			}
-		}
-		// create the jobInput
-		jobInput, err := a.jobInput(bs)
-		if err != nil {
-			return err
+		case err := <-errCh:
+			return fmt.Errorf("error watching the output branch: %v", err)
+		case commitInfo = <-commitCh:
 		}
-		jobsRO := a.jobs.ReadOnly(ctx)
 		// Check if the job has already been created.
-		jobCreated := false
-		jobIter, err := jobsRO.GetByIndex(ppsdb.JobsInputIndex, jobInput)
+		jobInfo, err := a.outputCommitJob(ctx, commitInfo.Commit)
 		if err != nil {
 			return err
 		}
-		for {
-			var jobID string
-			var jobInfo pps.JobInfo
-			ok, err := jobIter.Next(&jobID, &jobInfo)
+		if jobInfo == nil {
+			if commitInfo.Finished != nil {
+				continue nextInput
+			}
+			jobInfo, err = a.createJob(ctx, logger, commitInfo)
 			if err != nil {
 				return err
 			}
-			if !ok {
-				break
-			}
-			if jobInfo.Pipeline.Name == a.pipelineInfo.Pipeline.Name &&
-				(jobInfo.Salt == a.pipelineInfo.Salt || (jobInfo.Salt == "" && jobInfo.PipelineVersion == a.pipelineInfo.Version)) {
-				switch jobInfo.State {
-				case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING:
-					jobCreated = true
-				case pps.JobState_JOB_SUCCESS:
-					continue nextInput
-				}
+			if jobInfo == nil {
+				continue nextInput
 			}
+		} else if jobInfo.State == pps.JobState_JOB_SUCCESS {
+			continue nextInput
 		}
-		var jobID string
-		if !jobCreated {
-			newBranch := bs.Branches[bs.NewBranch]
-			job, err := a.pachClient.PpsAPIClient.CreateJob(ctx, &pps.CreateJobRequest{
-				Pipeline:        a.pipelineInfo.Pipeline,
-				Input:           jobInput,
-				NewBranch:       newBranch,
-				Salt:            a.pipelineInfo.Salt,
-				PipelineVersion: a.pipelineInfo.Version,
-				EnableStats:     a.pipelineInfo.EnableStats,
-				Batch:           a.pipelineInfo.Batch,
-				Service:         a.pipelineInfo.Service,
-				ChunkSpec:       a.pipelineInfo.ChunkSpec,
-				DatumTimeout:    a.pipelineInfo.DatumTimeout,
-				JobTimeout:      a.pipelineInfo.JobTimeout,
-			})
-			if err != nil {
-				return err
-			}
-			jobID = job.ID
+		jobID := jobInfo.Job.ID
+		// Services don't write output, so the output commit is finished right
+		// away, which lets PFS open a commit for the next inputs
+		if err := a.finishOutputCommit(ctx, commitInfo.Commit, nil); err != nil {
+			return err
 		}
-		df, err := NewDatumFactory(ctx, a.pachClient.PfsAPIClient, jobInput)
+		df, err := NewDatumFactory(ctx, a.pachClient.PfsAPIClient, jobInfo.Input)
 		if err != nil {
 			return err
 		}
@@ -458,11 +579,11 @@ nextInput:
 		data := df.Datum(0)
 		logger, err := a.getTaggedLogger(ctx, jobID, data, false)
 		puller := filesync.NewPuller()
-		dir, err := a.downloadData(logger, data, puller, nil, &pps.ProcessStats{}, nil, "")
+		dir, err := a.downloadData(ctx, logger, data, puller, nil, &pps.ProcessStats{}, nil, "")
 		if err != nil {
 			return err
 		}
-		if err := os.MkdirAll(client.PPSInputPrefix, 0666); err != nil {
+		if err := os.MkdirAll(client.PPSInputPrefix, 0755); err != nil {
 			return err
 		}
 		if err := syscall.Unmount(client.PPSInputPrefix, syscall.MNT_DETACH); err != nil {
@@ -512,6 +633,184 @@ nextInput:
 	}
 }
 
+// spoutSpawner runs the user code of a spout pipeline. Spouts have no input,
+// instead their user code writes tar streams to /pfs/out (a named pipe), and
+// each stream is committed to the pipeline's output branch.
+func (a *APIServer) spoutSpawner(ctx context.Context, logger *taggedLogger) error {
+	outPath := path.Join(client.PPSInputPrefix, "out")
+	if err := os.MkdirAll(client.PPSInputPrefix, 0755); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(outPath); err != nil {
+		return err
+	}
+	if err := syscall.Mkfifo(outPath, 0666); err != nil {
+		return fmt.Errorf("error creating spout pipe: %v", err)
+	}
+	// Mkfifo is subject to the umask, but the user code may not run as root
+	if err := os.Chmod(outPath, 0666); err != nil {
+		return err
+	}
+	// The user code and the receiver share a context, so that whichever of
+	// them fails first stops the other one
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errCh := make(chan error, 2)
+	receiverDone := make(chan struct{})
+	go func() {
+		errCh <- a.runService(ctx, logger)
+	}()
+	go func() {
+		defer close(receiverDone)
+		for ctx.Err() == nil {
+			if err := a.receiveSpoutBatch(ctx, logger, outPath); err != nil {
+				errCh <- err
+				return
+			}
+		}
+	}()
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	cancel()
+	// The receiver may be blocked opening the pipe, waiting for the user code
+	// to open it for writing, so we open (and close) it for writing until the
+	// receiver has returned.
+	for {
+		if f, err := os.OpenFile(outPath, os.O_WRONLY|syscall.O_NONBLOCK, 0); err == nil {
+			f.Close()
+		}
+		select {
+		case <-receiverDone:
+			return err
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// receiveSpoutBatch reads one tar stream from the spout's pipe and commits
+// the files in it to the output branch. The commit is only finished once the
+// whole stream has been read, a stream that's cut short is deleted so that
+// partial batches never show up in the output branch.
+func (a *APIServer) receiveSpoutBatch(ctx context.Context, logger *taggedLogger, outPath string) (retErr error) {
+	// Opening the pipe blocks until the user code opens it for writing
+	f, err := os.Open(outPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	pachClient := a.pachClient.WithCtx(ctx)
+	repo := a.pipelineInfo.Pipeline.Name
+	var commit *pfs.Commit
+	defer func() {
+		if retErr != nil && commit != nil {
+			if err := pachClient.DeleteCommit(repo, commit.ID); err != nil {
+				logger.Logf("error deleting partial spout commit %s: %v", commit.ID, err)
+			}
+		}
+	}()
+	if err := readSpoutStream(f, func(hdr *tar.Header, r io.Reader) error {
+		// Commits are started lazily so that empty streams don't create
+		// empty commits.
+		if commit == nil {
+			var err error
+			commit, err = pachClient.StartCommit(repo, a.pipelineInfo.OutputBranch)
+			if err != nil {
+				return err
+			}
+		}
+		var err error
+		if a.pipelineInfo.Spout.Overwrite {
+			_, err = pachClient.PutFileOverwrite(repo, commit.ID, hdr.Name, r, 0)
+		} else {
+			_, err = pachClient.PutFile(repo, commit.ID, hdr.Name, r)
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+	if commit == nil {
+		return nil
+	}
+	if err := pachClient.FinishCommit(repo, commit.ID); err != nil {
+		return err
+	}
+	logger.Logf("committed spout output to %s@%s", repo, commit.ID)
+	return nil
+}
+
+// readSpoutStream reads the tar stream 'r' written by a spout, and calls 'put'
+// on each regular file in it. A stream that ends before the tar's
+// end-of-archive marker was cut short (even if it ends between two files), so
+// it's an error, unless it's empty. Anything after the marker must be padding
+// (zeros), which is discarded.
+func readSpoutStream(r io.Reader, put func(*tar.Header, io.Reader) error) error {
+	sr := &spoutReader{r: r}
+	tr := tar.NewReader(sr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			// tr.Next returns io.EOF both when it reads the end-of-archive
+			// marker and when the stream ends before it
+			if !sr.eof {
+				break
+			}
+			if sr.n == 0 {
+				return nil
+			}
+			return fmt.Errorf("spout tar stream ended without an end-of-archive marker")
+		}
+		if err != nil {
+			return fmt.Errorf("error reading tar stream from spout: %v", err)
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+		if err := put(hdr, tr); err != nil {
+			return err
+		}
+	}
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := sr.Read(buf)
+		for _, b := range buf[:n] {
+			if b != 0 {
+				return fmt.Errorf("spout tar stream has data after its end-of-archive marker")
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// spoutReader records how much has been read from a spout's stream, and
+// whether its end has been reached.
+type spoutReader struct {
+	r   io.Reader
+	n   int64
+	eof bool
+}
+
+func (r *spoutReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if err == io.EOF {
+		r.eof = true
+	}
+	return n, err
+}
+
 func plusDuration(x *types.Duration, y *types.Duration) (*types.Duration, error) {
 	var xd time.Duration
 	var yd time.Duration
@@ -774,20 +1073,10 @@ func (a *APIServer) waitJob(ctx context.Context, jobInfo *pps.JobInfo, logger *t
 			return err
 		}
 
-		var provenance []*pfs.Commit
-		for _, commit := range pps.InputCommits(jobInfo.Input) {
-			provenance = append(provenance, commit)
-		}
-
-		outputCommit, err := a.pachClient.PfsAPIClient.BuildCommit(ctx, &pfs.BuildCommitRequest{
-			Parent: &pfs.Commit{
-				Repo: jobInfo.OutputRepo,
-			},
-			Branch:     jobInfo.OutputBranch,
-			Provenance: provenance,
-			Tree:       object,
-		})
-		if err != nil {
+		// The output commit was opened by PFS (with the job's inputs as its
+		// provenance) when the inputs were committed
+		outputCommit := jobInfo.OutputCommit
+		if err := a.finishOutputCommit(ctx, outputCommit, object); err != nil {
 			return err
 		}
 		if err := a.egress(ctx, logger, jobInfo, outputCommit); err != nil {
@@ -815,10 +1104,12 @@ func (a *APIServer) waitJob(ctx context.Context, jobInfo *pps.JobInfo, logger *t
 			if err := jobs.Get(jobID, jobInfo); err != nil {
 				return err
 			}
-			jobInfo.OutputCommit = outputCommit
 			jobInfo.Finished = now()
 			jobInfo.StatsCommit = statsCommit
-			if failedDatumID != "" {
+			// With SkipFailedDatums the failed datums are only counted in
+			// DataFailed (and recorded in the stats commit), the job itself
+			// still succeeds.
+			if failedDatumID != "" && !jobInfo.SkipFailedDatums {
 				return a.updateJobState(stm, jobInfo, pps.JobState_JOB_FAILURE, fmt.Sprintf("failed to process datum: %v", failedDatumID))
 			}
 			return a.updateJobState(stm, jobInfo, pps.JobState_JOB_SUCCESS, "")
@@ -1095,24 +1386,6 @@ func (a *APIServer) setCachedDatum(hash string) {
 	a.datumCache.Add(hash, struct{}{})
 }
 
-func untranslateJobInputs(input *pps.Input) []*pps.JobInput {
-	var result []*pps.JobInput
-	if input.Cross != nil {
-		for _, input := range input.Cross {
-			if input.Atom == nil {
-				return nil
-			}
-			result = append(result, &pps.JobInput{
-				Name:   input.Atom.Name,
-				Commit: client.NewCommit(input.Atom.Repo, input.Atom.Commit),
-				Glob:   input.Atom.Glob,
-				Lazy:   input.Atom.Lazy,
-			})
-		}
-	}
-	return result
-}
-
 func isNotFoundErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "not found")
 }
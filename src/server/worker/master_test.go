@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func spoutStream(t *testing.T, files map[string]string, names ...string) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range names {
+		content := files[name]
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func readSpoutFiles(stream []byte) (map[string]string, error) {
+	files := make(map[string]string)
+	err := readSpoutStream(bytes.NewReader(stream), func(hdr *tar.Header, r io.Reader) error {
+		content, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		files[hdr.Name] = string(content)
+		return nil
+	})
+	return files, err
+}
+
+func TestReadSpoutStream(t *testing.T) {
+	files := map[string]string{"foo": "foo\n", "bar": "bar\n"}
+	stream := spoutStream(t, files, "foo", "bar")
+
+	// A complete stream, with or without padding after the end-of-archive
+	// marker
+	result, err := readSpoutFiles(stream)
+	require.NoError(t, err)
+	require.Equal(t, files, result)
+	result, err = readSpoutFiles(append(stream, make([]byte, 1024)...))
+	require.NoError(t, err)
+	require.Equal(t, files, result)
+
+	// An empty stream
+	result, err = readSpoutFiles(nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(result))
+
+	// Data after the end-of-archive marker
+	_, err = readSpoutFiles(append(stream, []byte("foo")...))
+	require.YesError(t, err)
+}
+
+func TestReadSpoutStreamTruncated(t *testing.T) {
+	files := map[string]string{"foo": "foo\n", "bar": "bar\n"}
+	stream := spoutStream(t, files, "foo", "bar")
+	// The stream as it would be if it were cut off right after "foo", i.e.
+	// at a header boundary
+	oneFile := spoutStream(t, files, "foo")
+	truncated := oneFile[:len(oneFile)-1024]
+	require.True(t, bytes.HasPrefix(stream, truncated))
+
+	_, err := readSpoutFiles(truncated)
+	require.YesError(t, err)
+	// Cut off in the middle of the end-of-archive marker
+	_, err = readSpoutFiles(stream[:len(stream)-512])
+	require.YesError(t, err)
+	// Cut off in the middle of a file
+	_, err = readSpoutFiles(stream[:700])
+	require.YesError(t, err)
+}
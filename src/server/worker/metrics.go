@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// datumBuckets range from 10ms to about 45 minutes.
+var datumBuckets = prometheus.ExponentialBuckets(0.01, 4, 10)
+
+var (
+	datumDownloadSeconds = newDatumHistogramVec("pachyderm_worker_datum_download_seconds", "Time taken to download a datum's input data.")
+	datumProcessSeconds  = newDatumHistogramVec("pachyderm_worker_datum_process_seconds", "Time taken to run the user code on a datum.")
+	datumUploadSeconds   = newDatumHistogramVec("pachyderm_worker_datum_upload_seconds", "Time taken to upload a datum's output data.")
+)
+
+func init() {
+	prometheus.MustRegister(datumDownloadSeconds, datumProcessSeconds, datumUploadSeconds)
+}
+
+func newDatumHistogramVec(name string, help string) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    name,
+		Help:    help,
+		Buckets: datumBuckets,
+	}, []string{"pipeline"})
+}
+
+// observeDatumStats records the timings of a processed datum in the
+// per-pipeline datum histograms.
+func observeDatumStats(pipelineName string, stats *pps.ProcessStats) {
+	for _, o := range []struct {
+		vec      *prometheus.HistogramVec
+		duration *types.Duration
+	}{
+		{datumDownloadSeconds, stats.DownloadTime},
+		{datumProcessSeconds, stats.ProcessTime},
+		{datumUploadSeconds, stats.UploadTime},
+	} {
+		if o.duration == nil {
+			continue
+		}
+		d, err := types.DurationFromProto(o.duration)
+		if err != nil {
+			continue
+		}
+		o.vec.WithLabelValues(pipelineName).Observe(d.Seconds())
+	}
+}
@@ -3,7 +3,18 @@ package worker
 import (
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
+	"path"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	workerEtcdPrefix = "workers"
 )
 
 // MatchDatum checks if a datum matches a filter.  To match each string in
@@ -27,3 +38,24 @@ dataFilters:
 	}
 	return matchesData
 }
+
+// Clients returns a client for each worker in the worker pool identified by
+// workerPoolID (the name of a pipeline's RC), as registered in etcd under
+// etcdPrefix.
+func Clients(ctx context.Context, workerPoolID string, etcdClient *etcd.Client, etcdPrefix string) ([]WorkerClient, error) {
+	resp, err := etcdClient.Get(ctx, path.Join(etcdPrefix, workerEtcdPrefix, workerPoolID), etcd.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []WorkerClient
+	for _, kv := range resp.Kvs {
+		conn, err := grpc.Dial(fmt.Sprintf("%s:%d", path.Base(string(kv.Key)), client.PPSWorkerPort),
+			client.PachDialOptions()...)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, NewWorkerClient(conn))
+	}
+	return result, nil
+}
@@ -11,6 +11,7 @@
 		Input
 		CancelRequest
 		CancelResponse
+		ProfileRequest
 		ChunkState
 		Chunks
 */
@@ -23,6 +24,7 @@ import pfs "github.com/pachyderm/pachyderm/src/client/pfs"
 import pps "github.com/pachyderm/pachyderm/src/client/pps"
 import _ "github.com/gogo/protobuf/gogoproto"
 import google_protobuf "github.com/gogo/protobuf/types"
+import google_protobuf1 "github.com/gogo/protobuf/types"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
@@ -63,7 +65,7 @@ func (x ChunkState_State) String() string {
 	return proto.EnumName(ChunkState_State_name, int32(x))
 }
 func (ChunkState_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorWorkerService, []int{3, 0}
+	return fileDescriptorWorkerService, []int{4, 0}
 }
 
 type Input struct {
@@ -170,6 +172,34 @@ func (m *CancelResponse) GetSuccess() bool {
 	return false
 }
 
+type ProfileRequest struct {
+	// profile is the name of a runtime/pprof profile, e.g. "goroutine" or
+	// "heap".
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// debug is passed to the profile's WriteTo method. 0 writes the profile in
+	// the binary pprof format, larger values write it as text.
+	Debug int64 `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
+}
+
+func (m *ProfileRequest) Reset()                    { *m = ProfileRequest{} }
+func (m *ProfileRequest) String() string            { return proto.CompactTextString(m) }
+func (*ProfileRequest) ProtoMessage()               {}
+func (*ProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{3} }
+
+func (m *ProfileRequest) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *ProfileRequest) GetDebug() int64 {
+	if m != nil {
+		return m.Debug
+	}
+	return 0
+}
+
 type ChunkState struct {
 	State   ChunkState_State `protobuf:"varint,1,opt,name=state,proto3,enum=worker.ChunkState_State" json:"state,omitempty"`
 	DatumID string           `protobuf:"bytes,2,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
@@ -178,7 +208,7 @@ type ChunkState struct {
 func (m *ChunkState) Reset()                    { *m = ChunkState{} }
 func (m *ChunkState) String() string            { return proto.CompactTextString(m) }
 func (*ChunkState) ProtoMessage()               {}
-func (*ChunkState) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{3} }
+func (*ChunkState) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{4} }
 
 func (m *ChunkState) GetState() ChunkState_State {
 	if m != nil {
@@ -201,7 +231,7 @@ type Chunks struct {
 func (m *Chunks) Reset()                    { *m = Chunks{} }
 func (m *Chunks) String() string            { return proto.CompactTextString(m) }
 func (*Chunks) ProtoMessage()               {}
-func (*Chunks) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{4} }
+func (*Chunks) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{5} }
 
 func (m *Chunks) GetChunks() []int64 {
 	if m != nil {
@@ -214,6 +244,7 @@ func init() {
 	proto.RegisterType((*Input)(nil), "worker.Input")
 	proto.RegisterType((*CancelRequest)(nil), "worker.CancelRequest")
 	proto.RegisterType((*CancelResponse)(nil), "worker.CancelResponse")
+	proto.RegisterType((*ProfileRequest)(nil), "worker.ProfileRequest")
 	proto.RegisterType((*ChunkState)(nil), "worker.ChunkState")
 	proto.RegisterType((*Chunks)(nil), "worker.Chunks")
 	proto.RegisterEnum("worker.ChunkState_State", ChunkState_State_name, ChunkState_State_value)
@@ -232,6 +263,8 @@ const _ = grpc.SupportPackageIsVersion4
 type WorkerClient interface {
 	Status(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error)
 	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	// Profile streams one of the worker's pprof profiles.
+	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (Worker_ProfileClient, error)
 }
 
 type workerClient struct {
@@ -260,11 +293,45 @@ func (c *workerClient) Cancel(ctx context.Context, in *CancelRequest, opts ...gr
 	return out, nil
 }
 
+func (c *workerClient) Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (Worker_ProfileClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[0], c.cc, "/worker.Worker/Profile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerProfileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_ProfileClient interface {
+	Recv() (*google_protobuf1.BytesValue, error)
+	grpc.ClientStream
+}
+
+type workerProfileClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerProfileClient) Recv() (*google_protobuf1.BytesValue, error) {
+	m := new(google_protobuf1.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Worker service
 
 type WorkerServer interface {
 	Status(context.Context, *google_protobuf.Empty) (*pps.WorkerStatus, error)
 	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
+	// Profile streams one of the worker's pprof profiles.
+	Profile(*ProfileRequest, Worker_ProfileServer) error
 }
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
@@ -307,6 +374,27 @@ func _Worker_Cancel_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_Profile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).Profile(m, &workerProfileServer{stream})
+}
+
+type Worker_ProfileServer interface {
+	Send(*google_protobuf1.BytesValue) error
+	grpc.ServerStream
+}
+
+type workerProfileServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerProfileServer) Send(m *google_protobuf1.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "worker.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -320,7 +408,13 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Worker_Cancel_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Profile",
+			Handler:       _Worker_Profile_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "server/worker/worker_service.proto",
 }
 
@@ -467,6 +561,35 @@ func (m *CancelResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profile) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(len(m.Profile)))
+		i += copy(dAtA[i:], m.Profile)
+	}
+	if m.Debug != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.Debug))
+	}
+	return i, nil
+}
+
 func (m *ChunkState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -598,6 +721,19 @@ func (m *CancelResponse) Size() (n int) {
 	return n
 }
 
+func (m *ProfileRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Profile)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	if m.Debug != 0 {
+		n += 1 + sovWorkerService(uint64(m.Debug))
+	}
+	return n
+}
+
 func (m *ChunkState) Size() (n int) {
 	var l int
 	_ = l
@@ -1058,6 +1194,104 @@ func (m *CancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkerService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			m.Debug = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Debug |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkerService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ChunkState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1376,40 +1610,44 @@ var (
 func init() { proto.RegisterFile("server/worker/worker_service.proto", fileDescriptorWorkerService) }
 
 var fileDescriptorWorkerService = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0xba, 0x38, 0xed, 0x9b, 0x6d, 0x2a, 0x16, 0x4c, 0xd1, 0x90, 0xda, 0x10, 0x24,
-	0x54, 0xed, 0x90, 0xa2, 0x21, 0x0e, 0x1c, 0x59, 0xdb, 0x4d, 0x41, 0x63, 0x20, 0xb3, 0x89, 0x63,
-	0x94, 0xa4, 0x4e, 0x96, 0x2d, 0x8d, 0x43, 0xec, 0x80, 0xb6, 0xcf, 0xc1, 0x81, 0x8f, 0xc4, 0x91,
-	0x4f, 0x30, 0xa1, 0x70, 0xe4, 0x4b, 0x20, 0xdb, 0xed, 0x8a, 0x38, 0xb8, 0x7d, 0xde, 0x9f, 0x9f,
-	0xf8, 0xfd, 0x63, 0x83, 0xc7, 0x69, 0xfd, 0x85, 0xd6, 0x93, 0xaf, 0xac, 0xbe, 0xbe, 0xff, 0x0b,
-	0x25, 0xcc, 0x13, 0xea, 0x57, 0x35, 0x13, 0x0c, 0x23, 0x4d, 0xf7, 0x1f, 0x25, 0x45, 0x4e, 0x4b,
-	0x31, 0xa9, 0x52, 0x2e, 0x97, 0xde, 0xdd, 0xd0, 0x8a, 0xcb, 0xb5, 0xa6, 0x19, 0xcb, 0x98, 0x92,
-	0x13, 0xa9, 0x56, 0xf4, 0x49, 0xc6, 0x58, 0x56, 0xd0, 0x89, 0x8a, 0xe2, 0x26, 0x9d, 0xd0, 0x65,
-	0x25, 0x6e, 0xf4, 0xa6, 0xf7, 0xc7, 0x00, 0x33, 0x28, 0xab, 0x46, 0xe0, 0x03, 0xe8, 0xa7, 0x79,
-	0x41, 0xc3, 0xbc, 0x4c, 0x99, 0x63, 0xb8, 0xc6, 0xd8, 0x3e, 0xdc, 0xf1, 0x65, 0xc6, 0xe3, 0xbc,
-	0xa0, 0x41, 0x99, 0x32, 0xd2, 0x4b, 0x57, 0x0a, 0x63, 0xd8, 0x2a, 0xa3, 0x25, 0x75, 0x1e, 0xb8,
-	0xc6, 0xb8, 0x4f, 0x94, 0x96, 0xac, 0x88, 0x6e, 0x6f, 0x9c, 0xae, 0x6b, 0x8c, 0x7b, 0x44, 0x69,
-	0xbc, 0x07, 0x28, 0xae, 0xa3, 0x32, 0xb9, 0x74, 0xb6, 0x94, 0x73, 0x15, 0xe1, 0x17, 0xb0, 0x53,
-	0x45, 0x35, 0x2d, 0x45, 0x98, 0xb0, 0xe5, 0x32, 0x17, 0x8e, 0xa9, 0xf2, 0xd9, 0x2a, 0xdf, 0x54,
-	0x21, 0xb2, 0xad, 0x1d, 0x3a, 0xc2, 0xcf, 0xc0, 0xca, 0x72, 0x11, 0x36, 0x75, 0xe1, 0x20, 0x79,
-	0xd4, 0x11, 0xb4, 0x77, 0x23, 0x74, 0x92, 0x8b, 0x0b, 0x72, 0x4a, 0x50, 0x96, 0x8b, 0x8b, 0xba,
-	0xc0, 0x23, 0xb0, 0x55, 0x6f, 0xa1, 0x2c, 0x94, 0x3b, 0x96, 0xaa, 0x04, 0x14, 0x92, 0x4d, 0x70,
-	0xef, 0x1c, 0x76, 0xa6, 0x51, 0x99, 0xd0, 0x82, 0xd0, 0xcf, 0x0d, 0xe5, 0x02, 0x3f, 0x85, 0xed,
-	0x45, 0x24, 0x22, 0xf9, 0x81, 0xa0, 0x35, 0x77, 0x0c, 0xb7, 0x3b, 0xee, 0x13, 0x5b, 0xb2, 0x63,
-	0x8d, 0xb0, 0x0b, 0xe8, 0x8a, 0xc5, 0x61, 0xbe, 0xd0, 0xdd, 0x1e, 0xf5, 0xdb, 0xbb, 0x91, 0xf9,
-	0x96, 0xc5, 0xc1, 0x8c, 0x98, 0x57, 0x2c, 0x0e, 0x16, 0xde, 0x01, 0xec, 0xae, 0x4f, 0xe5, 0x15,
-	0x2b, 0x39, 0xc5, 0x0e, 0x58, 0xbc, 0x49, 0x12, 0xca, 0xb9, 0x9a, 0x64, 0x8f, 0xac, 0x43, 0xef,
-	0x9b, 0x01, 0x30, 0xbd, 0x6c, 0xca, 0xeb, 0x8f, 0x22, 0x12, 0x14, 0xfb, 0x60, 0x72, 0x29, 0x94,
-	0x6d, 0xf7, 0xd0, 0xf1, 0xf5, 0xad, 0xfb, 0x1b, 0x8b, 0xaf, 0x7e, 0x89, 0xb6, 0xe1, 0xe7, 0xd0,
-	0x5b, 0x44, 0xa2, 0x59, 0x6e, 0xca, 0xb1, 0xdb, 0xbb, 0x91, 0x35, 0x93, 0x2c, 0x98, 0x11, 0x4b,
-	0x6d, 0x06, 0x0b, 0xcf, 0x07, 0x53, 0x27, 0xb0, 0xc1, 0x22, 0x17, 0x67, 0x67, 0xc1, 0xd9, 0xc9,
-	0xa0, 0x83, 0xb7, 0xa1, 0x37, 0x7d, 0xff, 0xee, 0xc3, 0xe9, 0xfc, 0x7c, 0x3e, 0x30, 0x30, 0x00,
-	0x3a, 0x7e, 0x13, 0x9c, 0xce, 0x67, 0x83, 0xae, 0xe7, 0x02, 0x52, 0x29, 0xb9, 0xbc, 0xb2, 0x44,
-	0x29, 0x35, 0x8b, 0x2e, 0x59, 0x45, 0x87, 0xb7, 0x80, 0x3e, 0xa9, 0xda, 0xf0, 0x2b, 0x40, 0xf2,
-	0xec, 0x86, 0xe3, 0x3d, 0x5f, 0x3f, 0x2d, 0x7f, 0xfd, 0xb4, 0xfc, 0xb9, 0x9c, 0xf5, 0xfe, 0x43,
-	0x5f, 0xbe, 0x49, 0x6d, 0xd7, 0x56, 0xaf, 0x83, 0x5f, 0x03, 0xd2, 0x53, 0xc2, 0x8f, 0xef, 0xbb,
-	0xfc, 0xf7, 0x2e, 0xf6, 0xf7, 0xfe, 0xc7, 0x7a, 0x98, 0x5e, 0xe7, 0x68, 0xf0, 0xa3, 0x1d, 0x1a,
-	0x3f, 0xdb, 0xa1, 0xf1, 0xab, 0x1d, 0x1a, 0xdf, 0x7f, 0x0f, 0x3b, 0x31, 0x52, 0x19, 0x5f, 0xfe,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xed, 0x0d, 0x77, 0x4a, 0x03, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x92, 0xda, 0x4e, 0x26, 0x6d, 0x15, 0x56, 0xa5, 0xb2, 0x52, 0x29, 0x09, 0x46, 0x42,
+	0x51, 0x0f, 0x4e, 0x55, 0xc4, 0x81, 0x1b, 0xe4, 0xa7, 0x95, 0x51, 0x29, 0xd5, 0xd2, 0xc2, 0x31,
+	0xb2, 0x9d, 0x8d, 0xeb, 0xd6, 0xf1, 0x1a, 0xef, 0x9a, 0xaa, 0x3c, 0x07, 0x07, 0x9e, 0x86, 0x33,
+	0x47, 0x9e, 0xa0, 0x42, 0xe1, 0xc8, 0x4b, 0xa0, 0xdd, 0xb5, 0x5b, 0x28, 0x07, 0x27, 0x33, 0xdf,
+	0x7c, 0x3b, 0xf3, 0xcd, 0x0f, 0x38, 0x9c, 0xe6, 0x9f, 0x68, 0x3e, 0xbc, 0x62, 0xf9, 0xe5, 0xed,
+	0xdf, 0x4c, 0x82, 0x71, 0x48, 0xdd, 0x2c, 0x67, 0x82, 0x61, 0x53, 0xa3, 0x9d, 0xad, 0x30, 0x89,
+	0x69, 0x2a, 0x86, 0xd9, 0x82, 0xcb, 0x4f, 0x47, 0xef, 0xd0, 0x8c, 0xcb, 0xaf, 0x42, 0x23, 0x16,
+	0x31, 0x65, 0x0e, 0xa5, 0x55, 0xa2, 0x3b, 0x11, 0x63, 0x51, 0x42, 0x87, 0xca, 0x0b, 0x8a, 0xc5,
+	0x90, 0x2e, 0x33, 0x71, 0x5d, 0x06, 0xbb, 0xf7, 0x83, 0x57, 0xb9, 0x9f, 0x65, 0x34, 0x2f, 0x53,
+	0x3a, 0xbf, 0x11, 0x18, 0x5e, 0x9a, 0x15, 0x02, 0xef, 0x42, 0x73, 0x11, 0x27, 0x74, 0x16, 0xa7,
+	0x0b, 0x66, 0xa3, 0x3e, 0x1a, 0xb4, 0xf6, 0x37, 0x5c, 0xa9, 0xe8, 0x20, 0x4e, 0xa8, 0x97, 0x2e,
+	0x18, 0x69, 0x2c, 0x4a, 0x0b, 0x63, 0x58, 0x4b, 0xfd, 0x25, 0xb5, 0x1f, 0xf4, 0xd1, 0xa0, 0x49,
+	0x94, 0x2d, 0xb1, 0xc4, 0xff, 0x7c, 0x6d, 0xd7, 0xfb, 0x68, 0xd0, 0x20, 0xca, 0xc6, 0xdb, 0x60,
+	0x06, 0xb9, 0x9f, 0x86, 0xe7, 0xf6, 0x9a, 0x62, 0x96, 0x1e, 0xde, 0x83, 0x8d, 0xcc, 0xcf, 0x69,
+	0x2a, 0x66, 0x21, 0x5b, 0x2e, 0x63, 0x61, 0x1b, 0xaa, 0x5e, 0x4b, 0xd5, 0x1b, 0x2b, 0x88, 0xac,
+	0x6b, 0x86, 0xf6, 0xf0, 0x13, 0xb0, 0xa2, 0x58, 0xcc, 0x8a, 0x3c, 0xb1, 0x4d, 0x99, 0x6a, 0x04,
+	0xab, 0x9b, 0x9e, 0x79, 0x18, 0x8b, 0x33, 0x72, 0x44, 0xcc, 0x28, 0x16, 0x67, 0x79, 0x82, 0x7b,
+	0xd0, 0x52, 0xbd, 0xcf, 0xa4, 0x50, 0x6e, 0x5b, 0x4a, 0x09, 0x28, 0x48, 0x36, 0xc1, 0x9d, 0x53,
+	0xd8, 0x18, 0xfb, 0x69, 0x48, 0x13, 0x42, 0x3f, 0x16, 0x94, 0x0b, 0xfc, 0x18, 0xd6, 0xe7, 0xbe,
+	0xf0, 0xe5, 0x03, 0x41, 0x73, 0x6e, 0xa3, 0x7e, 0x7d, 0xd0, 0x24, 0x2d, 0x89, 0x1d, 0x68, 0x08,
+	0xf7, 0xc1, 0xbc, 0x60, 0xc1, 0x2c, 0x9e, 0xeb, 0x6e, 0x47, 0xcd, 0xd5, 0x4d, 0xcf, 0x78, 0xcd,
+	0x02, 0x6f, 0x42, 0x8c, 0x0b, 0x16, 0x78, 0x73, 0x67, 0x17, 0x36, 0xab, 0xac, 0x3c, 0x63, 0x29,
+	0xa7, 0xd8, 0x06, 0x8b, 0x17, 0x61, 0x48, 0x39, 0x57, 0x93, 0x6c, 0x90, 0xca, 0x75, 0x5e, 0xc2,
+	0xe6, 0x49, 0xce, 0xa4, 0xbe, 0x4a, 0x82, 0x0d, 0x56, 0xa6, 0x11, 0xc5, 0x6d, 0x92, 0xca, 0xc5,
+	0x5b, 0x60, 0xcc, 0x69, 0x50, 0x44, 0xaa, 0x70, 0x9d, 0x68, 0xc7, 0xf9, 0x82, 0x00, 0xc6, 0xe7,
+	0x45, 0x7a, 0xf9, 0x4e, 0xf8, 0x82, 0x62, 0x17, 0x0c, 0x2e, 0x0d, 0xf5, 0x78, 0x73, 0xdf, 0x76,
+	0xf5, 0x5d, 0xb9, 0x77, 0x14, 0x57, 0xfd, 0x12, 0x4d, 0xc3, 0x4f, 0xa1, 0x31, 0xf7, 0x45, 0xb1,
+	0xbc, 0x6b, 0xa8, 0xb5, 0xba, 0xe9, 0x59, 0x13, 0x89, 0x79, 0x13, 0x62, 0xa9, 0xa0, 0x37, 0x77,
+	0x5c, 0x30, 0x74, 0x81, 0x16, 0x58, 0xe4, 0xec, 0xf8, 0xd8, 0x3b, 0x3e, 0x6c, 0xd7, 0xf0, 0x3a,
+	0x34, 0xc6, 0x6f, 0xdf, 0x9c, 0x1c, 0x4d, 0x4f, 0xa7, 0x6d, 0x84, 0x01, 0xcc, 0x83, 0x57, 0xde,
+	0xd1, 0x74, 0xd2, 0xae, 0x3b, 0x7d, 0x30, 0x55, 0x49, 0x2e, 0x97, 0x1e, 0x2a, 0x4b, 0x4d, 0xb3,
+	0x4e, 0x4a, 0x6f, 0xff, 0x1b, 0x02, 0xf3, 0x83, 0x12, 0x87, 0x9f, 0x83, 0x29, 0x93, 0x17, 0x1c,
+	0x6f, 0xbb, 0xfa, 0x40, 0xdd, 0xea, 0x40, 0xdd, 0xa9, 0x5c, 0x57, 0xe7, 0xa1, 0x2b, 0xcf, 0x5e,
+	0xd3, 0x35, 0xd5, 0xa9, 0xe1, 0x17, 0x60, 0xea, 0x41, 0xe3, 0x47, 0xb7, 0x6d, 0xfe, 0xbd, 0xce,
+	0xce, 0xf6, 0x7d, 0x58, 0xef, 0xc3, 0xa9, 0xe1, 0x11, 0x58, 0xe5, 0xdc, 0xf1, 0x2d, 0xe9, 0xdf,
+	0x45, 0x74, 0x76, 0xfe, 0x93, 0x32, 0xba, 0x16, 0x94, 0xbf, 0xf7, 0x93, 0x82, 0x3a, 0xb5, 0x3d,
+	0x34, 0x6a, 0x7f, 0x5f, 0x75, 0xd1, 0x8f, 0x55, 0x17, 0xfd, 0x5c, 0x75, 0xd1, 0xd7, 0x5f, 0xdd,
+	0x5a, 0x60, 0x2a, 0xea, 0xb3, 0x3f, 0x03, 0x00, 0xfe, 0xa0, 0xf8, 0x95, 0xf1, 0x03, 0x00, 0x00,
 }
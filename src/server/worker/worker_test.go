@@ -35,12 +35,12 @@ func TestAcquireDatums(t *testing.T) {
 			jobInfo := &pps.JobInfo{
 				Job: client.NewJob(uuid.New()),
 			}
+			jobChunks := &Chunks{}
+			for i := 1; i <= nChunks; i++ {
+				jobChunks.Chunks = append(jobChunks.Chunks, int64(i))
+			}
 			_, err := col.NewSTM(context.Background(), etcdClient, func(stm col.STM) error {
-				c := &Chunks{}
-				for i := 1; i <= nChunks; i++ {
-					c.Chunks = append(c.Chunks, int64(i))
-				}
-				return chunks.ReadWrite(stm).Create(jobInfo.Job.ID, c)
+				return chunks.ReadWrite(stm).Create(jobInfo.Job.ID, jobChunks)
 			})
 			require.NoError(t, err)
 			var chunks []int64
@@ -50,11 +50,11 @@ func TestAcquireDatums(t *testing.T) {
 				server := newTestAPIServer(c, etcdClient, "", t)
 				logger := server.getMasterLogger()
 				eg.Go(func() error {
-					return server.acquireDatums(context.Background(), jobInfo, logger, func(low, high int64) error {
+					return server.acquireDatums(context.Background(), jobInfo.Job.ID, jobChunks, logger, func(low, high int64) (string, error) {
 						chunksMu.Lock()
 						defer chunksMu.Unlock()
 						chunks = append(chunks, high)
-						return nil
+						return "", nil
 					})
 				})
 			}